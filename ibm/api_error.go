@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	corev4 "github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// requestIDHeaders are the response headers carrying the ID which IBM Cloud support needs to trace a request
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Transaction-Id", "X-Global-Transaction-Id", "Cf-Ray"}
+
+var quotaRegexp = regexp.MustCompile(`(?i)quota|limit_exceeded|limit exceeded|maximum number`)
+
+// APIError is a failed IBM Cloud API request
+type APIError struct {
+	StatusCode int
+	// Code is the error code of the service, e.g. not_authorized
+	Code      string
+	Message   string
+	RequestID string
+	Trace     string
+	err       error
+}
+
+func (e *APIError) Error() string {
+	return e.err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// newAPIError wraps the error of an IBM Cloud Go SDK request with the details of its response.
+// response is the *core.DetailedResponse returned along with err, of the v4 or v5 core.
+func newAPIError(err error, response interface{}) error {
+	if err == nil {
+		return nil
+	}
+	e := &APIError{err: err, Message: err.Error()}
+	var headers http.Header
+	var result interface{}
+	switch r := response.(type) {
+	case *core.DetailedResponse:
+		if r != nil {
+			e.StatusCode, headers, result = r.StatusCode, r.Headers, r.Result
+		}
+	case *corev4.DetailedResponse:
+		if r != nil {
+			e.StatusCode, headers, result = r.StatusCode, r.Headers, r.Result
+		}
+	}
+	for _, h := range requestIDHeaders {
+		if v := headers.Get(h); v != "" {
+			e.RequestID = v
+			break
+		}
+	}
+	if body, ok := result.(map[string]interface{}); ok {
+		e.readBody(body)
+	}
+	return e
+}
+
+// readBody reads the error code and trace of the error response of an IBM Cloud API, e.g.
+// {"errors":[{"code":"not_authorized","message":"..."}],"trace":"..."}
+func (e *APIError) readBody(body map[string]interface{}) {
+	if trace, ok := body["trace"].(string); ok {
+		e.Trace = trace
+	}
+	if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			e.Code = errorCodeString(first["code"])
+			if message, ok := first["message"].(string); ok && message != "" {
+				e.Message = message
+			}
+		}
+	}
+	for _, key := range []string{"code", "errorCode", "error_code"} {
+		if e.Code == "" {
+			e.Code = errorCodeString(body[key])
+		}
+	}
+}
+
+// errorCodeString formats the error code of a response, which CIS returns as a number
+func errorCodeString(code interface{}) string {
+	switch c := code.(type) {
+	case string:
+		return c
+	case float64:
+		return fmt.Sprintf("%.0f", c)
+	}
+	return ""
+}
+
+// asAPIError finds the failed request in the chain of err, whether it comes from an IBM Cloud Go SDK,
+// the Bluemix or the SoftLayer client
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	var bmxErr bmxerror.RequestFailure
+	if errors.As(err, &bmxErr) {
+		return &APIError{
+			StatusCode: bmxErr.StatusCode(),
+			Code:       bmxErr.Code(),
+			Message:    bmxErr.Description(),
+			err:        bmxErr,
+		}, true
+	}
+	var slErr sl.Error
+	if errors.As(err, &slErr) && slErr.StatusCode != 0 {
+		return &APIError{
+			StatusCode: slErr.StatusCode,
+			Code:       slErr.Exception,
+			Message:    slErr.Message,
+			err:        slErr,
+		}, true
+	}
+	return nil, false
+}
+
+// Hint suggests how to fix the most common failures
+func (e *APIError) Hint() string {
+	switch {
+	case quotaRegexp.MatchString(e.Code) || quotaRegexp.MatchString(e.Message):
+		return "The account reached a quota for this type of resource. Delete the resources which are no longer used or ask IBM Cloud support to increase the quota."
+	case e.StatusCode == http.StatusUnauthorized:
+		return "Check that the API key or token configured in the provider is valid and has not expired."
+	case e.StatusCode == http.StatusForbidden:
+		return "The credentials of the provider miss an IAM role for this operation. Ask an administrator of the account for an access policy granting the required platform and service roles on the resource or its resource group."
+	case e.StatusCode == http.StatusConflict:
+		return "The resource is in a conflicting state: another operation may be in progress on it or on a resource it depends on, or a resource with the same name exists. Wait for the operation to complete and run terraform apply again."
+	case e.StatusCode == http.StatusTooManyRequests:
+		return "The service limits the rate of the requests. Configure the retry block or the rate_limits of the provider to send fewer requests."
+	}
+	return ""
+}
+
+// Detail describes the failed request for the detail of a diagnostic
+func (e *APIError) Detail() string {
+	var lines []string
+	if e.StatusCode != 0 {
+		lines = append(lines, fmt.Sprintf("Status code: %d", e.StatusCode))
+	}
+	if e.Code != "" {
+		lines = append(lines, fmt.Sprintf("Error code: %s", e.Code))
+	}
+	if e.Message != "" && e.Message != e.err.Error() {
+		lines = append(lines, fmt.Sprintf("Message: %s", e.Message))
+	}
+	if e.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("Request ID: %s", e.RequestID))
+	}
+	if e.Trace != "" && e.Trace != e.RequestID {
+		lines = append(lines, fmt.Sprintf("Trace: %s", e.Trace))
+	}
+	detail := strings.Join(lines, "\n")
+	if hint := e.Hint(); hint != "" {
+		detail += "\n\n" + hint
+	}
+	if e.RequestID != "" || e.Trace != "" {
+		detail += "\n\nProvide the request ID or trace when opening a case with IBM Cloud support."
+	}
+	return strings.TrimSpace(detail)
+}
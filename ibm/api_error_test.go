@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	corev4 "github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestNewAPIError(t *testing.T) {
+	response := &core.DetailedResponse{
+		StatusCode: 403,
+		Headers:    http.Header{"X-Request-Id": []string{"9c3f1c3e-2b2d-4f5a"}},
+		Result: map[string]interface{}{
+			"errors": []interface{}{map[string]interface{}{"code": "not_authorized", "message": "The request is not authorized."}},
+			"trace":  "9c3f1c3e-2b2d-4f5a",
+		},
+	}
+	err := fmt.Errorf("Error Getting Instance: %w", newAPIError(errors.New("The request is not authorized."), response))
+	diags := diagFromErr(context.Background(), err)
+	if diags[0].Summary != "Error Getting Instance: The request is not authorized." {
+		t.Fatalf("unexpected summary %q", diags[0].Summary)
+	}
+	for _, s := range []string{"Status code: 403", "Error code: not_authorized", "Request ID: 9c3f1c3e-2b2d-4f5a", "access policy"} {
+		if !strings.Contains(diags[0].Detail, s) {
+			t.Fatalf("expected %q in the detail:\n%s", s, diags[0].Detail)
+		}
+	}
+	if strings.Contains(diags[0].Detail, "Trace:") {
+		t.Fatalf("expected the trace matching the request ID not to be repeated:\n%s", diags[0].Detail)
+	}
+
+	var nilResponse *core.DetailedResponse
+	if apiErr := newAPIError(errors.New("timeout"), nilResponse).(*APIError); apiErr.StatusCode != 0 || apiErr.Detail() != "" {
+		t.Fatalf("unexpected error %#v", apiErr)
+	}
+	if newAPIError(nil, response) != nil {
+		t.Fatal("expected no error")
+	}
+}
+
+func TestAsAPIError(t *testing.T) {
+	cases := []struct {
+		err  error
+		code string
+		hint string
+	}{
+		{
+			err:  fmt.Errorf("Error creating access group: %w", bmxerror.NewRequestFailure("Conflict", "Access group already exists", 409)),
+			code: "Conflict",
+			hint: "conflicting state",
+		},
+		{
+			err:  fmt.Errorf("Error ordering virtual guest: %w", sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: "Maximum number of virtual guests reached"}),
+			code: "SoftLayer_Exception_Public",
+			hint: "quota",
+		},
+		{
+			err: newAPIError(errors.New("Record already exists."), &corev4.DetailedResponse{
+				StatusCode: 400,
+				Result:     map[string]interface{}{"success": false, "errors": []interface{}{map[string]interface{}{"code": 81057.0, "message": "Record already exists."}}},
+			}),
+			code: "81057",
+		},
+	}
+	for _, c := range cases {
+		apiErr, ok := asAPIError(c.err)
+		if !ok {
+			t.Fatalf("expected an API error from %v", c.err)
+		}
+		if apiErr.Code != c.code || !strings.Contains(apiErr.Hint(), c.hint) {
+			t.Fatalf("unexpected code %q or hint %q for %v", apiErr.Code, apiErr.Hint(), c.err)
+		}
+	}
+	if _, ok := asAPIError(errors.New("Error calling asPatch")); ok {
+		t.Fatal("expected no API error")
+	}
+}
+
+func TestProviderResourceDiagnostics(t *testing.T) {
+	r := Provider().ResourcesMap["ibm_is_vpc"]
+	if r.Create != nil || r.CreateContext == nil || r.DeleteContext == nil {
+		t.Fatal("expected the VPC resource to report its errors with diagnostics")
+	}
+}
@@ -4,14 +4,18 @@
 package ibm
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
 	"net"
 	gohttp "net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -62,6 +66,7 @@ import (
 	vpcclassic "github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
+	httptransport "github.com/go-openapi/runtime/client"
 	jwt "github.com/golang-jwt/jwt"
 	slsession "github.com/softlayer/softlayer-go/session"
 	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
@@ -103,7 +108,7 @@ var BluemixRegion string
 
 var (
 	errEmptySoftLayerCredentials = errors.New("iaas_classic_username and iaas_classic_api_key must be provided. Please see the documentation on how to configure them")
-	errEmptyBluemixCredentials   = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token must be provided. Please see the documentation on how to configure it")
+	errEmptyBluemixCredentials   = errors.New("ibmcloud_api_key or bluemix_api_key or iam_token and iam_refresh_token or iam_profile_id or iam_profile_name must be provided. Please see the documentation on how to configure it")
 )
 
 //UserConfig ...
@@ -147,6 +152,17 @@ type Config struct {
 	//Constant Retry Delay for API calls
 	RetryDelay time.Duration
 
+	// RetryPolicy is applied by the transport of every HTTP client of the provider
+	RetryPolicy *RetryPolicy
+
+	// RateLimits are the requests per second allowed for a service, keyed by the attributes of the provider endpoints block
+	RateLimits  map[string]float64
+	rateLimiter *rateLimiter
+
+	// DefaultTags and DefaultAccessTags are attached to every resource of the provider which supports tags
+	DefaultTags       []string
+	DefaultAccessTags []string
+
 	// FunctionNameSpace ...
 	FunctionNameSpace string
 
@@ -162,12 +178,24 @@ type Config struct {
 	//IAM Refresh Token
 	IAMRefreshToken string
 
+	// IAM Trusted Profile ID
+	IAMTrustedProfileID string
+
+	// IAM Trusted Profile Name
+	IAMTrustedProfileName string
+
+	// File containing the compute resource token exchanged for a trusted profile token
+	CRTokenFilename string
+
 	// PowerService Instance
 	PowerServiceInstance string
 
 	// Zone
 	Zone       string
 	Visibility string
+
+	// Endpoints overrides the service endpoints, keyed by the attributes of the provider endpoints block
+	Endpoints map[string]string
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -177,6 +205,9 @@ type Session struct {
 
 	// BluemixSession is the the Bluemix session used to connect to the Bluemix API
 	BluemixSession *bxsession.Session
+
+	// CRTokenAuthenticator authenticates with a trusted profile when a compute resource token is used
+	CRTokenAuthenticator *CRTokenAuthenticator
 }
 
 // ClientSession ...
@@ -251,10 +282,53 @@ type ClientSession interface {
 	SchematicsV1() (*schematicsv1.SchematicsV1, error)
 	SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error)
 	CisFiltersSession() (*cisfiltersv1.FiltersV1, error)
+
+	defaultTags() ([]string, []string)
+	serviceTransport(service string, next gohttp.RoundTripper) gohttp.RoundTripper
 }
 
 type clientSession struct {
 	session *Session
+	config  *Config
+	// key is the key of the session in clientSessions
+	key string
+
+	// The clients are configured on first use, once for all the provider aliases sharing the session
+	authOnce          sync.Once
+	authErr           error
+	cfAuthErr         error
+	userDetailsOnce   sync.Once
+	authenticatorOnce sync.Once
+	authenticator     core.Authenticator
+	authenticatorErr  error
+
+	appConfigurationOnce     sync.Once
+	apigatewayOnce           sync.Once
+	bluemixClientsOnce       sync.Once
+	catalogManagementOnce    sync.Once
+	cisOnce                  sync.Once
+	containerRegistryOnce    sync.Once
+	cosConfigOnce            sync.Once
+	directlinkOnce           sync.Once
+	dlProviderOnce           sync.Once
+	enterpriseManagementOnce sync.Once
+	functionOnce             sync.Once
+	globalTaggingV1Once      sync.Once
+	iamIdentityOnce          sync.Once
+	iamPolicyManagementOnce  sync.Once
+	kmsOnce                  sync.Once
+	kpOnce                   sync.Once
+	pDNSOnce                 sync.Once
+	powerOnce                sync.Once
+	pushServiceOnce          sync.Once
+	resourceControllerOnce   sync.Once
+	resourceManagerOnce      sync.Once
+	satelliteOnce            sync.Once
+	schematicsOnce           sync.Once
+	secretsManagerOnce       sync.Once
+	transitgatewayOnce       sync.Once
+	vpcClassicOnce           sync.Once
+	vpcOnce                  sync.Once
 
 	apigatewayErr error
 	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
@@ -338,8 +412,6 @@ type clientSession struct {
 	pDNSClient *dns.DnsSvcsV1
 	pDNSErr    error
 
-	bluemixSessionErr error
-
 	pushServiceClient    *pushservicev1.PushServiceV1
 	pushServiceClientErr error
 
@@ -491,203 +563,257 @@ type clientSession struct {
 	cisFiltersErr    error
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.catalogManagementOnce.Do(session.configureCatalogManagement)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
-	return sess.session.BluemixSession, sess.bluemixSessionErr
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	return sess.session.BluemixSession, sess.authenticate()
+}
+
+// defaultTags returns the user tags and the access tags of the default_tags block of the provider
+func (sess *clientSession) defaultTags() ([]string, []string) {
+	return sess.config.DefaultTags, sess.config.DefaultAccessTags
+}
+
+// serviceTransport wraps a transport with the rate limit of the service, the retry policy and the recorder of the
+// provider, for the clients which don't come from the session
+func (sess *clientSession) serviceTransport(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	return sess.config.serviceTransport(service, next)
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
-	return sess.bmxUserDetails, sess.bmxUserFetchErr
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
+	return sess.userDetails()
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.containerRegistryOnce.Do(session.configureContainerRegistry)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.schematicsOnce.Do(sess.configureSchematics)
 	return sess.schematicsClient, sess.schematicsClientErr
 }
 
 // CisAPI provides Cloud Internet Services APIs ...
-func (sess clientSession) CisAPI() (cisv1.CisServiceAPI, error) {
+func (sess *clientSession) CisAPI() (cisv1.CisServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.cisServiceAPI, sess.cisConfigErr
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.functionOnce.Do(sess.configureFunction)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.globalTaggingV1Once.Do(sess.configureGlobalTaggingV1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // IAMAPI provides IAM PAP APIs ...
-func (sess clientSession) IAMAPI() (iamv1.IAMServiceAPI, error) {
+func (sess *clientSession) IAMAPI() (iamv1.IAMServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.iamServiceAPI, sess.iamConfigErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.iamPolicyManagementOnce.Do(sess.configureIamPolicyManagement)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMUUMAPIV2 provides IAM UUM APIs ...
-func (sess clientSession) IAMUUMAPIV2() (iamuumv2.IAMUUMServiceAPIv2, error) {
+func (sess *clientSession) IAMUUMAPIV2() (iamuumv2.IAMUUMServiceAPIv2, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.iamUUMServiceAPIV2, sess.iamUUMConfigErrV2
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
+	if sess.config.IAMToken != "" || sess.session.CRTokenAuthenticator != nil {
+		// The SoftLayer session shares the IAM token refreshed by the authentication of the Bluemix session
+		sess.authenticate()
+	}
 	return sess.session.SoftLayerSession
 }
 
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
 //apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.apigatewayOnce.Do(sess.configureApigateway)
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.pushServiceOnce.Do(session.configurePushService)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.appConfigurationOnce.Do(session.configureAppConfiguration)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) keyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) keyProtectAPI() (*kp.Client, error) {
+	sess.kpOnce.Do(sess.configureKp)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) keyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) keyManagementAPI() (*kp.Client, error) {
+	sess.kmsOnce.Do(sess.configureKms)
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcClassicV1API() (*vpcclassic.VpcClassicV1, error) {
+func (sess *clientSession) VpcClassicV1API() (*vpcclassic.VpcClassicV1, error) {
+	sess.vpcClassicOnce.Do(sess.configureVpcClassic)
 	return sess.vpcClassicAPI, sess.vpcClassicErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.vpcOnce.Do(sess.configureVpc)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.directlinkOnce.Do(sess.configureDirectlink)
 	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.dlProviderOnce.Do(sess.configureDlProvider)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.cosConfigOnce.Do(sess.configureCosConfig)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.transitgatewayOnce.Do(sess.configureTransitgateway)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.powerOnce.Do(sess.configurePower)
 	return sess.ibmpiSession, sess.powerConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.pDNSOnce.Do(sess.configurePDNS)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.bluemixClientsOnce.Do(sess.configureBluemixClients)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -695,7 +821,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -703,7 +830,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -711,7 +839,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -719,7 +848,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -727,7 +857,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -735,7 +866,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -743,7 +875,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -751,7 +884,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -759,7 +893,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -767,7 +902,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -775,7 +911,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -783,7 +920,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -791,7 +929,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -799,7 +938,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -807,7 +947,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -815,7 +956,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -823,7 +965,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -831,7 +974,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -839,7 +983,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -847,7 +992,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -855,7 +1001,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -863,134 +1010,175 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.iamIdentityOnce.Do(sess.configureIamIdentity)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.resourceManagerOnce.Do(sess.configureResourceManager)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.enterpriseManagementOnce.Do(session.configureEnterpriseManagement)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.resourceControllerOnce.Do(sess.configureResourceController)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // SecretsManager Session
-func (session clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+func (session *clientSession) SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error) {
+	session.secretsManagerOnce.Do(session.configureSecretsManager)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.satelliteOnce.Do(sess.configureSatellite)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.cisOnce.Do(sess.configureCis)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
 	return sess.cisFiltersClient.Clone(), nil
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// clientSessions caches the client sessions by provider configuration, so that the aliases of the
+// provider which use identical credentials and region share their clients and authenticate once
+var clientSessions = struct {
+	sync.Mutex
+	sessions map[string]*cachedClientSession
+}{sessions: map[string]*cachedClientSession{}}
+
+type cachedClientSession struct {
+	once    sync.Once
+	session *clientSession
+	err     error
+}
+
+// ClientSession returns the client session of the configuration, whose service clients are configured on first use
 func (c *Config) ClientSession() (interface{}, error) {
+	key := c.sessionKey()
+	clientSessions.Lock()
+	cached, ok := clientSessions.sessions[key]
+	if !ok {
+		cached = &cachedClientSession{}
+		clientSessions.sessions[key] = cached
+	}
+	clientSessions.Unlock()
+	if ok {
+		log.Printf("[INFO] Reusing the client session of a provider configuration with the same credentials in region %s\n", c.Region)
+	}
+	cached.once.Do(func() {
+		cached.session, cached.err = c.newClientSession()
+		if cached.session != nil {
+			cached.session.key = key
+		}
+	})
+	if cached.err != nil {
+		// The next provider configuration with the same credentials tries again
+		clientSessions.Lock()
+		if clientSessions.sessions[key] == cached {
+			delete(clientSessions.sessions, key)
+		}
+		clientSessions.Unlock()
+		return nil, cached.err
+	}
+	return cached.session, nil
+}
+
+// evictClientSession removes the session from clientSessions, so that the next provider configuration with the same
+// credentials gets a new session instead of its failed authentication
+func evictClientSession(session *clientSession) {
+	clientSessions.Lock()
+	defer clientSessions.Unlock()
+	if cached, ok := clientSessions.sessions[session.key]; ok && cached.session == session {
+		delete(clientSessions.sessions, session.key)
+	}
+}
+
+// sessionKey identifies the credentials, region and options of the configuration which the clients are built from
+func (c *Config) sessionKey() string {
+	key := *c
+	key.RetryPolicy, key.rateLimiter = nil, nil
+	h := sha256.New()
+	fmt.Fprintf(h, "%+v", key)
+	if c.RetryPolicy != nil {
+		fmt.Fprintf(h, "%+v", *c.RetryPolicy)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// newClientSession creates the SoftLayer and Bluemix sessions of the configuration, without configuring any client
+func (c *Config) newClientSession() (*clientSession, error) {
+	c.rateLimiter = newRateLimiter(c.RateLimits)
+	if c.rateLimiter != nil {
+		log.Printf("[INFO] Configured rate limits (requests per second): %s", strings.Join(c.rateLimiter.rateLimitedServices(), ", "))
+	}
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
+	session := &clientSession{
 		session: sess,
+		config:  c,
 	}
 
 	if sess.BluemixSession == nil {
 		//Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
-		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.containerRegistryClientErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.pushServiceClientErr = errEmptyBluemixCredentials
-		session.appConfigurationClientErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErrV1 = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamConfigErr = errEmptyBluemixCredentials
-		session.iamUUMConfigErrV2 = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagerErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
-		session.resourceControllerErr = errEmptyBluemixCredentials
-		session.catalogManagementClientErr = errEmptyBluemixCredentials
-		session.powerConfigErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.certManagementErr = errEmptyBluemixCredentials
-		session.vpcClassicErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
-		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.functionIAMNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-		session.secretsManagerClientErr = errEmptyBluemixCredentials
-		session.cisFiltersErr = errEmptyBluemixCredentials
-		session.schematicsClientErr = errEmptyBluemixCredentials
-		session.satelliteClientErr = errEmptyBluemixCredentials
-		session.iamPolicyManagementErr = errEmptyBluemixCredentials
-
-		return session, nil
+	} else {
+		BluemixRegion = sess.BluemixSession.Config.Region
+	}
+	return session, nil
+}
+
+// authenticate exchanges the credentials of the Bluemix session for tokens, once for all the clients of the session
+func (session *clientSession) authenticate() error {
+	session.authOnce.Do(func() {
+		session.configureAuthentication()
+		if session.authErr != nil {
+			evictClientSession(session)
+		}
+	})
+	return session.authErr
+}
+
+// userDetails returns the account details of the authenticated user
+func (session *clientSession) userDetails() (*UserConfig, error) {
+	session.userDetailsOnce.Do(session.configureUserDetails)
+	return session.bmxUserDetails, session.bmxUserFetchErr
+}
+
+// coreAuthenticator returns the authenticator of the clients built with the IBM go-sdk-core
+func (session *clientSession) coreAuthenticator() (core.Authenticator, error) {
+	session.authenticatorOnce.Do(session.configureAuthenticator)
+	return session.authenticator, session.authenticatorErr
+}
+
+// configureAuthentication authenticates the Bluemix session with the API key, or refreshes its IAM token
+func (session *clientSession) configureAuthentication() {
+	c, sess := session.config, session.session
+	if sess.BluemixSession == nil {
+		session.authErr = errEmptyBluemixCredentials
+		return
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
+		err := authenticateAPIKey(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
 				if err == nil || !isRetryable(err) {
@@ -1001,10 +1189,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 				err = authenticateAPIKey(sess.BluemixSession)
 			}
 			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
-				session.powerConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
-				session.ibmpiConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
+				session.authErr = fmt.Errorf("Error occured while fetching the IAM token with the API key: %q", err)
+				return
 			}
 		}
 		err = authenticateCF(sess.BluemixSession)
@@ -1018,12 +1204,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 				err = authenticateCF(sess.BluemixSession)
 			}
 			if err != nil {
-				session.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
+				// Only the Cloud Functions client authenticates with UAA tokens
+				session.cfAuthErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
 			}
 		}
 	}
 
-	if sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+	if sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" && sess.CRTokenAuthenticator == nil {
 		err := refreshToken(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
@@ -1035,25 +1222,106 @@ func (c *Config) ClientSession() (interface{}, error) {
 				err = refreshToken(sess.BluemixSession)
 			}
 			if err != nil {
-				return nil, fmt.Errorf("Error occured while refreshing the token: %q", err)
+				session.authErr = fmt.Errorf("Error occured while refreshing the token: %q", err)
+				return
 			}
 		}
 
 	}
+
+	if sess.CRTokenAuthenticator != nil {
+		if err := authenticateCRToken(sess.BluemixSession, sess.CRTokenAuthenticator); err != nil {
+			session.authErr = fmt.Errorf("Error occured while exchanging the compute resource token: %q", err)
+			return
+		}
+	}
+
+	if sess.SoftLayerSession != nil && (sess.SoftLayerSession.IAMToken != "" || sess.CRTokenAuthenticator != nil) {
+		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
+		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
+	}
+}
+
+// configureUserDetails fetches the account details of the user from the IAM token
+func (session *clientSession) configureUserDetails() {
+	if err := session.authenticate(); err != nil {
+		session.bmxUserDetails, session.bmxUserFetchErr = &UserConfig{}, err
+		return
+	}
+	c, sess := session.config, session.session
 	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
 	if err != nil {
 		session.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", err)
 	}
 	session.bmxUserDetails = userConfig
+}
 
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.IAMToken != "" {
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
+// configureAuthenticator chooses the authenticator of the go-sdk-core clients from the provider credentials
+func (session *clientSession) configureAuthenticator() {
+	c, sess := session.config, session.session
+	if sess.BluemixSession == nil {
+		session.authenticatorErr = errEmptyBluemixCredentials
+		return
 	}
+	if c.BluemixAPIKey != "" {
+		session.authenticator = &core.IamAuthenticator{
+			ApiKey: c.BluemixAPIKey,
+			URL:    c.endpointFallBack("iam", "https://iam.cloud.ibm.com") + "/identity/token",
+			Client: c.retryableHTTPClient("iam", 30*time.Second),
+		}
+	} else if sess.CRTokenAuthenticator != nil {
+		session.authenticator = sess.CRTokenAuthenticator
+	} else if err := session.authenticate(); err != nil {
+		session.authenticatorErr = err
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		session.authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+		}
+	} else {
+		session.authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
-	BluemixRegion = sess.BluemixSession.Config.Region
+// configureFunction configures the Cloud Functions client, which authenticates with UAA tokens
+func (session *clientSession) configureFunction() {
+	if err := session.authenticate(); err != nil {
+		session.functionConfigErr = err
+		return
+	}
+	if session.cfAuthErr != nil {
+		session.functionConfigErr = session.cfAuthErr
+		return
+	}
+	session.functionClient, session.functionConfigErr = FunctionClient(session.session.BluemixSession.Config)
+}
+
+// configureBluemixClients configures the clients of the bluemix-go services
+func (session *clientSession) configureBluemixClients() {
+	sess := session.session
+	if err := session.authenticate(); err != nil {
+		session.accountV1ConfigErr = err
+		session.accountConfigErr = err
+		session.cfConfigErr = err
+		session.csConfigErr = err
+		session.csv2ConfigErr = err
+		session.hpcsEndpointErr = err
+		session.cisConfigErr = err
+		session.globalSearchConfigErr = err
+		session.globalTaggingConfigErr = err
+		session.iamConfigErr = err
+		session.iamUUMConfigErrV2 = err
+		session.icdConfigErr = err
+		session.resourceCatalogConfigErr = err
+		session.resourceManagementConfigErrv2 = err
+		session.resourceControllerConfigErr = err
+		session.resourceControllerConfigErrv2 = err
+		session.userManagementErr = err
+		session.certManagementErr = err
+		session.functionIAMNamespaceErr = err
+		return
+	}
 
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
@@ -1091,6 +1359,97 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.hpcsEndpointAPI = hpcsAPI
 
+	cisAPI, err := cisv1.New(sess.BluemixSession)
+	if err != nil {
+		session.cisConfigErr = fmt.Errorf("Error occured while configuring Cloud Internet Services: %q", err)
+	}
+	session.cisServiceAPI = cisAPI
+
+	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+	if err != nil {
+		session.globalSearchConfigErr = fmt.Errorf("Error occured while configuring Global Search: %q", err)
+	}
+	session.globalSearchServiceAPI = globalSearchAPI
+
+	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+	if err != nil {
+		session.globalTaggingConfigErr = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
+	}
+	session.globalTaggingServiceAPI = globalTaggingAPI
+
+	iam, err := iamv1.New(sess.BluemixSession)
+	if err != nil {
+		session.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAM Service: %q", err)
+	}
+	session.iamServiceAPI = iam
+
+	iamuumv2, err := iamuumv2.New(sess.BluemixSession)
+	if err != nil {
+		session.iamUUMConfigErrV2 = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
+	}
+	session.iamUUMServiceAPIV2 = iamuumv2
+
+	icdAPI, err := icdv4.New(sess.BluemixSession)
+	if err != nil {
+		session.icdConfigErr = fmt.Errorf("Error occured while configuring IBM Cloud Database Services: %q", err)
+	}
+	session.icdServiceAPI = icdAPI
+
+	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+	if err != nil {
+		session.resourceCatalogConfigErr = fmt.Errorf("Error occured while configuring Resource Catalog service: %q", err)
+	}
+	session.resourceCatalogServiceAPI = resourceCatalogAPI
+
+	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+	if err != nil {
+		session.resourceManagementConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
+	}
+	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+
+	resourceControllerAPI, err := controller.New(sess.BluemixSession)
+	if err != nil {
+		session.resourceControllerConfigErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
+	}
+	session.resourceControllerServiceAPI = resourceControllerAPI
+
+	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+	if err != nil {
+		session.resourceControllerConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Controller v2 service: %q", err)
+	}
+	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+
+	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+	if err != nil {
+		session.userManagementErr = fmt.Errorf("Error occured while configuring user management service: %q", err)
+	}
+	session.userManagementAPI = userManagementAPI
+	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
+	if err != nil {
+		session.certManagementErr = fmt.Errorf("Error occured while configuring Certificate manager service: %q", err)
+	}
+	session.certManagementAPI = certManagementAPI
+
+	namespaceFunction, err := functions.New(sess.BluemixSession)
+	if err != nil {
+		session.functionIAMNamespaceErr = fmt.Errorf("Error occured while configuring Cloud Funciton Service : %q", err)
+	}
+	session.functionIAMNamespaceAPI = namespaceFunction
+}
+
+// configureKp configures the Key Protect client
+func (session *clientSession) configureKp() {
+	c, sess := session.config, session.session
+	if sess.BluemixSession == nil {
+		session.kpErr = errEmptyBluemixCredentials
+		return
+	}
+	if c.BluemixAPIKey == "" {
+		if err := session.authenticate(); err != nil {
+			session.kpErr = err
+			return
+		}
+	}
 	kpurl := contructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = contructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1098,7 +1457,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	var options kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
-			BaseURL: envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL: c.endpointFallBack("kms", kpurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
@@ -1106,18 +1465,37 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	} else {
 		options = kp.ClientConfig{
-			BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+			BaseURL:       c.endpointFallBack("kms", kpurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, kp.DefaultTransport())
+	transport := c.serviceTransport("kms", DefaultTransport())
+	if sess.CRTokenAuthenticator != nil {
+		// Key Protect only takes a static token, which expires before the trusted profile token is refreshed
+		transport = &crTokenAuthTransport{authenticator: sess.CRTokenAuthenticator, transport: transport}
+	}
+	kpAPIclient, err := kp.New(options, transport)
 	if err != nil {
 		session.kpErr = fmt.Errorf("Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
+// configureKms configures the key management client
+func (session *clientSession) configureKms() {
+	c, sess := session.config, session.session
+	if sess.BluemixSession == nil {
+		session.kmsErr = errEmptyBluemixCredentials
+		return
+	}
+	if c.BluemixAPIKey == "" {
+		if err := session.authenticate(); err != nil {
+			session.kmsErr = err
+			return
+		}
+	}
 	kmsurl := contructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = contructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1125,7 +1503,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	var kmsOptions kp.ClientConfig
 	if c.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
-			BaseURL: envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL: c.endpointFallBack("kms", kmsurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, //pragma: allowlist secret
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose: kp.VerboseFailOnly,
@@ -1133,42 +1511,38 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	} else {
 		kmsOptions = kp.ClientConfig{
-			BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+			BaseURL:       c.endpointFallBack("kms", kmsurl),
 			Authorization: sess.BluemixSession.Config.IAMAccessToken,
 			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	transport := c.serviceTransport("kms", DefaultTransport())
+	if sess.CRTokenAuthenticator != nil {
+		transport = &crTokenAuthTransport{authenticator: sess.CRTokenAuthenticator, transport: transport}
+	}
+	kmsAPIclient, err := kp.New(kmsOptions, transport)
 	if err != nil {
 		session.kmsErr = fmt.Errorf("Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" {
-		authenticator = &core.IamAuthenticator{
-			ApiKey: c.BluemixAPIKey,
-			URL:    envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://iam.cloud.ibm.com") + "/identity/token",
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
+// configureCatalogManagement configures the Catalog Management client
+func (session *clientSession) configureCatalogManagement() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.catalogManagementClientErr = err
+		return
 	}
-
 	// Construct an "options" struct for creating the service client.
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
 	}
 	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+		URL:           c.endpointFallBack("catalog_management", catalogManagementURL),
 		Authenticator: authenticator,
 	}
 
@@ -1176,7 +1550,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("catalog_management", session.catalogManagementClient.Service)
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1184,6 +1558,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.catalogManagementClientErr = fmt.Errorf("Error occurred while configuring Catalog Management API service: %q", err)
 	}
+}
+
+// configureSchematics configures the Schematics client
+func (session *clientSession) configureSchematics() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.schematicsClientErr = err
+		return
+	}
 	schematicsEndpoint := "https://schematics.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1196,20 +1580,29 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		URL:           c.endpointFallBack("schematics", schematicsEndpoint),
 	}
 
 	// Construct the service client.
 	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("schematics", schematicsClient.Service)
 		if err != nil {
 			session.schematicsClientErr = fmt.Errorf("Error occurred while configuring Schematics Service API service: %q", err)
 		}
 	}
 	session.schematicsClient = schematicsClient
+}
 
+// configureVpcClassic configures the VPC classic client
+func (session *clientSession) configureVpcClassic() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.vpcClassicErr = err
+		return
+	}
 	vpcclassicurl := contructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1226,19 +1619,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 	vpcclassicoptions := &vpcclassic.VpcClassicV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_API_ENDPOINT"}, vpcclassicurl),
+		URL:           c.endpointFallBack("vpc_classic", vpcclassicurl),
 		Authenticator: authenticator,
 	}
 	vpcclassicclient, err := vpcclassic.NewVpcClassicV1(vpcclassicoptions)
 	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
+		session.vpcClassicErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
 	}
 	if vpcclassicclient != nil && vpcclassicclient.Service != nil {
-		vpcclassicclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("vpc_classic", vpcclassicclient.Service)
 	}
 
 	session.vpcClassicAPI = vpcclassicclient
+}
 
+// configureVpc configures the VPC client
+func (session *clientSession) configureVpc() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.vpcErr = err
+		return
+	}
 	vpcurl := contructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1254,7 +1656,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		vpcurl = contructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	vpcoptions := &vpc.VpcV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+		URL:           c.endpointFallBack("vpc", vpcurl),
 		Authenticator: authenticator,
 	}
 	vpcclient, err := vpc.NewVpcV1(vpcoptions)
@@ -1262,26 +1664,45 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("vpc", vpcclient.Service)
 	}
 	session.vpcAPI = vpcclient
+}
 
+// configurePushService configures the Push Notifications client
+func (session *clientSession) configurePushService() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.pushServiceClientErr = err
+		return
+	}
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
 	}
 	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+		URL:           c.endpointFallBack("push", pnurl),
 		Authenticator: authenticator,
 	}
 	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
 	if pnclient != nil {
 		// Enable retries for API calls
-		pnclient.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("push", pnclient.Service)
 		session.pushServiceClient = pnclient
 	} else {
 		session.pushServiceClientErr = fmt.Errorf("Error occured while configuring push notification service: %q", err)
 	}
+}
+
+// configureAppConfiguration configures the App Configuration client
+func (session *clientSession) configureAppConfiguration() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.appConfigurationClientErr = err
+		return
+	}
 	if c.Visibility == "private" {
 		session.appConfigurationClientErr = fmt.Errorf("App Configuration Service API doesnot support private endpoints")
 	}
@@ -1291,11 +1712,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("", appConfigClient.Service)
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("Error occurred while configuring App Configuration service: %q", err)
 	}
+}
+
+// configureContainerRegistry configures the Container Registry client of the account of the user
+func (session *clientSession) configureContainerRegistry() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.containerRegistryClientErr = err
+		return
+	}
+	userConfig, _ := session.userDetails()
 	// Construct an "options" struct for creating the service client.
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
@@ -1309,7 +1741,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		URL:           c.endpointFallBack("container_registry", containerRegistryClientURL),
 		Account:       core.StringPtr(userConfig.userAccount),
 	}
 
@@ -1317,7 +1749,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("container_registry", session.containerRegistryClient.Service)
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1325,36 +1757,39 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.containerRegistryClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
 	}
+}
 
+// configureCosConfig configures the COS resource configuration client
+func (session *clientSession) configureCosConfig() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.cosConfigErr = err
+		return
+	}
 	//cosconfigurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, "https://config.cloud-object-storage.cloud.ibm.com/v1"),
+		URL:           c.endpointFallBack("cos_config", "https://config.cloud-object-storage.cloud.ibm.com/v1"),
 	}
 	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
 	if err != nil {
 		session.cosConfigErr = fmt.Errorf("Error occured while configuring COS config service: %q", err)
 	}
-	session.cosConfigAPI = cosconfigclient
-
-	cisAPI, err := cisv1.New(sess.BluemixSession)
-	if err != nil {
-		session.cisConfigErr = fmt.Errorf("Error occured while configuring Cloud Internet Services: %q", err)
+	if cosconfigclient != nil && cosconfigclient.Service != nil {
+		c.enableRetries("cos_config", cosconfigclient.Service)
 	}
-	session.cisServiceAPI = cisAPI
+	session.cosConfigAPI = cosconfigclient
+}
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+// configureGlobalTaggingV1 configures the Global Tagging v1 client
+func (session *clientSession) configureGlobalTaggingV1() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
 	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("Error occured while configuring Global Search: %q", err)
+		session.globalTaggingConfigErrV1 = err
+		return
 	}
-	session.globalSearchServiceAPI = globalSearchAPI
-
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
-
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -1367,7 +1802,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 
 	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+		URL:           c.endpointFallBack("global_tagging", globalTaggingEndpoint),
 		Authenticator: authenticator,
 	}
 
@@ -1377,96 +1812,96 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-	}
-
-	iam, err := iamv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAM Service: %q", err)
-	}
-	session.iamServiceAPI = iam
-
-	iamuumv2, err := iamuumv2.New(sess.BluemixSession)
-	if err != nil {
-		session.iamUUMConfigErrV2 = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
-	}
-	session.iamUUMServiceAPIV2 = iamuumv2
-
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
-
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
-
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
-
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
-
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
-
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
-	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
-	if err != nil {
-		session.certManagementErr = fmt.Errorf("Error occured while configuring Certificate manager service: %q", err)
+		c.enableRetries("global_tagging", session.globalTaggingServiceAPIV1.Service)
 	}
-	session.certManagementAPI = certManagementAPI
+}
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("Error occured while configuring Cloud Funciton Service : %q", err)
+// configureApigateway configures the API Gateway client
+func (session *clientSession) configureApigateway() {
+	c := session.config
+	if session.session.BluemixSession == nil {
+		session.apigatewayErr = errEmptyBluemixCredentials
+		return
 	}
-	session.functionIAMNamespaceAPI = namespaceFunction
-
 	apicurl := contructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		apicurl = contructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
 	}
 	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+		URL:           c.endpointFallBack("apigateway", apicurl),
 		Authenticator: &core.NoAuthAuthenticator{},
 	}
 	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
 	if err != nil {
 		session.apigatewayErr = fmt.Errorf("Error occured while configuring  APIGateway service: %q", err)
 	}
+	if apigatewayAPI != nil && apigatewayAPI.Service != nil {
+		c.enableRetries("apigateway", apigatewayAPI.Service)
+	}
 	session.apigatewayAPI = apigatewayAPI
+}
 
-	ibmpisession, err := ibmpisession.New(sess.BluemixSession.Config.IAMAccessToken, c.Region, false, 90000000000, session.bmxUserDetails.userAccount, c.Zone)
+// configurePower configures the Power Virtual Server session of the account of the user
+func (session *clientSession) configurePower() {
+	c, sess := session.config, session.session
+	if err := session.authenticate(); err != nil {
+		session.powerConfigErr, session.ibmpiConfigErr = err, err
+		return
+	}
+	userConfig, _ := session.userDetails()
+	ibmpisession, err := ibmpisession.New(sess.BluemixSession.Config.IAMAccessToken, c.Region, false, 90000000000, userConfig.userAccount, c.Zone)
 	if err != nil {
-		session.ibmpiConfigErr = err
-		return nil, err
+		session.powerConfigErr, session.ibmpiConfigErr = err, err
+		return
 	}
 
+	if rt, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+		rt.Transport = c.serviceTransport("power", rt.Transport)
+		if powerURL, ok := c.Endpoints["power"]; ok && powerURL != "" {
+			ibmpisession.Power.SetTransport(overridePowerEndpoint(rt, powerURL))
+		}
+	}
 	session.ibmpiSession = ibmpisession
+}
 
+// overridePowerEndpoint returns a copy of the Power Virtual Server runtime which sends the requests to the
+// scheme, host and path of the endpoint URL
+func overridePowerEndpoint(rt *httptransport.Runtime, powerURL string) *httptransport.Runtime {
+	u, err := url.Parse(powerURL)
+	if err != nil || u.Host == "" {
+		return rt
+	}
+	basePath := rt.BasePath
+	if u.Path != "" {
+		basePath = u.Path
+	}
+	scheme := u.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	override := httptransport.New(u.Host, basePath, []string{scheme})
+	override.Transport = rt.Transport
+	override.Consumers = rt.Consumers
+	override.Producers = rt.Producers
+	override.DefaultAuthentication = rt.DefaultAuthentication
+	override.Debug = rt.Debug
+	return override
+}
+
+// configurePDNS configures the Private DNS client
+func (session *clientSession) configurePDNS() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.pDNSErr = err
+		return
+	}
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = contructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+		URL:           c.endpointFallBack("private_dns", pdnsURL),
 		Authenticator: authenticator,
 	}
 
@@ -1475,9 +1910,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("private_dns", session.pDNSClient.Service)
 	}
+}
 
+// configureDirectlink configures the Direct Link client
+func (session *clientSession) configureDirectlink() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.directlinkErr = err
+		return
+	}
 	ver := time.Now().Format("2006-01-02")
 
 	dlURL := dl.DefaultServiceURL
@@ -1485,7 +1929,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		dlURL = contructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+		URL:           c.endpointFallBack("directlink", dlURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -1495,8 +1939,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("directlink", session.directlinkAPI.Service)
 	}
+}
+
+// configureDlProvider configures the Direct Link provider client
+func (session *clientSession) configureDlProvider() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.dlProviderErr = err
+		return
+	}
+	ver := time.Now().Format("2006-01-02")
 
 	//Direct link provider
 	dlproviderURL := dlProviderV2.DefaultServiceURL
@@ -1504,7 +1959,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		dlproviderURL = contructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
 	}
 	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           envFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+		URL:           c.endpointFallBack("directlink_provider", dlproviderURL),
 		Authenticator: authenticator,
 		Version:       &ver,
 	}
@@ -1514,15 +1969,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("directlink_provider", session.dlProviderAPI.Service)
 	}
+}
 
+// configureTransitgateway configures the Transit Gateway client
+func (session *clientSession) configureTransitgateway() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.transitgatewayErr = err
+		return
+	}
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = contructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
 	}
 	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+		URL:           c.endpointFallBack("transit_gateway", tgURL),
 		Authenticator: authenticator,
 		Version:       CreateVersionDate(),
 	}
@@ -1532,7 +1996,39 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("transit_gateway", session.transitgatewayAPI.Service)
+	}
+}
+
+// configureCis configures the clients of the Cloud Internet Services
+func (session *clientSession) configureCis() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.cisZonesErr = err
+		session.cisDNSErr = err
+		session.cisDNSBulkErr = err
+		session.cisGLBPoolErr = err
+		session.cisGLBErr = err
+		session.cisGLBHealthCheckErr = err
+		session.cisIPErr = err
+		session.cisRLErr = err
+		session.cisPageRuleErr = err
+		session.cisEdgeFunctionErr = err
+		session.cisSSLErr = err
+		session.cisWAFPackageErr = err
+		session.cisDomainSettingsErr = err
+		session.cisRoutingErr = err
+		session.cisWAFGroupErr = err
+		session.cisCacheErr = err
+		session.cisCustomPageErr = err
+		session.cisAccessRuleErr = err
+		session.cisUARuleErr = err
+		session.cisLockdownErr = err
+		session.cisRangeAppErr = err
+		session.cisWAFRuleErr = err
+		session.cisFiltersErr = err
+		return
 	}
 
 	// CIS Service instances starts here.
@@ -1562,7 +2058,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
 	}
-	cisEndPoint := envFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+	cisEndPoint := c.endpointFallBack("cis", cisURL)
 
 	// IBM Network CIS Zones service
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
@@ -1577,7 +2073,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisZonesV1Client.Service)
 	}
 
 	// IBM Network CIS DNS Record service
@@ -1592,7 +2088,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisDNSRecordsClient.Service)
 	}
 
 	// IBM Network CIS DNS Record bulk service
@@ -1609,7 +2105,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisDNSRecordBulkClient.Service)
 	}
 
 	// IBM Network CIS Global load balancer pool
@@ -1626,7 +2122,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisGLBPoolClient.Service)
 	}
 
 	// IBM Network CIS Global load balancer
@@ -1643,7 +2139,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisGLBClient.Service)
 	}
 
 	// IBM Network CIS Global load balancer health check/monitor
@@ -1660,7 +2156,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisGLBHealthCheckClient.Service)
 	}
 
 	// IBM Network CIS IP
@@ -1674,7 +2170,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisIPClient.Service)
 	}
 
 	// IBM Network CIS Zone Rate Limit
@@ -1691,7 +2187,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisRLClient.Service)
 	}
 
 	// IBM Network CIS Page Rules
@@ -1708,7 +2204,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisPageRuleClient.Service)
 	}
 
 	// IBM Network CIS Edge Function
@@ -1726,7 +2222,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisEdgeFunctionClient.Service)
 	}
 
 	// IBM Network CIS SSL certificate
@@ -1744,7 +2240,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisSSLClient.Service)
 	}
 
 	// IBM Network CIS WAF Package
@@ -1762,7 +2258,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisWAFPackageClient.Service)
 	}
 
 	// IBM Network CIS Domain settings
@@ -1780,7 +2276,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisDomainSettingsClient.Service)
 	}
 
 	// IBM Network CIS Routing
@@ -1798,7 +2294,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisRoutingClient.Service)
 	}
 
 	// IBM Network CIS WAF Group
@@ -1816,7 +2312,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisWAFGroupClient.Service)
 	}
 
 	// IBM Network CIS Cache service
@@ -1834,7 +2330,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisCacheClient.Service)
 	}
 
 	// IBM Network CIS Custom pages service
@@ -1853,7 +2349,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisCustomPageClient.Service)
 	}
 
 	// IBM Network CIS Firewall Access rule
@@ -1871,7 +2367,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisAccessRuleClient.Service)
 	}
 
 	// IBM Network CIS Firewall User Agent Blocking rule
@@ -1889,7 +2385,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisUARuleClient.Service)
 	}
 
 	// IBM Network CIS Firewall Lockdown rule
@@ -1907,7 +2403,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisLockdownClient.Service)
 	}
 
 	// IBM Network CIS Range Application rule
@@ -1925,7 +2421,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisRangeAppClient.Service)
 	}
 
 	// IBM Network CIS WAF Rule Service
@@ -1943,7 +2439,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisWAFRuleClient.Service)
 	}
 
 	// IBM Network CIS Filters
@@ -1958,9 +2454,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 				session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("cis", session.cisFiltersClient.Service)
 	}
+}
 
+// configureIamIdentity configures the IAM Identity client
+func (session *clientSession) configureIamIdentity() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.iamIdentityErr = err
+		return
+	}
 	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
 	iamURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1972,17 +2477,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		URL:           c.endpointFallBack("iam", iamURL),
 	}
 	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
 	if err != nil {
 		session.iamIdentityErr = fmt.Errorf("Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("iam", iamIdentityClient.Service)
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
+// configureIamPolicyManagement configures the IAM Policy Management client
+func (session *clientSession) configureIamPolicyManagement() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.iamPolicyManagementErr = err
+		return
+	}
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1993,17 +2507,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		URL:           c.endpointFallBack("iam", iamPolicyManagementURL),
 	}
 	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
 	if err != nil {
 		session.iamPolicyManagementErr = fmt.Errorf("Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("iam", iamPolicyManagementClient.Service)
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
+// configureResourceManager configures the Resource Manager client
+func (session *clientSession) configureResourceManager() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.resourceManagerErr = err
+		return
+	}
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -2022,17 +2545,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		URL:           c.endpointFallBack("resource_manager", rmURL),
 	}
 	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
 	if err != nil {
 		session.resourceManagerErr = fmt.Errorf("Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil {
-		resourceManagerClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("resource_manager", resourceManagerClient.Service)
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
+// configureEnterpriseManagement configures the Enterprise Management client
+func (session *clientSession) configureEnterpriseManagement() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.enterpriseManagementClientErr = err
+		return
+	}
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -2052,16 +2584,25 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		URL:           c.endpointFallBack("enterprise", enterpriseURL),
 	}
 	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
 	if err == nil {
-		enterpriseManagementClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("enterprise", enterpriseManagementClient.Service)
 	} else {
 		session.enterpriseManagementClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
+// configureResourceController configures the Resource Controller client
+func (session *clientSession) configureResourceController() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.resourceControllerErr = err
+		return
+	}
 	// resource controller API
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
@@ -2081,16 +2622,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
 		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		URL:           c.endpointFallBack("resource_controller", rcURL),
 	}
 	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
 	if err != nil {
 		session.resourceControllerErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil {
-		resourceControllerClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("resource_controller", resourceControllerClient.Service)
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
+
+// configureSecretsManager configures the Secrets Manager client
+func (session *clientSession) configureSecretsManager() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.secretsManagerClientErr = err
+		return
+	}
 	// var authenticator2 *core.BearerTokenAuthenticator
 	// Construct an "options" struct for creating the service client.
 	secretsManagerClientOptions := &secretsmanagerv1.SecretsManagerV1Options{
@@ -2101,7 +2652,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.secretsManagerClient, err = secretsmanagerv1.NewSecretsManagerV1(secretsManagerClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		c.enableRetries("", session.secretsManagerClient.Service)
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2109,25 +2660,33 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager API service: %q", err)
 	}
+}
 
+// configureSatellite configures the Satellite client
+func (session *clientSession) configureSatellite() {
+	c := session.config
+	authenticator, err := session.coreAuthenticator()
+	if err != nil {
+		session.satelliteClientErr = err
+		return
+	}
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = contructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
 	}
 
 	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+		URL:           c.endpointFallBack("satellite", containerEndpoint),
 		Authenticator: authenticator,
 	}
 
 	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
 	if err != nil {
 		session.satelliteClientErr = fmt.Errorf("Error occured while configuring satellite client: %q", err)
+		return
 	}
 	// Enable retries for API calls
-	session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-
-	return session, nil
+	c.enableRetries("satellite", session.satelliteClient.Service)
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -2139,14 +2698,19 @@ func CreateVersionDate() *string {
 func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
 
+	// Requests are retried by the transport of the HTTP clients according to the provider retry policy,
+	// retrying them again in bluemix-go and softlayer-go would multiply the attempts.
+	bluemixRetries := 0
+
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		Retries:    0,
+		RetryWait:  c.RetryDelay,
+		HTTPClient: c.retryableHTTPClient("", c.SoftLayerTimeout),
 	}
 
 	if c.IAMToken != "" {
@@ -2174,12 +2738,14 @@ func newSession(c *Config) (*Session, error) {
 			IAMRefreshToken: c.IAMRefreshToken,
 			//Comment out debug mode for v0.12
 			//Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &bluemixRetries,
+			HTTPClient:      c.retryableHTTPClient("", c.BluemixTimeout),
+			Visibility:      c.Visibility,
+			EndpointLocator: newEndpointLocator(c.Region, c.Visibility, c.Endpoints, c.rateLimiter),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -2195,12 +2761,14 @@ func newSession(c *Config) (*Session, error) {
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
 			//Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
-			Visibility:    c.Visibility,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &bluemixRetries,
+			HTTPClient:      c.retryableHTTPClient("", c.BluemixTimeout),
+			Visibility:      c.Visibility,
+			EndpointLocator: newEndpointLocator(c.Region, c.Visibility, c.Endpoints, c.rateLimiter),
 			//PowerServiceInstance: c.PowerServiceInstance,
 		}
 		sess, err := bxsession.New(bmxConfig)
@@ -2210,6 +2778,32 @@ func newSession(c *Config) (*Session, error) {
 		ibmSession.BluemixSession = sess
 	}
 
+	if c.BluemixAPIKey == "" && c.IAMToken == "" && (c.IAMTrustedProfileID != "" || c.IAMTrustedProfileName != "") {
+		log.Println("Configuring IBM Cloud Session with trusted profile")
+		crAuthenticator, err := NewCRTokenAuthenticator(c.CRTokenFilename, c.IAMTrustedProfileID, c.IAMTrustedProfileName, c.endpointFallBack("iam", "https://iam.cloud.ibm.com"))
+		if err != nil {
+			return nil, err
+		}
+		crAuthenticator.Client = c.retryableHTTPClient("iam", crAuthenticator.Client.Timeout)
+		bmxConfig := &bluemix.Config{
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &bluemixRetries,
+			HTTPClient:      withCRTokenRefresh(c.retryableHTTPClient("", c.BluemixTimeout), crAuthenticator),
+			Visibility:      c.Visibility,
+			EndpointLocator: newEndpointLocator(c.Region, c.Visibility, c.Endpoints, c.rateLimiter),
+		}
+		// The compute resource token is only exchanged when a client is first used, see configureAuthentication
+		sess, err := bxsession.New(bmxConfig)
+		if err != nil {
+			return nil, err
+		}
+		ibmSession.BluemixSession = sess
+		ibmSession.CRTokenAuthenticator = crAuthenticator
+	}
+
 	return ibmSession, nil
 }
 
@@ -2285,10 +2879,12 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 
 func refreshToken(sess *bxsession.Session) error {
 	config := sess.Config
+	// The HTTP client of the session answers the refresh of a trusted profile token, see withCRTokenRefresh
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -2321,9 +2917,10 @@ func DefaultTransport() gohttp.RoundTripper {
 
 func isRetryable(err error) bool {
 	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
+		for _, code := range defaultRetryableStatusCodes {
+			if bmErr.StatusCode() == code {
+				return true
+			}
 		}
 	}
 
@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultTagsAll       = "tags_all"
+	defaultAccessTagsAll = "access_tags_all"
+)
+
+func providerDefaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags attached to every resource of the provider which supports tags.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         resourceIBMVPCHash,
+					Description: "User tags merged into the tags of every resource. A tag of the resource overrides the default tag with the same key.",
+				},
+				"access_tags": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         resourceIBMVPCHash,
+					Description: "Access management tags attached to every resource.",
+				},
+			},
+		},
+	}
+}
+
+// expandProviderDefaultTags returns the user tags and the access tags of the default_tags block of the provider
+func expandProviderDefaultTags(d *schema.ResourceData) ([]string, []string) {
+	l, ok := d.GetOk("default_tags")
+	if !ok || len(l.([]interface{})) == 0 || l.([]interface{})[0] == nil {
+		return nil, nil
+	}
+	block := l.([]interface{})[0].(map[string]interface{})
+	var tags, accessTags []string
+	if v, ok := block["tags"].(*schema.Set); ok {
+		tags = expandStringList(v.List())
+	}
+	if v, ok := block["access_tags"].(*schema.Set); ok {
+		accessTags = expandStringList(v.List())
+	}
+	return tags, accessTags
+}
+
+// supportsDefaultTags reports whether the resource has user tags and a CRN which the default tags can be attached to
+func supportsDefaultTags(r *schema.Resource) bool {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeSet || !tags.Optional {
+		return false
+	}
+	if elem, ok := tags.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+		return false
+	}
+	if _, ok := r.Schema[defaultTagsAll]; ok {
+		return false
+	}
+	if r.Update == nil && r.UpdateContext == nil {
+		return false
+	}
+	for _, k := range []string{"crn", ResourceCRN} {
+		if s, ok := r.Schema[k]; ok && s.Type == schema.TypeString {
+			return true
+		}
+	}
+	return false
+}
+
+// withDefaultTags merges the default tags of the provider into the tags of the resource. The merged user tags
+// and the access tags are exposed in computed attributes so that a drift from the defaults shows in the plan,
+// while the tags attribute keeps the tags of the configuration. The CRUD functions keep their form, legacy or
+// context aware.
+func withDefaultTags(r *schema.Resource) *schema.Resource {
+	r.Schema[defaultTagsAll] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         resourceIBMVPCHash,
+		Description: "The user tags of the resource, including the default tags of the provider",
+	}
+	r.Schema[defaultAccessTagsAll] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         resourceIBMVPCHash,
+		Description: "The access tags of the resource, including the default access tags of the provider",
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = customdiff.Sequence(customizeDiff, defaultTagsCustomizeDiff)
+	} else {
+		r.CustomizeDiff = defaultTagsCustomizeDiff
+	}
+
+	// Create and Update read the configured tags before the resource sets the tags read from the API
+	onCreate := func(d *schema.ResourceData, meta interface{}) func() error {
+		configured := tagSet(d.Get("tags"))
+		return func() error {
+			if d.Id() == "" {
+				return nil
+			}
+			return applyDefaultTags(d, meta, configured, tagSet(nil), tagSet(nil))
+		}
+	}
+	onUpdate := func(d *schema.ResourceData, meta interface{}) func() error {
+		oldTags, configured := d.GetChange("tags")
+		oldTagsAll, _ := d.GetChange(defaultTagsAll)
+		oldAccessTagsAll, _ := d.GetChange(defaultAccessTagsAll)
+		oldDefaults := tagSet(oldTagsAll).Difference(tagSet(oldTags))
+		return func() error {
+			return applyDefaultTags(d, meta, tagSet(configured), oldDefaults, tagSet(oldAccessTagsAll))
+		}
+	}
+	onRead := func(d *schema.ResourceData, meta interface{}) func() error {
+		configured := tagSet(d.Get("tags"))
+		return func() error {
+			if d.Id() == "" {
+				return nil
+			}
+			return readDefaultTags(d, meta, configured)
+		}
+	}
+
+	if createFunc := r.CreateContext; createFunc != nil {
+		r.CreateContext = withDefaultTagsContext(createFunc, onCreate)
+	} else if createFunc := r.Create; createFunc != nil {
+		r.Create = schema.CreateFunc(withDefaultTagsLegacy(createFunc, onCreate))
+	}
+	if readFunc := r.ReadContext; readFunc != nil {
+		r.ReadContext = schema.ReadContextFunc(withDefaultTagsContext(schema.CreateContextFunc(readFunc), onRead))
+	} else if readFunc := r.Read; readFunc != nil {
+		r.Read = schema.ReadFunc(withDefaultTagsLegacy(readFunc, onRead))
+	}
+	if updateFunc := r.UpdateContext; updateFunc != nil {
+		r.UpdateContext = schema.UpdateContextFunc(withDefaultTagsContext(schema.CreateContextFunc(updateFunc), onUpdate))
+	} else if updateFunc := r.Update; updateFunc != nil {
+		r.Update = schema.UpdateFunc(withDefaultTagsLegacy(updateFunc, onUpdate))
+	}
+	return r
+}
+
+// withDefaultTagsContext runs the default tags function prepared by before once the context aware CRUD function succeeded
+func withDefaultTagsContext(f schema.CreateContextFunc, before func(*schema.ResourceData, interface{}) func() error) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		after := before(d, meta)
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, diagFromErr(ctx, after())...)
+	}
+}
+
+// withDefaultTagsLegacy runs the default tags function prepared by before once the legacy CRUD function succeeded
+func withDefaultTagsLegacy(f func(*schema.ResourceData, interface{}) error, before func(*schema.ResourceData, interface{}) func() error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		after := before(d, meta)
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return after()
+	}
+}
+
+func defaultTagsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	sess, ok := meta.(ClientSession)
+	if !ok {
+		return nil
+	}
+	tags, accessTags := sess.defaultTags()
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed(defaultTagsAll)
+	}
+	if tagsAll := mergeDefaultTags(tags, tagSet(diff.Get("tags"))); !tagsAll.Equal(tagSet(diff.Get(defaultTagsAll))) {
+		if err := diff.SetNew(defaultTagsAll, tagsAll.List()); err != nil {
+			return err
+		}
+	}
+	if accessTagsAll := tagSet(accessTags); !accessTagsAll.Equal(tagSet(diff.Get(defaultAccessTagsAll))) {
+		return diff.SetNew(defaultAccessTagsAll, accessTagsAll.List())
+	}
+	return nil
+}
+
+// applyDefaultTags attaches the default tags which are not yet attached to the resource and detaches the ones
+// which were removed from the provider or are now overridden by a tag of the resource
+func applyDefaultTags(d *schema.ResourceData, meta interface{}, configured, oldDefaults, oldAccessTags *schema.Set) error {
+	tags, accessTags := meta.(ClientSession).defaultTags()
+	tagsAll := mergeDefaultTags(tags, configured)
+	defaults := tagsAll.Difference(configured)
+	accessTagsAll := tagSet(accessTags)
+
+	crn := defaultTagsCRN(d)
+	if crn == "" {
+		log.Printf("[WARN] Skipping the default tags of %s, which has no CRN", d.Id())
+		return nil
+	}
+	// A default tag which the resource now repeats stays attached as a tag of the resource
+	if err := updateDefaultTags(meta, crn, "user", defaults.Difference(oldDefaults), oldDefaults.Difference(defaults).Difference(configured)); err != nil {
+		return err
+	}
+	if err := updateDefaultTags(meta, crn, "access", accessTagsAll.Difference(oldAccessTags), oldAccessTags.Difference(accessTagsAll)); err != nil {
+		return err
+	}
+
+	d.Set("tags", withoutDefaultTags(tags, configured, tagSet(d.Get("tags"))))
+	d.Set(defaultTagsAll, tagsAll)
+	d.Set(defaultAccessTagsAll, accessTagsAll)
+	return nil
+}
+
+// readDefaultTags reads the tags attached to the resource into the computed attributes, and removes the default
+// tags of the provider configuration which the resource doesn't repeat from the tags attribute
+func readDefaultTags(d *schema.ResourceData, meta interface{}, configured *schema.Set) error {
+	tags, accessTags := meta.(ClientSession).defaultTags()
+	current := tagSet(d.Get("tags"))
+
+	crn := defaultTagsCRN(d)
+	if crn == "" || (len(tags) == 0 && len(accessTags) == 0 && tagSet(d.Get(defaultTagsAll)).Equal(current) && tagSet(d.Get(defaultAccessTagsAll)).Len() == 0) {
+		d.Set(defaultTagsAll, current)
+		return nil
+	}
+
+	tagsAll, err := GetGlobalTagsUsingCRN(meta, crn, "", "user")
+	if err != nil {
+		return fmt.Errorf("Error on get of resource (%s) tags: %w", d.Id(), err)
+	}
+	accessTagsAll, err := GetGlobalTagsUsingCRN(meta, crn, "", "access")
+	if err != nil {
+		return fmt.Errorf("Error on get of resource (%s) access tags: %w", d.Id(), err)
+	}
+	d.Set("tags", withoutDefaultTags(tags, configured, current))
+	d.Set(defaultTagsAll, tagsAll)
+	d.Set(defaultAccessTagsAll, accessTagsAll)
+	return nil
+}
+
+func updateDefaultTags(meta interface{}, crn, tagType string, add, remove *schema.Set) error {
+	if add.Len() == 0 && remove.Len() == 0 {
+		return nil
+	}
+	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("Error getting global tagging client settings: %s", err)
+	}
+	resources := []globaltaggingv1.Resource{{ResourceID: &crn}}
+	if remove.Len() > 0 {
+		detachTagOptions := &globaltaggingv1.DetachTagOptions{
+			Resources: resources,
+			TagNames:  expandStringList(remove.List()),
+			TagType:   &tagType,
+		}
+		_, response, err := gtClient.DetachTag(detachTagOptions)
+		if err != nil {
+			return fmt.Errorf("Error detaching the default %s tags %v from %s: %w", tagType, detachTagOptions.TagNames, crn, newAPIError(err, response))
+		}
+	}
+	if add.Len() > 0 {
+		attachTagOptions := &globaltaggingv1.AttachTagOptions{
+			Resources: resources,
+			TagNames:  expandStringList(add.List()),
+			TagType:   &tagType,
+		}
+		_, response, err := gtClient.AttachTag(attachTagOptions)
+		if err != nil {
+			return fmt.Errorf("Error attaching the default %s tags %v to %s: %w", tagType, attachTagOptions.TagNames, crn, newAPIError(err, response))
+		}
+	}
+	return nil
+}
+
+// mergeDefaultTags returns the tags of the resource and the default tags whose key is not used by a tag of the resource
+func mergeDefaultTags(defaults []string, tags *schema.Set) *schema.Set {
+	keys := map[string]bool{}
+	for _, tag := range tags.List() {
+		keys[tagKey(tag.(string))] = true
+	}
+	merged := tagSet(tags)
+	for _, tag := range defaults {
+		if !keys[tagKey(tag)] {
+			merged.Add(tag)
+		}
+	}
+	return merged
+}
+
+// withoutDefaultTags removes the default tags from the tags read from a resource, except the ones which are also
+// configured tags of the resource
+func withoutDefaultTags(defaults []string, configured, tags *schema.Set) *schema.Set {
+	return tags.Difference(tagSet(defaults).Difference(configured))
+}
+
+// tagKey returns the key of a tag of the form key:value, tags without a colon are their own key
+func tagKey(tag string) string {
+	return strings.ToLower(strings.TrimSpace(strings.SplitN(tag, ":", 2)[0]))
+}
+
+// tagSet copies tags into a set hashed like the tags attributes of the resources, whatever their hash function is
+func tagSet(v interface{}) *schema.Set {
+	switch tags := v.(type) {
+	case *schema.Set:
+		return newStringSet(resourceIBMVPCHash, expandStringList(tags.List()))
+	case []string:
+		return newStringSet(resourceIBMVPCHash, tags)
+	}
+	return newStringSet(resourceIBMVPCHash, nil)
+}
+
+func defaultTagsCRN(d *schema.ResourceData) string {
+	if crn, ok := d.Get("crn").(string); ok && crn != "" {
+		return crn
+	}
+	crn, _ := d.Get(ResourceCRN).(string)
+	return crn
+}
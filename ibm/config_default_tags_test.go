@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func sortedTags(s *schema.Set) []string {
+	tags := expandStringList(s.List())
+	sort.Strings(tags)
+	return tags
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaults := []string{"cost-center:1234", "env:dev", "finops"}
+	cases := []struct {
+		tags     []string
+		expected []string
+	}{
+		{nil, []string{"cost-center:1234", "env:dev", "finops"}},
+		{[]string{"app:web"}, []string{"app:web", "cost-center:1234", "env:dev", "finops"}},
+		{[]string{"env:prod"}, []string{"cost-center:1234", "env:prod", "finops"}},
+		{[]string{"Cost-Center:9999", "finops:true"}, []string{"Cost-Center:9999", "env:dev", "finops:true"}},
+	}
+	for _, c := range cases {
+		merged := mergeDefaultTags(defaults, tagSet(c.tags))
+		if got := sortedTags(merged); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("mergeDefaultTags(%v) = %v, expected %v", c.tags, got, c.expected)
+		}
+	}
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	defaults := []string{"cost-center:1234", "env:dev"}
+	remote := tagSet([]string{"cost-center:1234", "env:dev", "app:web", "env:prod"})
+
+	got := sortedTags(withoutDefaultTags(defaults, tagSet(nil), remote))
+	if expected := []string{"app:web", "env:prod"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	got = sortedTags(withoutDefaultTags(defaults, tagSet([]string{"env:dev"}), remote))
+	if expected := []string{"app:web", "env:dev", "env:prod"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected the default tag repeated by the resource to be kept, got %v", got)
+	}
+}
+
+func TestDefaultTagsCustomizeDiff(t *testing.T) {
+	r := Provider().ResourcesMap["ibm_is_vpc"]
+	meta := &clientSession{config: &Config{DefaultTags: []string{"cost-center:1234", "env:dev"}}}
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "tf-vpc-1",
+		"tags": []interface{}{"env:prod"},
+	}), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.Attributes[defaultTagsAll+".#"].New != "2" {
+		t.Fatalf("expected the overridden default tag to be merged, got %#v", diff.Attributes[defaultTagsAll+".#"])
+	}
+
+	diff, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "tf-vpc-1",
+		"tags": []interface{}{"env:dev"},
+	}), meta)
+	if err != nil {
+		t.Fatalf("expected a tag of the resource which is a default tag to be merged, got %s", err)
+	}
+	if diff.Attributes[defaultTagsAll+".#"].New != "2" {
+		t.Fatalf("expected the repeated default tag to be merged once, got %#v", diff.Attributes[defaultTagsAll+".#"])
+	}
+}
+
+func TestExpandProviderDefaultTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"default_tags": []interface{}{map[string]interface{}{
+			"tags":        []interface{}{"cost-center:1234"},
+			"access_tags": []interface{}{"project:finops"},
+		}},
+	})
+	tags, accessTags := expandProviderDefaultTags(d)
+	if !reflect.DeepEqual(tags, []string{"cost-center:1234"}) || !reflect.DeepEqual(accessTags, []string{"project:finops"}) {
+		t.Fatalf("unexpected default tags %v and access tags %v", tags, accessTags)
+	}
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{})
+	if tags, accessTags := expandProviderDefaultTags(d); tags != nil || accessTags != nil {
+		t.Fatalf("expected no default tags, got %v and %v", tags, accessTags)
+	}
+}
+
+func TestProviderDefaultTagsResources(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for _, name := range []string{"ibm_is_vpc", "ibm_is_instance", "ibm_resource_instance", "ibm_database"} {
+		r := resources[name]
+		for _, k := range []string{defaultTagsAll, defaultAccessTagsAll} {
+			if s, ok := r.Schema[k]; !ok || !s.Computed {
+				t.Errorf("expected %s to have a computed %s attribute", name, k)
+			}
+		}
+		if r.CustomizeDiff == nil {
+			t.Errorf("expected %s to plan the default tags", name)
+		}
+	}
+	// The default tags keep the form of the CRUD functions
+	if r := resources["ibm_resource_instance"]; r.Create == nil || r.Read == nil || r.Update == nil || r.CreateContext != nil {
+		t.Error("expected ibm_resource_instance to keep its legacy functions")
+	}
+	for _, name := range []string{"ibm_resource_tag", "ibm_compute_vm_instance"} {
+		if _, ok := resources[name].Schema[defaultTagsAll]; ok {
+			t.Errorf("expected %s not to support the default tags", name)
+		}
+	}
+}
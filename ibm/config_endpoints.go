@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+// endpointsFilePathKey is the attribute of the endpoints block which points to a JSON file of service endpoints
+const endpointsFilePathKey = "file_path"
+
+// serviceEndpointEnvs maps every attribute of the provider endpoints block to the
+// environment variables that were historically used to override the same endpoint.
+var serviceEndpointEnvs = map[string][]string{
+	"account":             {"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"},
+	"apigateway":          {"IBMCLOUD_API_GATEWAY_ENDPOINT"},
+	"catalog_management":  {"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"},
+	"certificate_manager": {"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT"},
+	"cf":                  {"IBMCLOUD_MCCP_API_ENDPOINT", "IBMCLOUD_CF_API_ENDPOINT"},
+	"cis":                 {"IBMCLOUD_CIS_API_ENDPOINT"},
+	"container":           {"IBMCLOUD_CS_API_ENDPOINT"},
+	"container_registry":  {"IBMCLOUD_CR_API_ENDPOINT"},
+	"cos_config":          {"IBMCLOUD_COS_CONFIG_ENDPOINT"},
+	"directlink":          {"IBMCLOUD_DL_API_ENDPOINT"},
+	"directlink_provider": {"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"},
+	"enterprise":          {"IBMCLOUD_ENTERPRISE_API_ENDPOINT"},
+	"functions":           {"IBMCLOUD_FUNCTIONS_API_ENDPOINT"},
+	"global_search":       {"IBMCLOUD_GS_API_ENDPOINT"},
+	"global_tagging":      {"IBMCLOUD_GT_API_ENDPOINT"},
+	"hpcs":                {"IBMCLOUD_HPCS_API_ENDPOINT"},
+	"iam":                 {"IBMCLOUD_IAM_API_ENDPOINT"},
+	"iampap":              {"IBMCLOUD_IAMPAP_API_ENDPOINT"},
+	"icd":                 {"IBMCLOUD_ICD_API_ENDPOINT"},
+	"kms":                 {"IBMCLOUD_KP_API_ENDPOINT"},
+	"power":               {"IBMCLOUD_POWER_API_ENDPOINT"},
+	"private_dns":         {"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"},
+	"push":                {"IBMCLOUD_PUSH_API_ENDPOINT"},
+	"resource_catalog":    {"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"},
+	"resource_controller": {"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"},
+	"resource_manager":    {"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"},
+	"satellite":           {"IBMCLOUD_SATELLITE_API_ENDPOINT"},
+	"schematics":          {"IBMCLOUD_SCHEMATICS_API_ENDPOINT"},
+	"transit_gateway":     {"IBMCLOUD_TG_API_ENDPOINT"},
+	"uaa":                 {"IBMCLOUD_UAA_ENDPOINT"},
+	"user_management":     {"IBMCLOUD_USER_MANAGEMENT_ENDPOINT"},
+	"vpc":                 {"IBMCLOUD_IS_NG_API_ENDPOINT"},
+	"vpc_classic":         {"IBMCLOUD_IS_API_ENDPOINT"},
+}
+
+// serviceEndpointKeys returns the sorted list of services that accept an endpoint override
+func serviceEndpointKeys() []string {
+	keys := make([]string, 0, len(serviceEndpointEnvs))
+	for k := range serviceEndpointEnvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// providerEndpointsSchema builds the schema of the provider endpoints block, one attribute per service
+func providerEndpointsSchema() *schema.Schema {
+	attributes := map[string]*schema.Schema{
+		endpointsFilePathKey: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path of a JSON file which maps service names to endpoint URLs. Attributes set in this block take precedence over the file.",
+		},
+	}
+	for _, k := range serviceEndpointKeys() {
+		attributes[k] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateEndpointURL,
+			Description:  fmt.Sprintf("Overrides the %s service endpoint. Takes precedence over the %s environment variable.", k, strings.Join(serviceEndpointEnvs[k], ", ")),
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Overrides the endpoints of the IBM Cloud services used by this provider configuration.",
+		Elem: &schema.Resource{
+			Schema: attributes,
+		},
+	}
+}
+
+// validateEndpointURL validates that an endpoint override is an absolute http(s) URL
+func validateEndpointURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		errors = append(errors, fmt.Errorf("%q must be an absolute http or https URL, got %q", k, value))
+	}
+	return
+}
+
+// expandProviderEndpoints reads the provider endpoints block and the optional endpoints
+// file and returns the effective service endpoint overrides.
+func expandProviderEndpoints(d *schema.ResourceData) (map[string]string, error) {
+	result := map[string]string{}
+	l, ok := d.GetOk("endpoints")
+	if !ok || len(l.([]interface{})) == 0 || l.([]interface{})[0] == nil {
+		return result, nil
+	}
+	block := l.([]interface{})[0].(map[string]interface{})
+
+	if path, ok := block[endpointsFilePathKey].(string); ok && path != "" {
+		fileEndpoints, err := loadEndpointsFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range fileEndpoints {
+			result[k] = v
+		}
+	}
+	for _, k := range serviceEndpointKeys() {
+		if v, ok := block[k].(string); ok && v != "" {
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
+// loadEndpointsFile parses a JSON endpoints file of the form {"vpc": "https://...", "iam": "https://..."}
+func loadEndpointsFile(path string) (map[string]string, error) {
+	fullPath, err := homedir.Expand(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding endpoints file path %s: %s", path, err)
+	}
+	content, err := ioutil.ReadFile(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading endpoints file %s: %s", path, err)
+	}
+	var fileEndpoints map[string]string
+	if err := json.Unmarshal(content, &fileEndpoints); err != nil {
+		return nil, fmt.Errorf("Error parsing endpoints file %s: %s", path, err)
+	}
+	var unknown []string
+	for k, v := range fileEndpoints {
+		if _, ok := serviceEndpointEnvs[k]; !ok {
+			unknown = append(unknown, k)
+			continue
+		}
+		if _, errs := validateEndpointURL(v, k); len(errs) > 0 {
+			return nil, fmt.Errorf("Invalid endpoint in endpoints file %s: %s", path, errs[0])
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("Unknown service(s) %q in endpoints file %s, supported services are %q", unknown, path, serviceEndpointKeys())
+	}
+	return fileEndpoints, nil
+}
+
+// endpointFallBack returns the endpoint configured for the service in the provider block,
+// then falls back to the service environment variables and finally to the default value.
+func (c *Config) endpointFallBack(service, defaultValue string) string {
+	if v, ok := c.Endpoints[service]; ok && v != "" {
+		return v
+	}
+	return envFallBack(serviceEndpointEnvs[service], defaultValue)
+}
+
+// endpointLocator wraps the bluemix-go endpoint locator so that the services built
+// from the Bluemix session honour the provider endpoints block.
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	overrides map[string]string
+	limiter   *rateLimiter
+}
+
+func newEndpointLocator(region, visibility string, overrides map[string]string, limiter *rateLimiter) endpoints.EndpointLocator {
+	return &endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(region, visibility),
+		overrides:       overrides,
+		limiter:         limiter,
+	}
+}
+
+// lookup returns the endpoint of the service and registers it with the rate limiter, as the clients of
+// the Bluemix session share a single HTTP client.
+func (e *endpointLocator) lookup(service string, fallback func() (string, error)) (string, error) {
+	endpoint, ok := e.overrides[service]
+	if !ok || endpoint == "" {
+		var err error
+		if endpoint, err = fallback(); err != nil {
+			return endpoint, err
+		}
+	}
+	e.limiter.registerEndpoint(service, endpoint)
+	return endpoint, nil
+}
+
+func (e *endpointLocator) AccountManagementEndpoint() (string, error) {
+	return e.lookup("account", e.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (e *endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return e.lookup("certificate_manager", e.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (e *endpointLocator) CFAPIEndpoint() (string, error) {
+	return e.lookup("cf", e.EndpointLocator.CFAPIEndpoint)
+}
+
+func (e *endpointLocator) ContainerEndpoint() (string, error) {
+	return e.lookup("container", e.EndpointLocator.ContainerEndpoint)
+}
+
+func (e *endpointLocator) CisEndpoint() (string, error) {
+	return e.lookup("cis", e.EndpointLocator.CisEndpoint)
+}
+
+func (e *endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return e.lookup("global_search", e.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (e *endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return e.lookup("global_tagging", e.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (e *endpointLocator) IAMEndpoint() (string, error) {
+	return e.lookup("iam", e.EndpointLocator.IAMEndpoint)
+}
+
+func (e *endpointLocator) IAMPAPEndpoint() (string, error) {
+	return e.lookup("iampap", e.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (e *endpointLocator) ICDEndpoint() (string, error) {
+	return e.lookup("icd", e.EndpointLocator.ICDEndpoint)
+}
+
+func (e *endpointLocator) MCCPAPIEndpoint() (string, error) {
+	return e.lookup("cf", e.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (e *endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return e.lookup("resource_manager", e.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (e *endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return e.lookup("resource_controller", e.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (e *endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return e.lookup("resource_catalog", e.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (e *endpointLocator) UAAEndpoint() (string, error) {
+	return e.lookup("uaa", e.EndpointLocator.UAAEndpoint)
+}
+
+func (e *endpointLocator) SchematicsEndpoint() (string, error) {
+	return e.lookup("schematics", e.EndpointLocator.SchematicsEndpoint)
+}
+
+func (e *endpointLocator) UserManagementEndpoint() (string, error) {
+	return e.lookup("user_management", e.EndpointLocator.UserManagementEndpoint)
+}
+
+func (e *endpointLocator) HpcsEndpoint() (string, error) {
+	return e.lookup("hpcs", e.EndpointLocator.HpcsEndpoint)
+}
+
+func (e *endpointLocator) FunctionsEndpoint() (string, error) {
+	return e.lookup("functions", e.EndpointLocator.FunctionsEndpoint)
+}
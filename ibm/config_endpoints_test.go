@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+func TestLoadEndpointsFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ibm-endpoints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	valid := filepath.Join(dir, "valid.json")
+	ioutil.WriteFile(valid, []byte(`{"vpc": "https://us-south.iaas.test.cloud.ibm.com/v1", "iam": "https://iam.test.cloud.ibm.com"}`), 0600)
+	endpoints, err := loadEndpointsFile(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if endpoints["vpc"] != "https://us-south.iaas.test.cloud.ibm.com/v1" {
+		t.Fatalf("unexpected vpc endpoint %q", endpoints["vpc"])
+	}
+
+	unknown := filepath.Join(dir, "unknown.json")
+	ioutil.WriteFile(unknown, []byte(`{"vpcs": "https://us-south.iaas.test.cloud.ibm.com/v1"}`), 0600)
+	if _, err := loadEndpointsFile(unknown); err == nil || !strings.Contains(err.Error(), "vpcs") {
+		t.Fatalf("expected unknown service error, got %v", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.json")
+	ioutil.WriteFile(invalid, []byte(`{"cis": "api.cis.cloud.ibm.com"}`), 0600)
+	if _, err := loadEndpointsFile(invalid); err == nil {
+		t.Fatal("expected invalid URL error")
+	}
+}
+
+func TestEndpointFallBack(t *testing.T) {
+	os.Setenv("IBMCLOUD_IS_NG_API_ENDPOINT", "https://env.iaas.cloud.ibm.com/v1")
+	defer os.Unsetenv("IBMCLOUD_IS_NG_API_ENDPOINT")
+
+	c := &Config{}
+	if got := c.endpointFallBack("vpc", "https://default"); got != "https://env.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("expected environment endpoint, got %q", got)
+	}
+	c.Endpoints = map[string]string{"vpc": "https://hcl.iaas.cloud.ibm.com/v1"}
+	if got := c.endpointFallBack("vpc", "https://default"); got != "https://hcl.iaas.cloud.ibm.com/v1" {
+		t.Fatalf("expected provider endpoint, got %q", got)
+	}
+	if got := c.endpointFallBack("cis", "https://default"); got != "https://default" {
+		t.Fatalf("expected default endpoint, got %q", got)
+	}
+
+	locator := newEndpointLocator("us-south", "public", map[string]string{"container": "https://containers.test.cloud.ibm.com"}, nil)
+	if ep, _ := locator.ContainerEndpoint(); ep != "https://containers.test.cloud.ibm.com" {
+		t.Fatalf("expected overridden container endpoint, got %q", ep)
+	}
+	if ep, _ := locator.IAMEndpoint(); ep == "" {
+		t.Fatal("expected default IAM endpoint")
+	}
+}
+
+func TestOverridePowerEndpoint(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path != "/pcloud/v1/cloud-instances/1234" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// The test server only accepts http, which the override must keep
+	rt := overridePowerEndpoint(httptransport.New("us-south.power-iaas.cloud.ibm.com", "/", []string{"https"}), server.URL+"/pcloud")
+	if rt.Host != strings.TrimPrefix(server.URL, "http://") || rt.BasePath != "/pcloud" {
+		t.Fatalf("unexpected runtime %s %s", rt.Host, rt.BasePath)
+	}
+	_, err := rt.Submit(&runtime.ClientOperation{
+		ID:                 "pcloud.cloudinstances.get",
+		Method:             http.MethodGet,
+		PathPattern:        "/v1/cloud-instances/1234",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             runtime.ClientRequestWriterFunc(func(runtime.ClientRequest, strfmt.Registry) error { return nil }),
+		Reader: runtime.ClientResponseReaderFunc(func(runtime.ClientResponse, runtime.Consumer) (interface{}, error) {
+			return nil, nil
+		}),
+	})
+	if err != nil || calls != 1 {
+		t.Fatalf("expected the request to be sent over http, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"math"
+	gohttp "net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tokenBucket allows rate requests per second with bursts of up to one second of requests
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+// Tokens are reserved in order so that concurrent callers are served first come, first served.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a reserved token which was not used
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// rateLimiter keeps a token bucket per service of the provider rate_limits argument
+type rateLimiter struct {
+	buckets map[string]*tokenBucket
+
+	mu    sync.RWMutex
+	hosts map[string]string
+}
+
+// newRateLimiter returns nil when no rate limit is configured
+func newRateLimiter(limits map[string]float64) *rateLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	limiter := &rateLimiter{
+		buckets: map[string]*tokenBucket{},
+		hosts:   map[string]string{},
+	}
+	for service, rate := range limits {
+		limiter.buckets[service] = newTokenBucket(rate)
+	}
+	return limiter
+}
+
+// registerEndpoint maps the host of a service endpoint to the service, for clients which share
+// an HTTP client between several services such as the Bluemix session clients.
+func (l *rateLimiter) registerEndpoint(service, endpoint string) {
+	if l == nil || l.buckets[service] == nil {
+		return
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hosts[strings.ToLower(u.Host)] = service
+}
+
+func (l *rateLimiter) serviceForHost(host string) string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.hosts[strings.ToLower(host)]
+}
+
+// wait blocks until the request is allowed by the rate limit of the service
+func (l *rateLimiter) wait(service string, req *gohttp.Request) error {
+	bucket := l.buckets[service]
+	if bucket == nil {
+		return nil
+	}
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	log.Printf("[DEBUG] Rate limit of %g requests per second reached for %s, waiting %s before %s %s", bucket.rate, service, delay, req.Method, req.URL.Redacted())
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		bucket.cancel()
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTransport is a RoundTripper which waits for the rate limit of the service before sending a request
+type rateLimitTransport struct {
+	next    gohttp.RoundTripper
+	limiter *rateLimiter
+	// service is empty when the service is looked up from the host of the request
+	service string
+}
+
+// newRateLimitTransport wraps the transport with the rate limit of the service
+func newRateLimitTransport(next gohttp.RoundTripper, limiter *rateLimiter, service string) gohttp.RoundTripper {
+	if next == nil {
+		next = DefaultTransport()
+	}
+	if limiter == nil || (service != "" && limiter.buckets[service] == nil) {
+		return next
+	}
+	return &rateLimitTransport{
+		next:    next,
+		limiter: limiter,
+		service: service,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *rateLimitTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	service := t.service
+	if service == "" {
+		service = t.limiter.serviceForHost(req.URL.Host)
+	}
+	if err := t.limiter.wait(service, req); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// validateRateLimits validates the services and rates of the provider rate_limits argument
+func validateRateLimits(v interface{}, k string) (ws []string, errors []error) {
+	for service, rate := range v.(map[string]interface{}) {
+		if _, ok := serviceEndpointEnvs[service]; !ok {
+			errors = append(errors, fmt.Errorf("%q contains the unknown service %q, supported services are: %s", k, service, strings.Join(serviceEndpointKeys(), ", ")))
+			continue
+		}
+		var r float64
+		switch rate := rate.(type) {
+		case float64:
+			r = rate
+		case int:
+			r = float64(rate)
+		case string:
+			var err error
+			if r, err = strconv.ParseFloat(rate, 64); err != nil {
+				errors = append(errors, fmt.Errorf("%q must be a number for service %q, got %q", k, service, rate))
+				continue
+			}
+		default:
+			continue
+		}
+		if r <= 0 {
+			errors = append(errors, fmt.Errorf("%q must be greater than 0 for service %q, got %g", k, service, r))
+		}
+	}
+	return
+}
+
+// expandProviderRateLimits returns the requests per second allowed for every service of the provider rate_limits argument
+func expandProviderRateLimits(d *schema.ResourceData) map[string]float64 {
+	limits := map[string]float64{}
+	for service, rate := range d.Get("rate_limits").(map[string]interface{}) {
+		if r, ok := rate.(float64); ok && r > 0 {
+			limits[service] = r
+		}
+	}
+	return limits
+}
+
+// rateLimitedServices returns the services of the rate limiter in a stable order, for logging
+func (l *rateLimiter) rateLimitedServices() []string {
+	services := make([]string, 0, len(l.buckets))
+	for service, bucket := range l.buckets {
+		services = append(services, fmt.Sprintf("%s=%g", service, bucket.rate))
+	}
+	sort.Strings(services)
+	return services
+}
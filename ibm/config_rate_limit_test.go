@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testTimestampServer records the arrival time of every request
+func testTimestampServer() (*httptest.Server, func() []time.Time) {
+	var mu sync.Mutex
+	var timestamps []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		timestamps = append(timestamps, time.Now())
+		mu.Unlock()
+	}))
+	return server, func() []time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+		return timestamps
+	}
+}
+
+func sendConcurrently(t *testing.T, client *http.Client, url string, n int) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(url)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server, timestamps := testTimestampServer()
+	defer server.Close()
+
+	c := &Config{RateLimits: map[string]float64{"vpc": 20}}
+	c.rateLimiter = newRateLimiter(c.RateLimits)
+	client := c.retryableHTTPClient("vpc", 0)
+
+	// 20 requests are allowed right away, the other 20 are spread over the next second
+	sendConcurrently(t, client, server.URL, 40)
+	ts := timestamps()
+	if len(ts) != 40 {
+		t.Fatalf("expected 40 requests, got %d", len(ts))
+	}
+	if elapsed := ts[39].Sub(ts[0]); elapsed < 900*time.Millisecond {
+		t.Fatalf("expected 40 requests at 20 requests per second to take about 1s, took %s", elapsed)
+	}
+	// Besides the initial burst, no second may contain more than 20 requests
+	for i := 20; i+20 < len(ts); i++ {
+		if window := ts[i+20].Sub(ts[i]); window < 900*time.Millisecond {
+			t.Fatalf("requests %d to %d were sent within %s", i, i+20, window)
+		}
+	}
+}
+
+func TestRateLimitTransportByHost(t *testing.T) {
+	limited, limitedTimestamps := testTimestampServer()
+	defer limited.Close()
+	unlimited, unlimitedTimestamps := testTimestampServer()
+	defer unlimited.Close()
+
+	c := &Config{RateLimits: map[string]float64{"iam": 5}}
+	c.rateLimiter = newRateLimiter(c.RateLimits)
+	// The Bluemix session clients share one HTTP client, the service is found from the endpoint host
+	locator := newEndpointLocator("us-south", "public", map[string]string{"iam": limited.URL}, c.rateLimiter)
+	if _, err := locator.IAMEndpoint(); err != nil {
+		t.Fatal(err)
+	}
+	client := c.retryableHTTPClient("", 0)
+
+	sendConcurrently(t, client, unlimited.URL, 20)
+	if ts := unlimitedTimestamps(); ts[len(ts)-1].Sub(ts[0]) > 500*time.Millisecond {
+		t.Fatalf("expected requests to a service without rate limit not to wait, took %s", ts[len(ts)-1].Sub(ts[0]))
+	}
+
+	sendConcurrently(t, client, limited.URL, 10)
+	if ts := limitedTimestamps(); ts[len(ts)-1].Sub(ts[0]) < 900*time.Millisecond {
+		t.Fatalf("expected 10 requests at 5 requests per second to take about 1s, took %s", ts[len(ts)-1].Sub(ts[0]))
+	}
+}
+
+func TestValidateRateLimits(t *testing.T) {
+	if _, errs := validateRateLimits(map[string]interface{}{"vpc": 20.0, "cis": 0.5}, "rate_limits"); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if _, errs := validateRateLimits(map[string]interface{}{"vpcs": 20.0}, "rate_limits"); len(errs) != 1 {
+		t.Fatalf("expected an unknown service error, got %v", errs)
+	}
+	if _, errs := validateRateLimits(map[string]interface{}{"vpc": 0.0}, "rate_limits"); len(errs) != 1 {
+		t.Fatalf("expected a rate error, got %v", errs)
+	}
+	if _, errs := validateRateLimits(map[string]interface{}{"vpc": 20, "cis": "4", "iam": "0.5"}, "rate_limits"); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if _, errs := validateRateLimits(map[string]interface{}{"vpc": 0, "cis": "-1", "iam": "fast"}, "rate_limits"); len(errs) != 3 {
+		t.Fatalf("expected 3 rate errors, got %v", errs)
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// RecordModeEnv enables the recording or the replay of the API requests of the acceptance tests
+	RecordModeEnv = "IBM_TF_RECORD_MODE"
+	// CassetteDirEnv overrides the directory the cassettes are stored in
+	CassetteDirEnv = "IBM_TF_CASSETTE_DIR"
+
+	recordModeRecord = "record"
+	recordModeReplay = "replay"
+
+	defaultCassetteDir = "testdata/cassettes"
+	redacted           = "REDACTED"
+	redactedAccountID  = "00000000000000000000000000000000"
+)
+
+// recordMode returns record, replay or an empty string when requests are sent as is
+func recordMode() string {
+	switch mode := strings.ToLower(os.Getenv(RecordModeEnv)); mode {
+	case recordModeRecord, recordModeReplay:
+		return mode
+	default:
+		return ""
+	}
+}
+
+// cassettePath returns the file of the cassette of a test
+func cassettePath(name string) string {
+	dir := os.Getenv(CassetteDirEnv)
+	if dir == "" {
+		dir = defaultCassetteDir
+	}
+	return filepath.Join(dir, strings.NewReplacer("/", "_", " ", "_").Replace(name)+".json")
+}
+
+// recordedRequest is a redacted API request of a cassette
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// recordedResponse is a redacted API response of a cassette
+type recordedResponse struct {
+	StatusCode int           `json:"status_code"`
+	Header     gohttp.Header `json:"header,omitempty"`
+	Body       string        `json:"body,omitempty"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+
+	used bool
+}
+
+// cassette holds the API interactions of a test
+type cassette struct {
+	Name         string         `json:"name"`
+	Interactions []*interaction `json:"interactions"`
+
+	mu   sync.Mutex
+	mode string
+	// replacements maps the values of the recording to the values of the replay, such as random resource names
+	replacements map[string]string
+	lastUsed     map[string]*interaction
+}
+
+var (
+	// cassetteLock serializes the tests while recording or replaying, as the transports of the
+	// provider clients are shared by all the tests of the package
+	cassetteLock sync.Mutex
+
+	activeCassetteMu sync.RWMutex
+	activeCassette   *cassette
+)
+
+// startRecording loads the cassette of the test, waiting for the cassette of another test to be stopped
+func startRecording(name string) error {
+	mode := recordMode()
+	if mode == "" {
+		return nil
+	}
+	activeCassetteMu.RLock()
+	active := activeCassette
+	activeCassetteMu.RUnlock()
+	if active != nil && active.Name == name {
+		return nil
+	}
+
+	cassetteLock.Lock()
+	c := &cassette{
+		Name:         name,
+		mode:         mode,
+		replacements: map[string]string{},
+		lastUsed:     map[string]*interaction{},
+	}
+	if mode == recordModeReplay {
+		data, err := ioutil.ReadFile(cassettePath(name))
+		if err == nil {
+			err = json.Unmarshal(data, c)
+		}
+		if err != nil {
+			cassetteLock.Unlock()
+			return fmt.Errorf("Error loading the cassette of %s: %s", name, err)
+		}
+	}
+	log.Printf("[DEBUG] Started the %s of cassette %s", mode, cassettePath(name))
+
+	activeCassetteMu.Lock()
+	activeCassette = c
+	activeCassetteMu.Unlock()
+	return nil
+}
+
+// stopRecording saves the cassette of the running test when recording
+func stopRecording() error {
+	activeCassetteMu.Lock()
+	c := activeCassette
+	activeCassette = nil
+	activeCassetteMu.Unlock()
+	if c == nil {
+		return nil
+	}
+	defer cassetteLock.Unlock()
+
+	if c.mode != recordModeRecord {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := cassettePath(c.Name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Saving %d interactions to cassette %s", len(c.Interactions), path)
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// recorderTransport records the API interactions in the cassette of the running test, or replays them
+// without sending the requests, depending on IBM_TF_RECORD_MODE
+type recorderTransport struct {
+	next gohttp.RoundTripper
+}
+
+// newRecorderTransport wraps the transport with the recorder when IBM_TF_RECORD_MODE is set
+func newRecorderTransport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	if recordMode() == "" {
+		return next
+	}
+	if _, ok := next.(*recorderTransport); ok {
+		return next
+	}
+	return &recorderTransport{next: next}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *recorderTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	activeCassetteMu.RLock()
+	c := activeCassette
+	activeCassetteMu.RUnlock()
+	if c == nil {
+		if recordMode() == recordModeReplay {
+			return nil, fmt.Errorf("%s=replay but no cassette is loaded for %s %s", RecordModeEnv, req.Method, req.URL.Redacted())
+		}
+		return t.next.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := recordedRequest{
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Body:   redactString(string(body)),
+	}
+
+	if c.mode == recordModeReplay {
+		return c.replay(req, recorded)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	header := gohttp.Header{}
+	for k, v := range resp.Header {
+		if k == "Set-Cookie" || strings.Contains(strings.ToLower(k), "token") || strings.Contains(strings.ToLower(k), "auth") {
+			continue
+		}
+		header[k] = v
+	}
+	c.mu.Lock()
+	c.Interactions = append(c.Interactions, &interaction{
+		Request: recorded,
+		Response: recordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       redactString(string(respBody)),
+		},
+	})
+	c.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the recorded response of the request. Requests are matched on their method, URL and body,
+// then on their method and path only so that tests using random resource names can be replayed. The values
+// which differ from the recording are substituted in the following responses.
+func (c *cassette) replay(req *gohttp.Request, recorded recordedRequest) (*gohttp.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	reverse := c.reverseReplacer()
+	recorded.URL = reverse.Replace(recorded.URL)
+	recorded.Body = reverse.Replace(recorded.Body)
+	key := recorded.Method + " " + stripQuery(recorded.URL)
+
+	var match *interaction
+	for _, i := range c.Interactions {
+		if !i.used && i.Request.Method == recorded.Method && i.Request.URL == recorded.URL && i.Request.Body == recorded.Body {
+			match = i
+			break
+		}
+	}
+	if match == nil {
+		for _, i := range c.Interactions {
+			if !i.used && i.Request.Method+" "+stripQuery(i.Request.URL) == key {
+				match = i
+				c.learnReplacements(i.Request.URL+" "+i.Request.Body, recorded.URL+" "+recorded.Body)
+				break
+			}
+		}
+	}
+	if match == nil {
+		// Requests repeated more often than during the recording, such as token requests, reuse the last response
+		match = c.lastUsed[key]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("No interaction of cassette %s matches %s %s", c.Name, req.Method, req.URL.Redacted())
+	}
+	match.used = true
+	c.lastUsed[key] = match
+
+	replacer := c.replacer()
+	header := gohttp.Header{}
+	for k, v := range match.Response.Header {
+		for _, s := range v {
+			header.Add(k, replacer.Replace(s))
+		}
+	}
+	body := replacer.Replace(match.Response.Body)
+	header.Del("Content-Length")
+	return &gohttp.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, gohttp.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+var replacementTokenRegexp = regexp.MustCompile(`[A-Za-z0-9][A-Za-z0-9._-]*`)
+
+// learnReplacements pairs the tokens of a recorded request with the tokens of the replayed request
+func (c *cassette) learnReplacements(recorded, actual string) {
+	recordedTokens := replacementTokenRegexp.FindAllString(recorded, -1)
+	actualTokens := replacementTokenRegexp.FindAllString(actual, -1)
+	if len(recordedTokens) != len(actualTokens) {
+		return
+	}
+	for i := range recordedTokens {
+		if recordedTokens[i] != actualTokens[i] && len(recordedTokens[i]) >= 4 && len(actualTokens[i]) >= 4 {
+			c.replacements[recordedTokens[i]] = actualTokens[i]
+		}
+	}
+}
+
+func (c *cassette) replacer() *strings.Replacer {
+	pairs := make([]string, 0, 2*len(c.replacements))
+	for recorded, actual := range c.replacements {
+		pairs = append(pairs, recorded, actual)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+func (c *cassette) reverseReplacer() *strings.Replacer {
+	pairs := make([]string, 0, 2*len(c.replacements))
+	for recorded, actual := range c.replacements {
+		pairs = append(pairs, actual, recorded)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+func stripQuery(u string) string {
+	if i := strings.Index(u, "?"); i >= 0 {
+		return u[:i]
+	}
+	return u
+}
+
+var (
+	jwtRegexp          = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	secretJSONRegexp   = regexp.MustCompile(`"(refresh_token|delegated_refresh_token|uaa_token|uaa_refresh_token|apikey|api_key|apiKey|password|passphrase|cr_token|ims_token)"(\s*):(\s*)"[^"]*"`)
+	secretFormRegexp   = regexp.MustCompile(`\b(refresh_token|apikey|api_key|password|passcode|cr_token)=[^&\s"]*`)
+	accountCRNRegexp   = regexp.MustCompile(`a/[0-9a-f]{32}`)
+	accountJSONRegexp  = regexp.MustCompile(`"(account_id|accountId|bss_account_id)"(\s*):(\s*)("[^"]*"|[0-9]+)`)
+	accountQueryRegexp = regexp.MustCompile(`\b(account_id|accountId)=[0-9a-f]+`)
+	sensitiveJWTClaims = []string{"email", "sub", "iam_id", "id", "name", "given_name", "family_name", "realmid", "identifier"}
+)
+
+// redactString removes the tokens, API keys and account IDs from a request or a response. Credentials are
+// found by the JSON key or the form field which holds them, never by value, so that the placeholder
+// credentials of a replay don't redact the resource names or IDs they happen to appear in.
+func redactString(s string) string {
+	if s == "" {
+		return s
+	}
+	s = secretJSONRegexp.ReplaceAllString(s, `"$1"$2:$3"`+redacted+`"`)
+	s = secretFormRegexp.ReplaceAllString(s, "$1="+redacted)
+	s = accountCRNRegexp.ReplaceAllString(s, "a/"+redactedAccountID)
+	s = accountJSONRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		parts := accountJSONRegexp.FindStringSubmatch(m)
+		if strings.HasPrefix(parts[4], `"`) {
+			return fmt.Sprintf(`"%s"%s:%s"%s"`, parts[1], parts[2], parts[3], redactedAccountID)
+		}
+		return fmt.Sprintf(`"%s"%s:%s0`, parts[1], parts[2], parts[3])
+	})
+	s = accountQueryRegexp.ReplaceAllString(s, "$1="+redactedAccountID)
+	return jwtRegexp.ReplaceAllStringFunc(s, redactJWT)
+}
+
+// redactURL redacts the URL of a request, without its credentials
+func redactURL(u *url.URL) string {
+	stripped := *u
+	stripped.User = nil
+	return redactString(stripped.String())
+}
+
+// redactJWT replaces the personal claims and the signature of an IAM token. The token keeps its structure
+// as the provider reads the account of the user from its claims.
+func redactJWT(token string) string {
+	parts := strings.Split(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return redacted
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return redacted
+	}
+	for _, claim := range sensitiveJWTClaims {
+		if _, ok := claims[claim]; ok {
+			claims[claim] = redacted
+		}
+	}
+	if claims["email"] != nil {
+		claims["email"] = "redacted@example.com"
+	}
+	if account, ok := claims["account"].(map[string]interface{}); ok {
+		if _, ok := account["bss"]; ok {
+			account["bss"] = redactedAccountID
+		}
+		if _, ok := account["ims"]; ok {
+			account["ims"] = "0"
+		}
+		if _, ok := account["ims_user_id"]; ok {
+			account["ims_user_id"] = "0"
+		}
+	}
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return redacted
+	}
+	return parts[0] + "." + base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString([]byte(redacted))
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt"
+)
+
+func TestRedactString(t *testing.T) {
+	claims := map[string]interface{}{
+		"id":      "IBMid-1234",
+		"email":   "jane@example.com",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": "0123456789abcdef0123456789abcdef"},
+	}
+	payload, _ := json.Marshal(claims)
+	token := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+
+	s := redactString(fmt.Sprintf(`{"access_token":"%s","refresh_token":"secret-refresh","crn":"crn:v1:bluemix:public:is:us-south:a/0123456789abcdef0123456789abcdef::vpc:r006-1"}`, token))
+	for _, secret := range []string{"jane@example.com", "IBMid-1234", "secret-refresh", "0123456789abcdef0123456789abcdef", "c2lnbmF0dXJl"} {
+		if strings.Contains(s, secret) {
+			t.Fatalf("%q was not redacted from %s", secret, s)
+		}
+	}
+	if got := redactString("grant_type=urn:ibm:params:oauth:grant-type:apikey&apikey=my-api-key"); got != "grant_type=urn:ibm:params:oauth:grant-type:apikey&apikey="+redacted {
+		t.Fatalf("unexpected redacted form %q", got)
+	}
+
+	// The placeholder credentials of a replay are only redacted where they are used as credentials
+	os.Setenv("IC_API_KEY", "replay")
+	defer os.Unsetenv("IC_API_KEY")
+	if got := redactString(`{"name":"tf-replay-vpc","apikey":"replay"}`); got != `{"name":"tf-replay-vpc","apikey":"`+redacted+`"}` {
+		t.Fatalf("unexpected redacted body %q", got)
+	}
+
+	// The redacted token still carries the claims read by fetchUserDetails
+	redactedToken := jwtRegexp.FindString(s)
+	parsed, err := jwt.Parse(redactedToken, func(token *jwt.Token) (interface{}, error) {
+		return "", nil
+	})
+	if err != nil && !strings.Contains(err.Error(), "key is of invalid type") {
+		t.Fatal(err)
+	}
+	if bss := parsed.Claims.(jwt.MapClaims)["account"].(map[string]interface{})["bss"]; bss != redactedAccountID {
+		t.Fatalf("unexpected account %v", bss)
+	}
+}
+
+func TestRecorderTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ibm-cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(CassetteDirEnv, dir)
+	defer os.Unsetenv(CassetteDirEnv)
+	defer os.Unsetenv(RecordModeEnv)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"r006-1234","account_id":"0123456789abcdef0123456789abcdef","request":%s}`, body)
+	}))
+	defer server.Close()
+
+	send := func(name string) (string, error) {
+		client := &http.Client{Transport: newRecorderTransport(http.DefaultTransport)}
+		resp, err := client.Post(server.URL+"/v1/vpcs", "application/json", strings.NewReader(fmt.Sprintf(`{"name":"%s"}`, name)))
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	os.Setenv(RecordModeEnv, recordModeRecord)
+	if err := startRecording("TestAccIBMISVPC_basic"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := send("tf-test-vpc-42"); err != nil {
+		t.Fatal(err)
+	}
+	if err := stopRecording(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(cassettePath("TestAccIBMISVPC_basic"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "0123456789abcdef0123456789abcdef") {
+		t.Fatalf("the account ID was not redacted from the cassette:\n%s", data)
+	}
+
+	os.Setenv(RecordModeEnv, recordModeReplay)
+	server.Close()
+	if _, err := send("tf-test-vpc-77"); err == nil {
+		t.Fatal("expected replay mode to fail without a cassette")
+	}
+	if err := startRecording("TestAccIBMISVPC_basic"); err != nil {
+		t.Fatal(err)
+	}
+	defer stopRecording()
+	body, err := send("tf-test-vpc-77")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, `"name":"tf-test-vpc-77"`) || !strings.Contains(body, "r006-1234") {
+		t.Fatalf("expected the recorded response with the replayed name, got %s", body)
+	}
+}
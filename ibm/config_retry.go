@@ -0,0 +1,324 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	gohttp "net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// DefaultRetryMinBackoff is the initial wait before retrying a failed request
+	DefaultRetryMinBackoff = 1 * time.Second
+	// DefaultRetryMaxBackoff is the maximum wait between two attempts of a request
+	DefaultRetryMaxBackoff = 30 * time.Second
+)
+
+// defaultRetryableStatusCodes are the HTTP status codes retried when the provider retry block doesn't set any
+var defaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+// idempotentMethods are the HTTP methods whose requests are retried after any failure. Requests with other
+// methods, such as POST and PATCH, may have been applied before they failed and are only retried when the
+// server didn't process them: 429 and 503 responses, and refused connections.
+var idempotentMethods = map[string]bool{
+	gohttp.MethodGet:    true,
+	gohttp.MethodHead:   true,
+	gohttp.MethodPut:    true,
+	gohttp.MethodDelete: true,
+}
+
+// RetryPolicy describes how failed API requests are retried by every client of the provider
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of a request, including the first one
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry, doubled on every following retry
+	MinBackoff time.Duration
+	// MaxBackoff caps the computed wait between two attempts
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the response status codes which are retried
+	RetryableStatusCodes map[int]bool
+	// HonorRetryAfter waits for the duration of the Retry-After response header when it is longer than the backoff
+	HonorRetryAfter bool
+}
+
+// newRetryPolicy returns the default retry policy for the given number of retries
+func newRetryPolicy(retries int) *RetryPolicy {
+	if retries < 0 {
+		retries = 0
+	}
+	policy := &RetryPolicy{
+		MaxAttempts:          retries + 1,
+		MinBackoff:           DefaultRetryMinBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		RetryableStatusCodes: map[int]bool{},
+		HonorRetryAfter:      true,
+	}
+	for _, code := range defaultRetryableStatusCodes {
+		policy.RetryableStatusCodes[code] = true
+	}
+	return policy
+}
+
+// providerRetrySchema is the schema of the provider retry block
+func providerRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Retry policy applied to the API requests of every IBM Cloud service client.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Total number of attempts of a request, including the first one. Defaults to max_retries + 1.",
+				},
+				"min_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      DefaultRetryMinBackoff.String(),
+					ValidateFunc: validateDuration,
+					Description:  "Wait before the first retry, doubled on every following retry.",
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      DefaultRetryMaxBackoff.String(),
+					ValidateFunc: validateDuration,
+					Description:  "Maximum wait between two attempts of a request.",
+				},
+				"retryable_status_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+					Set:         schema.HashInt,
+					Description: "HTTP status codes which are retried.",
+				},
+				"honor_retry_after": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Wait for the duration of the Retry-After response header when it is longer than the backoff.",
+				},
+			},
+		},
+	}
+}
+
+// validateDuration validates a Go duration string such as 500ms or 30s
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 500ms or 30s, got %q", k, value))
+		return
+	}
+	if d < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative, got %q", k, value))
+	}
+	return
+}
+
+// expandProviderRetryPolicy builds the retry policy from the provider retry block
+func expandProviderRetryPolicy(d *schema.ResourceData, retries int) (*RetryPolicy, error) {
+	policy := newRetryPolicy(retries)
+	l, ok := d.GetOk("retry")
+	if !ok || len(l.([]interface{})) == 0 || l.([]interface{})[0] == nil {
+		return policy, nil
+	}
+	block := l.([]interface{})[0].(map[string]interface{})
+
+	if v, ok := block["max_attempts"].(int); ok && v > 0 {
+		policy.MaxAttempts = v
+	}
+	if v, ok := block["min_backoff"].(string); ok && v != "" {
+		policy.MinBackoff, _ = time.ParseDuration(v)
+	}
+	if v, ok := block["max_backoff"].(string); ok && v != "" {
+		policy.MaxBackoff, _ = time.ParseDuration(v)
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		return nil, fmt.Errorf("retry.max_backoff (%s) must not be lower than retry.min_backoff (%s)", policy.MaxBackoff, policy.MinBackoff)
+	}
+	if v, ok := block["retryable_status_codes"].(*schema.Set); ok && v.Len() > 0 {
+		policy.RetryableStatusCodes = map[int]bool{}
+		for _, code := range v.List() {
+			policy.RetryableStatusCodes[code.(int)] = true
+		}
+	}
+	if v, ok := block["honor_retry_after"].(bool); ok {
+		policy.HonorRetryAfter = v
+	}
+	return policy, nil
+}
+
+// backoff returns the wait before the given retry, using exponential backoff with jitter
+func (policy *RetryPolicy) backoff(retry int, resp *gohttp.Response) time.Duration {
+	wait := time.Duration(float64(policy.MinBackoff) * math.Pow(2, float64(retry-1)))
+	if wait > policy.MaxBackoff || wait <= 0 {
+		wait = policy.MaxBackoff
+	}
+	if wait > 1 {
+		half := int64(wait / 2)
+		wait = time.Duration(half + rand.Int63n(half+1))
+	}
+	if policy.HonorRetryAfter && resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok && retryAfter > wait {
+			wait = retryAfter
+		}
+	}
+	return wait
+}
+
+// parseRetryAfter parses a Retry-After header expressed either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// shouldRetry tells whether the result of an attempt of the request is retried
+func (policy *RetryPolicy) shouldRetry(req *gohttp.Request, resp *gohttp.Response, err error) bool {
+	if err != nil {
+		if _, ok := err.(x509.UnknownAuthorityError); ok {
+			return false
+		}
+		return idempotentMethods[req.Method] || errors.Is(err, syscall.ECONNREFUSED)
+	}
+	if !idempotentMethods[req.Method] && resp.StatusCode != gohttp.StatusTooManyRequests && resp.StatusCode != gohttp.StatusServiceUnavailable {
+		return false
+	}
+	return policy.RetryableStatusCodes[resp.StatusCode]
+}
+
+// retryTransport is a RoundTripper which retries requests according to the provider retry policy
+type retryTransport struct {
+	next   gohttp.RoundTripper
+	policy *RetryPolicy
+}
+
+// newRetryTransport wraps the transport with the retry policy
+func newRetryTransport(next gohttp.RoundTripper, policy *RetryPolicy) gohttp.RoundTripper {
+	if next == nil {
+		next = DefaultTransport()
+	}
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return next
+	}
+	return &retryTransport{
+		next:   next,
+		policy: policy,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *retryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if req.Body != nil && req.GetBody == nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if req.Context().Err() != nil || !t.policy.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		if attempt >= t.policy.MaxAttempts {
+			log.Printf("[DEBUG] Not retrying %s %s, %s after %d attempts", req.Method, req.URL.Redacted(), describeAttempt(resp, err), attempt)
+			return resp, err
+		}
+
+		wait := t.policy.backoff(attempt, resp)
+		log.Printf("[DEBUG] Retrying %s %s in %s, %s (attempt %d of %d)", req.Method, req.URL.Redacted(), wait, describeAttempt(resp, err), attempt, t.policy.MaxAttempts)
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func describeAttempt(resp *gohttp.Response, err error) string {
+	if err != nil {
+		return fmt.Sprintf("request failed: %s", err)
+	}
+	return fmt.Sprintf("received status %d", resp.StatusCode)
+}
+
+// httpClientSetter is implemented by the BaseService of every IBM Go SDK core version
+type httpClientSetter interface {
+	SetHTTPClient(*gohttp.Client)
+}
+
+// serviceTransport wraps the transport with the rate limit of the service and the provider retry policy.
+// Every attempt of a retried request waits for the rate limit and is recorded separately by the acceptance
+// test recorder. An empty service is looked up from the host of every request.
+func (c *Config) serviceTransport(service string, next gohttp.RoundTripper) gohttp.RoundTripper {
+	return newRetryTransport(newRateLimitTransport(newRecorderTransport(next), c.rateLimiter, service), c.RetryPolicy)
+}
+
+// retryableHTTPClient returns an HTTP client which applies the rate limit of the service and retries
+// requests with the provider retry policy
+func (c *Config) retryableHTTPClient(service string, timeout time.Duration) *gohttp.Client {
+	transport := gohttp.DefaultTransport.(*gohttp.Transport).Clone()
+	return &gohttp.Client{
+		Transport: c.serviceTransport(service, transport),
+		Timeout:   timeout,
+	}
+}
+
+// enableRetries installs the rate limit of the service and the provider retry policy on an IBM Go SDK service.
+// The client keeps a timeout, ibmcloud_timeout, so that a hung connection doesn't block the apply.
+func (c *Config) enableRetries(service string, client httpClientSetter) {
+	client.SetHTTPClient(c.retryableHTTPClient(service, c.BluemixTimeout))
+}
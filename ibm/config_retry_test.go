@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy(attempts int) *RetryPolicy {
+	policy := newRetryPolicy(attempts - 1)
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"tf-test"}` {
+			t.Errorf("unexpected body %q on attempt %d", body, atomic.LoadInt32(&calls)+1)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryPolicy(5))}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"tf-test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || calls != 3 {
+		t.Fatalf("expected success after 3 attempts, got status %d after %d attempts", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryTransportLimits(t *testing.T) {
+	var calls int32
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryPolicy(4))}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != status || calls != 4 {
+		t.Fatalf("expected to give up after 4 attempts, got status %d after %d attempts", resp.StatusCode, calls)
+	}
+
+	calls = 0
+	status = http.StatusNotFound
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Fatalf("expected status %d not to be retried, got %d attempts", status, calls)
+	}
+}
+
+func TestRetryPolicyMethods(t *testing.T) {
+	policy := newRetryPolicy(3)
+	connectionRefused := &net.OpError{Op: "dial", Net: "tcp", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}
+	connectionReset := &net.OpError{Op: "read", Net: "tcp", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}
+	for _, tc := range []struct {
+		method string
+		status int
+		err    error
+		retry  bool
+	}{
+		{method: http.MethodGet, status: http.StatusInternalServerError, retry: true},
+		{method: http.MethodPut, err: connectionReset, retry: true},
+		{method: http.MethodDelete, status: http.StatusBadGateway, retry: true},
+		{method: http.MethodGet, status: http.StatusNotFound, retry: false},
+		{method: http.MethodPost, status: http.StatusTooManyRequests, retry: true},
+		{method: http.MethodPatch, status: http.StatusServiceUnavailable, retry: true},
+		{method: http.MethodPost, err: connectionRefused, retry: true},
+		{method: http.MethodPost, status: http.StatusInternalServerError, retry: false},
+		{method: http.MethodPost, status: http.StatusGatewayTimeout, retry: false},
+		{method: http.MethodPatch, err: connectionReset, retry: false},
+		{method: http.MethodPost, err: errors.New("EOF"), retry: false},
+	} {
+		req, _ := http.NewRequest(tc.method, "https://example.com", nil)
+		var resp *http.Response
+		if tc.err == nil {
+			resp = &http.Response{StatusCode: tc.status}
+		}
+		if retry := policy.shouldRetry(req, resp, tc.err); retry != tc.retry {
+			t.Errorf("%s with status %d and error %v: expected retry %t, got %t", tc.method, tc.status, tc.err, tc.retry, retry)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := newRetryPolicy(10)
+	policy.MinBackoff = time.Second
+	policy.MaxBackoff = 4 * time.Second
+	for retry, max := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 8: 4 * time.Second} {
+		wait := policy.backoff(retry, nil)
+		if wait < max/2 || wait > max {
+			t.Errorf("retry %d: backoff %s not within [%s, %s]", retry, wait, max/2, max)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"20"}}}
+	if wait := policy.backoff(1, resp); wait != 20*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %s", wait)
+	}
+	policy.HonorRetryAfter = false
+	if wait := policy.backoff(1, resp); wait > time.Second {
+		t.Errorf("expected Retry-After to be ignored, got %s", wait)
+	}
+}
+
+// testHTTPClientSetter records the HTTP client set on an IBM Go SDK service
+type testHTTPClientSetter struct {
+	client *http.Client
+}
+
+func (s *testHTTPClientSetter) SetHTTPClient(client *http.Client) {
+	s.client = client
+}
+
+func TestEnableRetriesTimeout(t *testing.T) {
+	c := &Config{BluemixTimeout: 60 * time.Second, RetryPolicy: newRetryPolicy(3)}
+	setter := &testHTTPClientSetter{}
+	c.enableRetries("vpc", setter)
+	if setter.client == nil || setter.client.Timeout != 60*time.Second {
+		t.Errorf("expected the SDK client to keep the provider timeout, got %+v", setter.client)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientSessionSharedByAliases(t *testing.T) {
+	var iamRequests int32
+	iam := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&iamRequests, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer iam.Close()
+
+	config := func(region string) *Config {
+		return &Config{
+			BluemixAPIKey: "test-shared-session-api-key",
+			Region:        region,
+			RetryPolicy:   newRetryPolicy(0),
+			Endpoints:     map[string]string{"iam": iam.URL},
+		}
+	}
+	session, err := config("us-south").ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	alias, err := config("us-south").ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := config("eu-de").ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session != alias {
+		t.Fatal("expected the aliases with identical credentials and region to share their session")
+	}
+	if session == other {
+		t.Fatal("expected the aliases of different regions not to share their session")
+	}
+
+	sess := session.(*clientSession)
+	if sess.vpcAPI != nil || sess.cosConfigAPI != nil {
+		t.Fatal("expected no client to be configured before its first use")
+	}
+	vpcClient, err := sess.VpcV1API()
+	if err != nil || vpcClient == nil {
+		t.Fatalf("unexpected VPC client %v: %v", vpcClient, err)
+	}
+	if again, _ := alias.(ClientSession).VpcV1API(); again != vpcClient {
+		t.Fatal("expected the VPC client to be configured once")
+	}
+	if sess.cosConfigAPI != nil {
+		t.Fatal("expected the COS config client not to be configured by the VPC client")
+	}
+	if n := atomic.LoadInt32(&iamRequests); n != 0 {
+		t.Fatalf("expected no IAM request before the first API call, got %d", n)
+	}
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	session, err := (&Config{Region: "us-south", RetryPolicy: newRetryPolicy(0)}).ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.(ClientSession).VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected the missing credentials error, got %v", err)
+	}
+	if _, err := session.(ClientSession).BluemixUserDetails(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected the missing credentials error, got %v", err)
+	}
+	if session.(ClientSession).SoftLayerSession() == nil {
+		t.Fatal("expected the SoftLayer session to be configured")
+	}
+}
+
+func TestClientSessionAuthenticationFailure(t *testing.T) {
+	iam := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer iam.Close()
+
+	config := &Config{
+		BluemixAPIKey: "test-failed-session-api-key",
+		Region:        "us-south",
+		RetryPolicy:   newRetryPolicy(0),
+		Endpoints:     map[string]string{"iam": iam.URL},
+	}
+	session, err := config.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	authErr := session.(*clientSession).authenticate()
+	if authErr == nil {
+		t.Fatal("expected the API key authentication to fail")
+	}
+	if _, err := session.(ClientSession).BluemixUserDetails(); err != authErr {
+		t.Fatalf("expected the authentication error, got %v", err)
+	}
+	if _, err := session.(ClientSession).FunctionClient(); err != authErr {
+		t.Fatalf("expected the authentication error, got %v", err)
+	}
+
+	again, err := config.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == session {
+		t.Fatal("expected the failed session not to be shared with the next configuration")
+	}
+}
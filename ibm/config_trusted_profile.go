@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+const (
+	// DefaultCRTokenFilename is the file a compute resource token is projected to in IKS pods and VPC instances
+	DefaultCRTokenFilename = "/var/run/secrets/tokens/vault-token"
+
+	// crTokenGrantType is the IAM grant type used to exchange a compute resource token for an IAM token
+	crTokenGrantType = "urn:ibm:params:oauth:grant-type:cr-token"
+
+	// crTokenAuthenticationType is reported by the authenticator to the IBM Go SDKs
+	crTokenAuthenticationType = "crToken"
+)
+
+// crTokenRefreshTransport answers the refresh token grants the Bluemix clients send to IAM when a request
+// fails with 401 or 403 by exchanging the compute resource token again, as IAM doesn't issue a usable
+// refresh token for compute resource tokens. Every other request is sent unchanged.
+type crTokenRefreshTransport struct {
+	authenticator *CRTokenAuthenticator
+	transport     gohttp.RoundTripper
+}
+
+// withCRTokenRefresh makes the Bluemix clients using client refresh their token with the authenticator
+func withCRTokenRefresh(client *gohttp.Client, authenticator *CRTokenAuthenticator) *gohttp.Client {
+	transport := client.Transport
+	if transport == nil {
+		transport = gohttp.DefaultTransport
+	}
+	client.Transport = &crTokenRefreshTransport{
+		authenticator: authenticator,
+		transport:     transport,
+	}
+	return client
+}
+
+func (t *crTokenRefreshTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	if req.Method != gohttp.MethodPost || req.Body == nil || !strings.HasSuffix(req.URL.Path, "/identity/token") {
+		return t.transport.RoundTrip(req)
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if form, err := url.ParseQuery(string(body)); err != nil || form.Get("grant_type") != "refresh_token" {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		return t.transport.RoundTrip(req)
+	}
+
+	log.Printf("[DEBUG] Refreshing the trusted profile IAM token with the compute resource token")
+	tokenData, err := t.authenticator.RefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	tokenResponse := *tokenData
+	if tokenResponse.TokenType == "" {
+		tokenResponse.TokenType = "Bearer"
+	}
+	respBody, err := json.Marshal(tokenResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &gohttp.Response{
+		Status:        "200 OK",
+		StatusCode:    gohttp.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        gohttp.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+// crTokenAuthTransport sets the trusted profile IAM token on every request of the clients which only take a static
+// token, such as Key Protect, so that they keep working once the token they were configured with expires
+type crTokenAuthTransport struct {
+	authenticator *CRTokenAuthenticator
+	transport     gohttp.RoundTripper
+}
+
+func (t *crTokenAuthTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	req = req.Clone(req.Context())
+	if err := t.authenticator.Authenticate(req); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// iamTokenResponse is the response of the IAM token API
+type iamTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Expiration   int64  `json:"expiration"`
+}
+
+// CRTokenAuthenticator authenticates requests with an IAM token obtained by exchanging the
+// compute resource token of the workload for an IAM trusted profile token.
+// It implements the core.Authenticator interface of the IBM Go SDKs.
+type CRTokenAuthenticator struct {
+	// CRTokenFilename is the file containing the compute resource token
+	CRTokenFilename string
+	// IAMProfileID is the ID of the trusted profile to assume
+	IAMProfileID string
+	// IAMProfileName is the name of the trusted profile to assume
+	IAMProfileName string
+	// URL is the IAM endpoint, without the /identity/token path
+	URL string
+	// Client is the HTTP client used to call IAM
+	Client *gohttp.Client
+
+	mu          sync.Mutex
+	tokenData   *iamTokenResponse
+	refreshTime int64
+}
+
+// NewCRTokenAuthenticator returns a trusted profile authenticator and validates its configuration
+func NewCRTokenAuthenticator(crTokenFilename, profileID, profileName, iamURL string) (*CRTokenAuthenticator, error) {
+	if crTokenFilename == "" {
+		crTokenFilename = DefaultCRTokenFilename
+	}
+	authenticator := &CRTokenAuthenticator{
+		CRTokenFilename: crTokenFilename,
+		IAMProfileID:    profileID,
+		IAMProfileName:  profileName,
+		URL:             strings.TrimSuffix(iamURL, "/"),
+		Client: &gohttp.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the authentication type for this authenticator
+func (*CRTokenAuthenticator) AuthenticationType() string {
+	return crTokenAuthenticationType
+}
+
+// Validate checks that the trusted profile configuration is usable
+func (authenticator *CRTokenAuthenticator) Validate() error {
+	if authenticator.IAMProfileID == "" && authenticator.IAMProfileName == "" {
+		return fmt.Errorf("iam_profile_id or iam_profile_name must be provided to authenticate with a compute resource token")
+	}
+	if authenticator.URL == "" {
+		return fmt.Errorf("The IAM endpoint must be provided to authenticate with a compute resource token")
+	}
+	return nil
+}
+
+// Authenticate adds the trusted profile IAM token to the Authorization header of the request
+func (authenticator *CRTokenAuthenticator) Authenticate(request *gohttp.Request) error {
+	token, err := authenticator.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// GetToken returns a cached IAM access token, exchanging the compute resource token again
+// once 80% of the token lifetime has elapsed.
+func (authenticator *CRTokenAuthenticator) GetToken() (string, error) {
+	authenticator.mu.Lock()
+	defer authenticator.mu.Unlock()
+
+	now := time.Now().Unix()
+	if authenticator.tokenData == nil || now >= authenticator.refreshTime || now >= authenticator.tokenData.Expiration {
+		if err := authenticator.requestToken(); err != nil {
+			return "", err
+		}
+	}
+	return authenticator.tokenData.AccessToken, nil
+}
+
+// RefreshToken exchanges the compute resource token for a new IAM token, regardless of the cached one
+func (authenticator *CRTokenAuthenticator) RefreshToken() (*iamTokenResponse, error) {
+	authenticator.mu.Lock()
+	defer authenticator.mu.Unlock()
+
+	if err := authenticator.requestToken(); err != nil {
+		return nil, err
+	}
+	return authenticator.tokenData, nil
+}
+
+func (authenticator *CRTokenAuthenticator) requestToken() error {
+	crToken, err := ioutil.ReadFile(authenticator.CRTokenFilename)
+	if err != nil {
+		return fmt.Errorf("Error reading the compute resource token from %s: %s", authenticator.CRTokenFilename, err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", crTokenGrantType)
+	form.Set("cr_token", strings.TrimSpace(string(crToken)))
+	if authenticator.IAMProfileID != "" {
+		form.Set("profile_id", authenticator.IAMProfileID)
+	}
+	if authenticator.IAMProfileName != "" {
+		form.Set("profile_name", authenticator.IAMProfileName)
+	}
+
+	req, err := gohttp.NewRequest(gohttp.MethodPost, authenticator.URL+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
+
+	log.Printf("[DEBUG] Exchanging the compute resource token for a trusted profile IAM token")
+	resp, err := authenticator.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error requesting the trusted profile IAM token: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Error requesting the trusted profile IAM token: %s\n%s", resp.Status, body)
+	}
+
+	tokenData := &iamTokenResponse{}
+	if err := json.Unmarshal(body, tokenData); err != nil {
+		return fmt.Errorf("Error parsing the trusted profile IAM token response: %s", err)
+	}
+	if tokenData.AccessToken == "" {
+		return fmt.Errorf("The IAM token response doesn't contain an access token")
+	}
+	if tokenData.Expiration == 0 {
+		tokenData.Expiration = time.Now().Unix() + tokenData.ExpiresIn
+	}
+	authenticator.tokenData = tokenData
+	authenticator.refreshTime = tokenData.Expiration - int64(float64(tokenData.ExpiresIn)*0.2)
+	return nil
+}
+
+// authenticateCRToken sets the trusted profile IAM token on the Bluemix session
+func authenticateCRToken(sess *bxsession.Session, authenticator *CRTokenAuthenticator) error {
+	tokenData, err := authenticator.RefreshToken()
+	if err != nil {
+		return err
+	}
+	sess.Config.IAMAccessToken = fmt.Sprintf("Bearer %s", tokenData.AccessToken)
+	// IAM doesn't issue a usable refresh token for compute resource tokens. It's only kept for the Bluemix
+	// session validation, the refresh token grants are answered by crTokenRefreshTransport.
+	sess.Config.IAMRefreshToken = tokenData.RefreshToken
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func testIAMTokenServer(t *testing.T, calls *int32, expiresIn int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity/token" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.Form.Get("grant_type") != crTokenGrantType {
+			t.Errorf("unexpected grant type %q", r.Form.Get("grant_type"))
+		}
+		if r.Form.Get("cr_token") != "test-cr-token" {
+			t.Errorf("unexpected cr token %q", r.Form.Get("cr_token"))
+		}
+		if r.Form.Get("profile_id") != "Profile-1234" {
+			t.Errorf("unexpected profile id %q", r.Form.Get("profile_id"))
+		}
+		n := atomic.AddInt32(calls, 1)
+		json.NewEncoder(w).Encode(iamTokenResponse{
+			AccessToken:  fmt.Sprintf("access-token-%d", n),
+			RefreshToken: "not_supported",
+			TokenType:    "Bearer",
+			ExpiresIn:    expiresIn,
+			Expiration:   time.Now().Unix() + expiresIn,
+		})
+	}))
+}
+
+func testCRTokenFile(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "ibm-cr-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "vault-token")
+	if err := ioutil.WriteFile(filename, []byte("test-cr-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestCRTokenAuthenticator(t *testing.T) {
+	var calls int32
+	server := testIAMTokenServer(t, &calls, 3600)
+	defer server.Close()
+	filename := testCRTokenFile(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	if _, err := NewCRTokenAuthenticator(filename, "", "", server.URL); err == nil {
+		t.Fatal("expected an error without a trusted profile")
+	}
+
+	authenticator, err := NewCRTokenAuthenticator(filename, "Profile-1234", "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		if err := authenticator.Authenticate(req); err != nil {
+			t.Fatal(err)
+		}
+		if got := req.Header.Get("Authorization"); got != "Bearer access-token-1" {
+			t.Fatalf("unexpected Authorization header %q", got)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected the token to be cached, IAM was called %d times", calls)
+	}
+}
+
+func TestCRTokenAuthenticatorRefresh(t *testing.T) {
+	var calls int32
+	// A token whose refresh window has already started is exchanged again on every use
+	server := testIAMTokenServer(t, &calls, 0)
+	defer server.Close()
+	filename := testCRTokenFile(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	authenticator, err := NewCRTokenAuthenticator(filename, "Profile-1234", "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := authenticator.GetToken(); err != nil {
+		t.Fatal(err)
+	}
+	token, err := authenticator.GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "access-token-2" || calls != 2 {
+		t.Fatalf("expected the expired token to be refreshed, got %q after %d calls", token, calls)
+	}
+
+	sess, err := bxsession.New(&bluemix.Config{Region: "us-south"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticateCRToken(sess, authenticator); err != nil {
+		t.Fatal(err)
+	}
+	if sess.Config.IAMAccessToken != "Bearer access-token-3" {
+		t.Fatalf("unexpected session token %q", sess.Config.IAMAccessToken)
+	}
+	if sess.Config.IAMRefreshToken != "not_supported" {
+		t.Fatalf("expected the refresh token returned by IAM on the session, got %q", sess.Config.IAMRefreshToken)
+	}
+}
+
+func TestCRTokenRefreshTransport(t *testing.T) {
+	var calls int32
+	// The IAM server fails the test if it receives the refresh token grant of the Bluemix token refresher
+	server := testIAMTokenServer(t, &calls, 3600)
+	defer server.Close()
+	filename := testCRTokenFile(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	authenticator, err := NewCRTokenAuthenticator(filename, "Profile-1234", "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &bluemix.Config{
+		TokenProviderEndpoint: &server.URL,
+		HTTPClient:            withCRTokenRefresh(&http.Client{}, authenticator),
+		IAMAccessToken:        "Bearer expired-token",
+		IAMRefreshToken:       "not_supported",
+	}
+	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{HTTPClient: config.HTTPClient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokenRefresher.RefreshToken(); err != nil {
+		t.Fatal(err)
+	}
+	if config.IAMAccessToken != "Bearer access-token-1" || calls != 1 {
+		t.Fatalf("expected the token to be refreshed with the compute resource token, got %q after %d calls", config.IAMAccessToken, calls)
+	}
+
+	// The sessions of the functions client are refreshed through the HTTP client of the session too
+	if err := refreshToken(&bxsession.Session{Config: config}); err != nil {
+		t.Fatal(err)
+	}
+	if config.IAMAccessToken != "Bearer access-token-2" || calls != 2 {
+		t.Fatalf("expected refreshToken to exchange the compute resource token, got %q after %d calls", config.IAMAccessToken, calls)
+	}
+}
+
+func TestCRTokenAuthTransport(t *testing.T) {
+	var calls int32
+	server := testIAMTokenServer(t, &calls, 3600)
+	defer server.Close()
+	filename := testCRTokenFile(t)
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	authenticator, err := NewCRTokenAuthenticator(filename, "Profile-1234", "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var authorization string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer api.Close()
+
+	client := &http.Client{Transport: &crTokenAuthTransport{authenticator: authenticator, transport: http.DefaultTransport}}
+	req, err := http.NewRequest(http.MethodGet, api.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer static-token")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if authorization != "Bearer access-token-1" {
+		t.Errorf("expected the trusted profile token instead of the static one, got %q", authorization)
+	}
+	if req.Header.Get("Authorization") != "Bearer static-token" {
+		t.Errorf("expected the request of the caller to be left unchanged")
+	}
+}
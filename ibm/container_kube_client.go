@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+// kubeClient is a minimal client of the Kubernetes API of a cluster, authenticated by the config of the cluster. It
+// only manages ConfigMaps, which is what the provider needs to configure the add-ons of a cluster, and drains the
+// nodes of the workers which are updated.
+type kubeClient struct {
+	host   string
+	token  string
+	client *http.Client
+}
+
+// kubeConfigMap is a Kubernetes ConfigMap
+type kubeConfigMap struct {
+	APIVersion string                 `json:"apiVersion,omitempty"`
+	Kind       string                 `json:"kind,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata"`
+	Data       map[string]string      `json:"data,omitempty"`
+}
+
+// kubeObjectMeta is the metadata of the Kubernetes objects which are drained
+type kubeObjectMeta struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+	DeletionTimestamp string            `json:"deletionTimestamp,omitempty"`
+	OwnerReferences   []struct {
+		Kind string `json:"kind"`
+	} `json:"ownerReferences,omitempty"`
+}
+
+// kubePod is a Kubernetes Pod
+type kubePod struct {
+	Metadata kubeObjectMeta `json:"metadata"`
+	Status   struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// kubeList is a list of Kubernetes pods or nodes, the nodes only having metadata
+type kubeList struct {
+	Items []kubePod `json:"items"`
+}
+
+const (
+	// kubeWorkerIDLabel is the label of the nodes of a cluster with the ID of their worker
+	kubeWorkerIDLabel = "ibm-cloud.kubernetes.io/worker-id"
+	// kubeMirrorPodAnnotation marks the static pods of a node, which can't be evicted
+	kubeMirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// kubeAPIError is an error status returned by the Kubernetes API
+type kubeAPIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *kubeAPIError) Error() string {
+	return fmt.Sprintf("Kubernetes API error %d: %s", e.StatusCode, e.Message)
+}
+
+// isKubeNotFound tells whether err is the Kubernetes API telling an object doesn't exist
+func isKubeNotFound(err error) bool {
+	return isKubeStatus(err, http.StatusNotFound)
+}
+
+// isKubeStatus tells whether err is an error status of the Kubernetes API with the status code
+func isKubeStatus(err error, statusCode int) bool {
+	apiErr, ok := err.(*kubeAPIError)
+	return ok && apiErr.StatusCode == statusCode
+}
+
+// clusterKubeClient returns a client of the Kubernetes API of the cluster, with the admin config of the cluster
+func clusterKubeClient(meta interface{}, cluster string, target v2.ClusterTargetHeader) (*kubeClient, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	config, err := getClusterConfigInMemory(csClient, cluster, true, target)
+	if err != nil {
+		return nil, fmt.Errorf("Error downloading the config of the cluster %s: %s", cluster, err)
+	}
+	return newKubeClient(meta, config)
+}
+
+// newKubeClient returns a client of the cluster of config. Its requests go through the rate limit of the container
+// service, the retry policy and the recorder of the provider.
+func newKubeClient(meta interface{}, config clusterConfig) (*kubeClient, error) {
+	tlsConfig := &tls.Config{}
+	if config.caCertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.caCertificate)) {
+			return nil, fmt.Errorf("The CA certificate of the cluster config is not valid")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.adminCertificate != "" && config.adminKey != "" {
+		cert, err := tls.X509KeyPair([]byte(config.adminCertificate), []byte(config.adminKey))
+		if err != nil {
+			return nil, fmt.Errorf("The client certificate of the cluster config is not valid: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if config.host == "" {
+		return nil, fmt.Errorf("The cluster config has no server")
+	}
+	transport := DefaultTransport().(*http.Transport)
+	transport.TLSClientConfig = tlsConfig
+	return &kubeClient{
+		host:  strings.TrimSuffix(config.host, "/"),
+		token: config.token,
+		client: &http.Client{
+			Timeout:   time.Minute,
+			Transport: meta.(ClientSession).serviceTransport("container", transport),
+		},
+	}, nil
+}
+
+func (c *kubeClient) configMapURL(namespace, name string) string {
+	return fmt.Sprintf("%s/api/v1/namespaces/%s/configmaps/%s", c.host, url.PathEscape(namespace), url.PathEscape(name))
+}
+
+// getConfigMap retrieves a ConfigMap
+func (c *kubeClient) getConfigMap(namespace, name string) (*kubeConfigMap, error) {
+	configMap := &kubeConfigMap{}
+	if err := c.do(http.MethodGet, c.configMapURL(namespace, name), nil, configMap); err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
+
+// updateConfigMap replaces a ConfigMap. As the resource version of the ConfigMap is sent back, the update fails with
+// a conflict if the ConfigMap changed since it was retrieved.
+func (c *kubeClient) updateConfigMap(configMap *kubeConfigMap) (*kubeConfigMap, error) {
+	namespace, _ := configMap.Metadata["namespace"].(string)
+	name, _ := configMap.Metadata["name"].(string)
+	result := &kubeConfigMap{}
+	if err := c.do(http.MethodPut, c.configMapURL(namespace, name), configMap, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// workerNode returns the name of the node of a worker, or an empty name if the worker has no node yet
+func (c *kubeClient) workerNode(worker string) (string, error) {
+	nodes := &kubeList{}
+	query := url.Values{"labelSelector": {kubeWorkerIDLabel + "=" + worker}}
+	if err := c.do(http.MethodGet, c.host+"/api/v1/nodes?"+query.Encode(), nil, nodes); err != nil {
+		return "", err
+	}
+	if len(nodes.Items) == 0 {
+		return "", nil
+	}
+	return nodes.Items[0].Metadata.Name, nil
+}
+
+// cordonNode marks a node unschedulable, so that the pods evicted from the drained nodes don't move to it
+func (c *kubeClient) cordonNode(node string) error {
+	patch := map[string]interface{}{"spec": map[string]interface{}{"unschedulable": true}}
+	return c.do(http.MethodPatch, fmt.Sprintf("%s/api/v1/nodes/%s", c.host, url.PathEscape(node)), patch, &map[string]interface{}{})
+}
+
+// nodePods lists the pods of a node
+func (c *kubeClient) nodePods(node string) ([]kubePod, error) {
+	pods := &kubeList{}
+	query := url.Values{"fieldSelector": {"spec.nodeName=" + node}}
+	if err := c.do(http.MethodGet, c.host+"/api/v1/pods?"+query.Encode(), nil, pods); err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// evictPod asks the API to evict a pod, which respects its PodDisruptionBudgets. The clusters older than
+// Kubernetes 1.22 only know the policy/v1beta1 Eviction.
+func (c *kubeClient) evictPod(pod kubePod) error {
+	evictionURL := fmt.Sprintf("%s/api/v1/namespaces/%s/pods/%s/eviction", c.host, url.PathEscape(pod.Metadata.Namespace), url.PathEscape(pod.Metadata.Name))
+	var err error
+	for _, apiVersion := range []string{"policy/v1", "policy/v1beta1"} {
+		eviction := map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "Eviction",
+			"metadata":   map[string]interface{}{"name": pod.Metadata.Name, "namespace": pod.Metadata.Namespace},
+		}
+		if err = c.do(http.MethodPost, evictionURL, eviction, &map[string]interface{}{}); !isKubeStatus(err, http.StatusBadRequest) {
+			return err
+		}
+	}
+	return err
+}
+
+// evictablePod tells whether a drain evicts the pod: the pods of DaemonSets, the static pods and the pods which
+// are done are left on the node
+func evictablePod(pod kubePod) bool {
+	if pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
+		return false
+	}
+	if _, ok := pod.Metadata.Annotations[kubeMirrorPodAnnotation]; ok {
+		return false
+	}
+	for _, owner := range pod.Metadata.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+// drainWorkers cordons the nodes of the workers, then evicts their pods until they are all gone or the timeout
+// expires. The pods which are left then, for example because a PodDisruptionBudget blocks their eviction, are
+// stopped with the workers.
+func (c *kubeClient) drainWorkers(workers []string, timeout, pollInterval time.Duration) error {
+	nodes := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		node, err := c.workerNode(worker)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the node of the worker %s: %s", worker, err)
+		}
+		if node == "" {
+			log.Printf("[WARN] The worker %s has no node to drain", worker)
+			continue
+		}
+		if err := c.cordonNode(node); err != nil {
+			return fmt.Errorf("Error cordoning the node %s: %s", node, err)
+		}
+		nodes = append(nodes, node)
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		pending := 0
+		for _, node := range nodes {
+			pods, err := c.nodePods(node)
+			if err != nil {
+				return fmt.Errorf("Error listing the pods of the node %s: %s", node, err)
+			}
+			for _, pod := range pods {
+				if !evictablePod(pod) {
+					continue
+				}
+				pending++
+				if pod.Metadata.DeletionTimestamp != "" {
+					continue
+				}
+				// A PodDisruptionBudget answers 429 Too Many Requests until the eviction is allowed
+				err := c.evictPod(pod)
+				if err != nil && !isKubeNotFound(err) && !isKubeStatus(err, http.StatusTooManyRequests) {
+					return fmt.Errorf("Error evicting the pod %s/%s: %s", pod.Metadata.Namespace, pod.Metadata.Name, err)
+				}
+			}
+		}
+		if pending == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			log.Printf("[WARN] %d pods of the nodes %v are still running after the drain timeout of %s", pending, nodes, timeout)
+			return nil
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func (c *kubeClient) do(method, url string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if method == http.MethodPatch {
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+	} else if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		status := struct {
+			Message string `json:"message"`
+		}{}
+		if json.Unmarshal(data, &status) != nil || status.Message == "" {
+			status.Message = strings.TrimSpace(string(data))
+		}
+		return &kubeAPIError{StatusCode: resp.StatusCode, Message: status.Message}
+	}
+	return json.Unmarshal(data, result)
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKubeClientDrainWorkers(t *testing.T) {
+	var mu sync.Mutex
+	var cordoned, evicted []string
+	pods := map[string]string{
+		"default/web-1":            `{"metadata":{"name":"web-1","namespace":"default"},"status":{"phase":"Running"}}`,
+		"default/web-2":            `{"metadata":{"name":"web-2","namespace":"default"},"status":{"phase":"Running"}}`,
+		"default/job-1":            `{"metadata":{"name":"job-1","namespace":"default"},"status":{"phase":"Succeeded"}}`,
+		"kube-system/calico-node":  `{"metadata":{"name":"calico-node","namespace":"kube-system","ownerReferences":[{"kind":"DaemonSet"}]},"status":{"phase":"Running"}}`,
+		"kube-system/static-proxy": `{"metadata":{"name":"static-proxy","namespace":"kube-system","annotations":{"kubernetes.io/config.mirror":"1"}},"status":{"phase":"Running"}}`,
+	}
+	budget := 1
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/nodes":
+			if r.URL.Query().Get("labelSelector") != kubeWorkerIDLabel+"=kube-w1" {
+				fmt.Fprint(w, `{"items":[]}`)
+				return
+			}
+			fmt.Fprint(w, `{"items":[{"metadata":{"name":"10.240.0.4"}}]}`)
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/api/v1/nodes/"):
+			body, _ := ioutil.ReadAll(r.Body)
+			if r.Header.Get("Content-Type") != "application/merge-patch+json" || string(body) != `{"spec":{"unschedulable":true}}` {
+				t.Errorf("unexpected cordon %s %s", r.Header.Get("Content-Type"), body)
+			}
+			cordoned = append(cordoned, strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/"))
+			fmt.Fprint(w, `{}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+			if r.URL.Query().Get("fieldSelector") != "spec.nodeName=10.240.0.4" {
+				t.Errorf("unexpected pod selector %s", r.URL.RawQuery)
+			}
+			items := make([]string, 0, len(pods))
+			for _, pod := range pods {
+				items = append(items, pod)
+			}
+			fmt.Fprintf(w, `{"items":[%s]}`, strings.Join(items, ","))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/eviction"):
+			var eviction struct {
+				APIVersion string `json:"apiVersion"`
+				Metadata   struct {
+					Name      string `json:"name"`
+					Namespace string `json:"namespace"`
+				} `json:"metadata"`
+			}
+			json.NewDecoder(r.Body).Decode(&eviction)
+			if eviction.APIVersion != "policy/v1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			key := eviction.Metadata.Namespace + "/" + eviction.Metadata.Name
+			// The PodDisruptionBudget of web-2 allows its eviction once web-1 is gone
+			if key == "default/web-2" && budget == 1 && pods["default/web-1"] != "" {
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"message":"Cannot evict pod as it would violate the pod's disruption budget."}`)
+				return
+			}
+			delete(pods, key)
+			evicted = append(evicted, key)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	meta := &clientSession{config: &Config{RetryPolicy: newRetryPolicy(0)}}
+	client, err := newKubeClient(meta, clusterConfig{host: server.URL, token: "token", caCertificate: string(ca)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.drainWorkers([]string{"kube-w1", "kube-w2"}, time.Second, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"10.240.0.4"}; !reflect.DeepEqual(cordoned, want) {
+		t.Errorf("got cordoned nodes %v, want %v", cordoned, want)
+	}
+	sort.Strings(evicted)
+	if want := []string{"default/web-1", "default/web-2"}; !reflect.DeepEqual(evicted, want) {
+		t.Errorf("got evicted pods %v, want %v", evicted, want)
+	}
+}
+
+func TestKubeClientDrainTimeout(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/nodes":
+			fmt.Fprint(w, `{"items":[{"metadata":{"name":"10.240.0.4"}}]}`)
+		case r.URL.Path == "/api/v1/pods":
+			fmt.Fprint(w, `{"items":[{"metadata":{"name":"web-1","namespace":"default"},"status":{"phase":"Running"}}]}`)
+		case strings.HasSuffix(r.URL.Path, "/eviction"):
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	meta := &clientSession{config: &Config{RetryPolicy: newRetryPolicy(0)}}
+	client, err := newKubeClient(meta, clusterConfig{host: server.URL, token: "token", caCertificate: string(ca)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if err := client.drainWorkers([]string{"kube-w1"}, 20*time.Millisecond, time.Millisecond); err != nil {
+		t.Fatalf("expected the workers to be updated after the drain timeout, got %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the drain to stop after its timeout, took %s", elapsed)
+	}
+}
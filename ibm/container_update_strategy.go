@@ -0,0 +1,531 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
+)
+
+const (
+	updateStrategyMaxUnavailable = 1
+	updateStrategyDrainTimeout   = "20m"
+	updateStrategyPollInterval   = 10 * time.Second
+	albEnabled                   = "enabled"
+	albHealthy                   = "healthy"
+)
+
+// workerUpdateStrategySchema is the update_strategy block of the resources updating the workers of a cluster
+func workerUpdateStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "How the outdated workers are updated: in batches, each batch having to return to a healthy state before the next one starts",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      updateStrategyMaxUnavailable,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of workers of a worker pool updated at a time",
+				},
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of workers per zone added to a worker pool before its workers are updated, and removed once they are all updated. The surge workers are updated together with the unavailable ones.",
+				},
+				"drain_timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      updateStrategyDrainTimeout,
+					ValidateFunc: validateDuration,
+					Description:  "The maximum time for the pods of the workers of a batch to be evicted before the workers are replaced or reloaded, as a duration like 30m. The workers are cordoned and the pods left after the timeout are stopped with the workers.",
+				},
+				"wait_for_ingress_healthy": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Wait for the enabled ALBs of the cluster to be healthy after each batch",
+				},
+			},
+		},
+	}
+}
+
+// workerUpdateStrategy is the expanded update_strategy block
+type workerUpdateStrategy struct {
+	MaxUnavailable        int
+	MaxSurge              int
+	DrainTimeout          time.Duration
+	WaitForIngressHealthy bool
+
+	// pollInterval is how often the workers are checked while waiting
+	pollInterval time.Duration
+}
+
+// newWorkerUpdateStrategy returns the strategy updating one worker at a time
+func newWorkerUpdateStrategy() *workerUpdateStrategy {
+	drainTimeout, _ := time.ParseDuration(updateStrategyDrainTimeout)
+	return &workerUpdateStrategy{
+		MaxUnavailable: updateStrategyMaxUnavailable,
+		DrainTimeout:   drainTimeout,
+		pollInterval:   updateStrategyPollInterval,
+	}
+}
+
+// expandWorkerUpdateStrategy returns the strategy configured in update_strategy, or nil if the block is not set
+func expandWorkerUpdateStrategy(d *schema.ResourceData) *workerUpdateStrategy {
+	l := d.Get("update_strategy").([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	strategy := newWorkerUpdateStrategy()
+	strategy.MaxUnavailable = m["max_unavailable"].(int)
+	strategy.MaxSurge = m["max_surge"].(int)
+	strategy.WaitForIngressHealthy = m["wait_for_ingress_healthy"].(bool)
+	if drainTimeout, _ := time.ParseDuration(m["drain_timeout"].(string)); drainTimeout > 0 {
+		strategy.DrainTimeout = drainTimeout
+	}
+	return strategy
+}
+
+// batchSize is the number of workers updated at a time in a worker pool spread over zones
+func (s *workerUpdateStrategy) batchSize(zones int) int {
+	return s.MaxUnavailable + s.MaxSurge*zones
+}
+
+// workerBatches splits the workers in batches of size workers
+func workerBatches(workers []string, size int) [][]string {
+	batches := make([][]string, 0, (len(workers)+size-1)/size)
+	for size < len(workers) {
+		workers, batches = workers[size:], append(batches, workers[:size])
+	}
+	if len(workers) > 0 {
+		batches = append(batches, workers)
+	}
+	return batches
+}
+
+// rolloutWorker is the state of a worker that matters to a rolling update
+type rolloutWorker struct {
+	ID       string
+	State    string
+	Health   string
+	Outdated bool
+}
+
+// workerRollout is the cluster specific part of a rolling update of workers
+type workerRollout interface {
+	// workers lists the workers of a worker pool that aren't deleted
+	workers(pool string) ([]rolloutWorker, error)
+	// poolSize returns the number of workers per zone and the number of zones of a worker pool
+	poolSize(pool string) (int, int, error)
+	// resize sets the number of workers per zone of a worker pool
+	resize(pool string, size int) error
+	// drain cordons the nodes of the workers and evicts their pods within timeout
+	drain(workers []string, timeout time.Duration) error
+	// update replaces or reloads a worker at the version of the master
+	update(worker string) error
+	// albs lists the ALBs of the cluster
+	albs() ([]v2.AlbConfig, error)
+}
+
+// rollWorkers updates the outdated workers of the pools one pool after the other. The workers of a batch are drained
+// within DrainTimeout before they are updated. It stops at the first batch whose workers, or whose ALBs if asked,
+// don't return to a healthy state within timeout.
+func (s *workerUpdateStrategy) rollWorkers(r workerRollout, pools []string, timeout time.Duration) error {
+	for _, pool := range pools {
+		if err := s.rollWorkerPool(r, pool, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *workerUpdateStrategy) rollWorkerPool(r workerRollout, pool string, timeout time.Duration) error {
+	workers, err := r.workers(pool)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the workers of the worker pool %s: %s", pool, err)
+	}
+	outdated := make([]string, 0, len(workers))
+	for _, w := range workers {
+		if w.Outdated {
+			outdated = append(outdated, w.ID)
+		}
+	}
+	if len(outdated) == 0 {
+		return nil
+	}
+	size, zones, err := r.poolSize(pool)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the worker pool %s: %s", pool, err)
+	}
+
+	if s.MaxSurge > 0 {
+		log.Printf("[INFO] Adding %d workers per zone to the worker pool %s before its update", s.MaxSurge, pool)
+		if err := r.resize(pool, size+s.MaxSurge); err != nil {
+			return fmt.Errorf("Error resizing the worker pool %s to %d workers per zone: %s", pool, size+s.MaxSurge, err)
+		}
+		if err := s.waitForWorkerPoolHealthy(r, pool, nil, (size+s.MaxSurge)*zones, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range workerBatches(outdated, s.batchSize(zones)) {
+		log.Printf("[INFO] Draining the workers %v of the worker pool %s", batch, pool)
+		if err := r.drain(batch, s.DrainTimeout); err != nil {
+			return fmt.Errorf("Error draining the workers %v: %s", batch, err)
+		}
+		log.Printf("[INFO] Updating the workers %v of the worker pool %s", batch, pool)
+		for _, worker := range batch {
+			if err := r.update(worker); err != nil {
+				return fmt.Errorf("Error updating the worker %s: %s", worker, err)
+			}
+		}
+		if err := s.waitForWorkersUpdated(r, pool, batch, timeout); err != nil {
+			return err
+		}
+		if err := s.waitForWorkerPoolHealthy(r, pool, batch, (size+s.MaxSurge)*zones, timeout); err != nil {
+			return err
+		}
+		if s.WaitForIngressHealthy {
+			if err := s.waitForALBsHealthy(r, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.MaxSurge > 0 {
+		log.Printf("[INFO] Removing the %d surge workers per zone of the worker pool %s", s.MaxSurge, pool)
+		if err := r.resize(pool, size); err != nil {
+			return fmt.Errorf("Error resizing the worker pool %s back to %d workers per zone: %s", pool, size, err)
+		}
+		if err := s.waitForWorkerPoolHealthy(r, pool, nil, size*zones, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForWorkersUpdated waits for the workers of a batch to be either deleted or reloaded at the version of the
+// master
+func (s *workerUpdateStrategy) waitForWorkersUpdated(r workerRollout, pool string, batch []string, timeout time.Duration) error {
+	return s.waitFor(fmt.Sprintf("the workers %v to be updated", batch), timeout, func() (bool, error) {
+		workers, err := r.workers(pool)
+		if err != nil {
+			return false, err
+		}
+		inBatch := make(map[string]bool, len(batch))
+		for _, id := range batch {
+			inBatch[id] = true
+		}
+		for _, w := range workers {
+			if inBatch[w.ID] && w.Outdated {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// waitForWorkerPoolHealthy waits for count workers in the worker pool, none of them from batch being outdated, to be
+// deployed and healthy
+func (s *workerUpdateStrategy) waitForWorkerPoolHealthy(r workerRollout, pool string, batch []string, count int, timeout time.Duration) error {
+	return s.waitFor(fmt.Sprintf("the workers of the worker pool %s to be healthy", pool), timeout, func() (bool, error) {
+		workers, err := r.workers(pool)
+		if err != nil {
+			return false, err
+		}
+		return rolloutWorkersHealthy(workers, batch, count)
+	})
+}
+
+// rolloutWorkersHealthy tells whether there are count workers deployed and healthy, none of them from batch being
+// outdated. It fails if a worker failed to deploy.
+func rolloutWorkersHealthy(workers []rolloutWorker, batch []string, count int) (bool, error) {
+	inBatch := make(map[string]bool, len(batch))
+	for _, id := range batch {
+		inBatch[id] = true
+	}
+	healthy := true
+	for _, w := range workers {
+		if strings.HasSuffix(w.State, "failed") {
+			return false, fmt.Errorf("The worker %s is %s", w.ID, w.State)
+		}
+		if w.State != workerDesired || w.Health != workerNormal || (inBatch[w.ID] && w.Outdated) {
+			healthy = false
+		}
+	}
+	return healthy && len(workers) == count, nil
+}
+
+func (s *workerUpdateStrategy) waitForALBsHealthy(r workerRollout, timeout time.Duration) error {
+	return s.waitFor("the ALBs of the cluster to be healthy", timeout, func() (bool, error) {
+		albs, err := r.albs()
+		if err != nil {
+			return false, err
+		}
+		return albsHealthy(albs), nil
+	})
+}
+
+// albsHealthy tells whether the enabled ALBs are all healthy
+func albsHealthy(albs []v2.AlbConfig) bool {
+	for _, alb := range albs {
+		if alb.Enable && (alb.State != albEnabled || alb.Status != albHealthy) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *workerUpdateStrategy) waitFor(what string, timeout time.Duration, done func() (bool, error)) error {
+	log.Printf("[DEBUG] Waiting for %s", what)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"waiting"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			ok, err := done()
+			if err != nil {
+				return nil, "", err
+			}
+			if ok {
+				return what, "done", nil
+			}
+			return what, "waiting", nil
+		},
+		Timeout:      timeout,
+		PollInterval: s.pollInterval,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s: %s", what, err)
+	}
+	return nil
+}
+
+// vpcWorkerRollout replaces the outdated workers of a VPC cluster
+type vpcWorkerRollout struct {
+	meta          interface{}
+	csClient      v2.ContainerServiceAPI
+	clusterClient v1.ContainerServiceAPI
+	cluster       string
+	target        v2.ClusterTargetHeader
+	kube          *kubeClient
+}
+
+func newVpcWorkerRollout(meta interface{}, cluster string, target v2.ClusterTargetHeader) (*vpcWorkerRollout, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	clusterClient, err := meta.(ClientSession).ContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	return &vpcWorkerRollout{meta: meta, csClient: csClient, clusterClient: clusterClient, cluster: cluster, target: target}, nil
+}
+
+// pools lists the IDs of the worker pools of the cluster
+func (r *vpcWorkerRollout) pools() ([]string, error) {
+	pools, err := r.csClient.WorkerPools().ListWorkerPools(r.cluster, r.target)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the worker pools of the cluster %s: %s", r.cluster, err)
+	}
+	ids := make([]string, len(pools))
+	for i, pool := range pools {
+		ids[i] = pool.ID
+	}
+	return ids, nil
+}
+
+func (r *vpcWorkerRollout) workers(pool string) ([]rolloutWorker, error) {
+	workers, err := r.csClient.Workers().ListByWorkerPool(r.cluster, pool, false, r.target)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]rolloutWorker, 0, len(workers))
+	for _, w := range workers {
+		if w.LifeCycle.ActualState == workerDeleteState {
+			continue
+		}
+		result = append(result, rolloutWorker{
+			ID:       w.ID,
+			State:    w.LifeCycle.ActualState,
+			Health:   w.Health.State,
+			Outdated: w.KubeVersion.Actual != w.KubeVersion.Target,
+		})
+	}
+	return result, nil
+}
+
+func (r *vpcWorkerRollout) poolSize(pool string) (int, int, error) {
+	workerPool, err := r.csClient.WorkerPools().GetWorkerPool(r.cluster, pool, r.target)
+	if err != nil {
+		return 0, 0, err
+	}
+	return workerPool.WorkerCount, len(workerPool.Zones), nil
+}
+
+func (r *vpcWorkerRollout) resize(pool string, size int) error {
+	return r.clusterClient.WorkerPools().ResizeWorkerPool(r.cluster, pool, size, v1.ClusterTargetHeader{ResourceGroup: r.target.ResourceGroup})
+}
+
+func (r *vpcWorkerRollout) drain(workers []string, timeout time.Duration) error {
+	if r.kube == nil {
+		kube, err := clusterKubeClient(r.meta, r.cluster, r.target)
+		if err != nil {
+			return err
+		}
+		r.kube = kube
+	}
+	return r.kube.drainWorkers(workers, timeout, updateStrategyPollInterval)
+}
+
+func (r *vpcWorkerRollout) update(worker string) error {
+	_, err := r.csClient.Workers().ReplaceWokerNode(r.cluster, worker, r.target)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return err
+	}
+	return nil
+}
+
+func (r *vpcWorkerRollout) albs() ([]v2.AlbConfig, error) {
+	return r.csClient.Albs().ListClusterAlbs(r.cluster, r.target)
+}
+
+// satelliteWorkerRollout reloads the outdated workers of a Satellite cluster in place
+type satelliteWorkerRollout struct {
+	meta          interface{}
+	satClient     *kubernetesserviceapiv1.KubernetesServiceApiV1
+	csClient      v2.ContainerServiceAPI
+	wrkAPI        v1.Workers
+	cluster       string
+	target        v1.ClusterTargetHeader
+	masterVersion string
+	patchVersion  string
+	kube          *kubeClient
+}
+
+// pools lists the IDs of the worker pools of the cluster
+func (r *satelliteWorkerRollout) pools() ([]string, error) {
+	pools, response, err := r.satClient.GetWorkerPools1(&kubernetesserviceapiv1.GetWorkerPools1Options{
+		Cluster:            &r.cluster,
+		XAuthResourceGroup: &r.target.ResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the worker pools of the cluster %s: %s\n%s", r.cluster, err, response)
+	}
+	ids := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		if pool.ID != nil {
+			ids = append(ids, *pool.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *satelliteWorkerRollout) workers(pool string) ([]rolloutWorker, error) {
+	workers, response, err := r.satClient.GetWorkers1(&kubernetesserviceapiv1.GetWorkers1Options{
+		Cluster:            &r.cluster,
+		XAuthResourceGroup: &r.target.ResourceGroup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s", err, response)
+	}
+	result := make([]rolloutWorker, 0, len(workers))
+	for _, w := range workers {
+		if w.ID == nil || w.PoolID == nil || *w.PoolID != pool {
+			continue
+		}
+		worker := rolloutWorker{ID: *w.ID}
+		if w.Lifecycle != nil && w.Lifecycle.ActualState != nil {
+			worker.State = *w.Lifecycle.ActualState
+		}
+		if worker.State == workerDeleteState {
+			continue
+		}
+		if w.Health != nil && w.Health.State != nil {
+			worker.Health = *w.Health.State
+		}
+		if w.KubeVersion != nil && w.KubeVersion.Actual != nil && w.KubeVersion.Target != nil {
+			worker.Outdated = satelliteWorkerOutdated(*w.KubeVersion.Actual, *w.KubeVersion.Target, r.masterVersion, r.patchVersion)
+		}
+		result = append(result, worker)
+	}
+	return result, nil
+}
+
+// satelliteWorkerOutdated tells whether a worker runs an older MAJOR.MINOR version than the master, or another
+// patch version than the requested one
+func satelliteWorkerOutdated(actual, target, masterVersion, patchVersion string) bool {
+	if strings.Split(actual, "_")[0] != strings.Split(masterVersion, "_")[0] {
+		return true
+	}
+	actualParts, targetParts := strings.Split(actual, "."), strings.Split(target, ".")
+	return len(actualParts) > 2 && len(targetParts) > 2 && actualParts[2] != patchVersion && targetParts[2] == patchVersion
+}
+
+func (r *satelliteWorkerRollout) poolSize(pool string) (int, int, error) {
+	workerPool, response, err := r.satClient.GetWorkerPool(&kubernetesserviceapiv1.GetWorkerPoolOptions{
+		Cluster:            &r.cluster,
+		Workerpool:         &pool,
+		XAuthResourceGroup: &r.target.ResourceGroup,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s\n%s", err, response)
+	}
+	size := 0
+	if workerPool.WorkerCount != nil {
+		size = int(*workerPool.WorkerCount)
+	}
+	return size, len(workerPool.Zones), nil
+}
+
+func (r *satelliteWorkerRollout) resize(pool string, size int) error {
+	workerCount := int64(size)
+	response, err := r.satClient.V2ResizeWorkerPool(&kubernetesserviceapiv1.V2ResizeWorkerPoolOptions{
+		Cluster:            &r.cluster,
+		Workerpool:         &pool,
+		Size:               &workerCount,
+		XAuthResourceGroup: &r.target.ResourceGroup,
+	})
+	if err != nil {
+		return fmt.Errorf("%s\n%s", err, response)
+	}
+	return nil
+}
+
+func (r *satelliteWorkerRollout) drain(workers []string, timeout time.Duration) error {
+	if r.kube == nil {
+		kube, err := clusterKubeClient(r.meta, r.cluster, v2.ClusterTargetHeader{ResourceGroup: r.target.ResourceGroup})
+		if err != nil {
+			return err
+		}
+		r.kube = kube
+	}
+	return r.kube.drainWorkers(workers, timeout, updateStrategyPollInterval)
+}
+
+func (r *satelliteWorkerRollout) update(worker string) error {
+	return r.wrkAPI.Update(r.cluster, worker, v1.WorkerUpdateParam{Action: "update"}, r.target)
+}
+
+func (r *satelliteWorkerRollout) albs() ([]v2.AlbConfig, error) {
+	return r.csClient.Albs().ListClusterAlbs(r.cluster, v2.ClusterTargetHeader{ResourceGroup: r.target.ResourceGroup})
+}
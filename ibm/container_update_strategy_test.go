@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeWorkerRollout replaces the updated workers of a single worker pool like a VPC cluster does
+type fakeWorkerRollout struct {
+	pool     []rolloutWorker
+	zones    int
+	size     int
+	next     int
+	updated  []string
+	drained  []string
+	resized  []int
+	failNext bool
+	alb      v2.AlbConfig
+}
+
+func newFakeWorkerRollout(size, zones int) *fakeWorkerRollout {
+	r := &fakeWorkerRollout{size: size, zones: zones, alb: v2.AlbConfig{Enable: true, State: albEnabled, Status: albHealthy}}
+	for i := 0; i < size*zones; i++ {
+		r.pool = append(r.pool, rolloutWorker{ID: fmt.Sprintf("old-%d", i), State: workerDesired, Health: workerNormal, Outdated: true})
+	}
+	return r
+}
+
+func (r *fakeWorkerRollout) add(n int) {
+	for i := 0; i < n; i++ {
+		r.next++
+		w := rolloutWorker{ID: fmt.Sprintf("new-%d", r.next), State: workerDesired, Health: workerNormal}
+		if r.failNext {
+			w.State = "provision_failed"
+		}
+		r.pool = append(r.pool, w)
+	}
+}
+
+func (r *fakeWorkerRollout) workers(pool string) ([]rolloutWorker, error) {
+	return append([]rolloutWorker(nil), r.pool...), nil
+}
+
+func (r *fakeWorkerRollout) poolSize(pool string) (int, int, error) {
+	return r.size, r.zones, nil
+}
+
+func (r *fakeWorkerRollout) resize(pool string, size int) error {
+	r.resized = append(r.resized, size)
+	if delta := (size - r.size) * r.zones; delta > 0 {
+		r.add(delta)
+	} else {
+		r.pool = r.pool[:len(r.pool)+delta]
+	}
+	r.size = size
+	return nil
+}
+
+func (r *fakeWorkerRollout) drain(workers []string, timeout time.Duration) error {
+	r.drained = append(r.drained, workers...)
+	return nil
+}
+
+func (r *fakeWorkerRollout) update(worker string) error {
+	drained := false
+	for _, w := range r.drained {
+		drained = drained || w == worker
+	}
+	if !drained {
+		return fmt.Errorf("the worker %s was updated before it was drained", worker)
+	}
+	for i, w := range r.pool {
+		if w.ID == worker {
+			r.pool = append(r.pool[:i], r.pool[i+1:]...)
+			break
+		}
+	}
+	r.updated = append(r.updated, worker)
+	r.add(1)
+	return nil
+}
+
+func (r *fakeWorkerRollout) albs() ([]v2.AlbConfig, error) {
+	return []v2.AlbConfig{r.alb, {Enable: false, State: "disabled"}}, nil
+}
+
+func testWorkerUpdateStrategy(maxUnavailable, maxSurge int) *workerUpdateStrategy {
+	strategy := newWorkerUpdateStrategy()
+	strategy.MaxUnavailable = maxUnavailable
+	strategy.MaxSurge = maxSurge
+	strategy.DrainTimeout = 100 * time.Millisecond
+	strategy.pollInterval = time.Millisecond
+	return strategy
+}
+
+func TestExpandWorkerUpdateStrategy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"update_strategy": workerUpdateStrategySchema()}, map[string]interface{}{
+		"update_strategy": []interface{}{map[string]interface{}{"max_unavailable": 2, "drain_timeout": "45m"}},
+	})
+	strategy := expandWorkerUpdateStrategy(d)
+	if strategy == nil || strategy.MaxUnavailable != 2 || strategy.DrainTimeout != 45*time.Minute {
+		t.Errorf("unexpected strategy %+v", strategy)
+	}
+}
+
+func TestWorkerBatches(t *testing.T) {
+	workers := []string{"a", "b", "c", "d", "e"}
+	for size, want := range map[int][][]string{
+		1: {{"a"}, {"b"}, {"c"}, {"d"}, {"e"}},
+		2: {{"a", "b"}, {"c", "d"}, {"e"}},
+		5: {{"a", "b", "c", "d", "e"}},
+		8: {{"a", "b", "c", "d", "e"}},
+	} {
+		if got := workerBatches(workers, size); !reflect.DeepEqual(got, want) {
+			t.Errorf("batches of %d: got %v, want %v", size, got, want)
+		}
+	}
+}
+
+func TestWorkerUpdateStrategyRollWorkers(t *testing.T) {
+	r := newFakeWorkerRollout(2, 2)
+	strategy := testWorkerUpdateStrategy(1, 1)
+	strategy.WaitForIngressHealthy = true
+	if err := strategy.rollWorkers(r, []string{"pool"}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{3, 2}; !reflect.DeepEqual(r.resized, want) {
+		t.Errorf("got resizes %v, want %v", r.resized, want)
+	}
+	if want := []string{"old-0", "old-1", "old-2", "old-3"}; !reflect.DeepEqual(r.updated, want) {
+		t.Errorf("got updates %v, want %v", r.updated, want)
+	}
+	if !reflect.DeepEqual(r.drained, r.updated) {
+		t.Errorf("got drains %v, want %v", r.drained, r.updated)
+	}
+	if len(r.pool) != 4 {
+		t.Errorf("got %d workers, want 4", len(r.pool))
+	}
+	for _, w := range r.pool {
+		if w.Outdated {
+			t.Errorf("worker %s is still outdated", w.ID)
+		}
+	}
+}
+
+func TestWorkerUpdateStrategyStopsOnFailedWorker(t *testing.T) {
+	r := newFakeWorkerRollout(3, 1)
+	r.failNext = true
+	err := testWorkerUpdateStrategy(1, 0).rollWorkers(r, []string{"pool"}, time.Second)
+	if err == nil || !strings.Contains(err.Error(), "provision_failed") {
+		t.Fatalf("expected the update to fail on the failed worker, got %v", err)
+	}
+	if want := []string{"old-0"}; !reflect.DeepEqual(r.updated, want) {
+		t.Errorf("got updates %v, want %v", r.updated, want)
+	}
+}
+
+func TestWorkerUpdateStrategyStopsOnUnhealthyIngress(t *testing.T) {
+	r := newFakeWorkerRollout(3, 1)
+	r.alb.Status = "critical"
+	strategy := testWorkerUpdateStrategy(2, 0)
+	strategy.WaitForIngressHealthy = true
+	err := strategy.rollWorkers(r, []string{"pool"}, 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "ALBs") {
+		t.Fatalf("expected the update to time out on the ALBs, got %v", err)
+	}
+	if want := []string{"old-0", "old-1"}; !reflect.DeepEqual(r.updated, want) {
+		t.Errorf("got updates %v, want %v", r.updated, want)
+	}
+}
+
+func TestSatelliteWorkerOutdated(t *testing.T) {
+	for _, c := range []struct {
+		actual, target, master, patch string
+		want                          bool
+	}{
+		{"4.6.30_openshift", "4.7.19_openshift", "4.7.19_openshift", "", true},
+		{"4.7.16_openshift", "4.7.19_openshift", "4.7.19_openshift", "19_openshift", true},
+		{"4.7.19_openshift", "4.7.19_openshift", "4.7.19_openshift", "19_openshift", false},
+		{"4.7.16_openshift", "4.7.19_openshift", "4.7.19_openshift", "", true},
+		{"4.7.19_openshift", "4.7.19_openshift", "4.7.19_openshift", "", false},
+	} {
+		if got := satelliteWorkerOutdated(c.actual, c.target, c.master, c.patch); got != c.want {
+			t.Errorf("satelliteWorkerOutdated(%q, %q, %q, %q) = %t, want %t", c.actual, c.target, c.master, c.patch, got, c.want)
+		}
+	}
+}
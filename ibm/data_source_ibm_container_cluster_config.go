@@ -4,20 +4,36 @@
 package ibm
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 )
 
+const (
+	clusterConfigOutputFile   = "file"
+	clusterConfigOutputMemory = "memory"
+
+	clusterConfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	clusterConfigExecCommand    = "ibmcloud"
+)
+
 func dataSourceIBMContainerClusterConfig() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIBMContainerClusterConfigRead,
@@ -64,6 +80,47 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"output": {
+				Description:  "Where to put the config: file downloads it into config_dir, memory only returns it in config_yaml without writing any file",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      clusterConfigOutputFile,
+				ValidateFunc: InvokeDataSourceValidator("ibm_container_cluster_config", "output"),
+			},
+			"exec": {
+				Description: "With the memory output, replaces the static credentials of config_yaml by an exec credential plugin which gets a fresh token on every request. Can't be used with admin.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Description: "The client.authentication.k8s.io API version of the ExecCredential the command prints",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     clusterConfigExecAPIVersion,
+						},
+						"command": {
+							Description: "The command printing the ExecCredential, with an IAM token",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     clusterConfigExecCommand,
+						},
+						"args": {
+							Description: "The arguments of the command, by default the ibmcloud ks arguments printing the token of the cluster",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"env": {
+							Description: "The environment variables of the command",
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"download": {
 				Description: "If set to false will not download the config, otherwise they are downloaded each time but onto the same path for a given cluster name/id",
 				Type:        schema.TypeBool,
@@ -87,6 +144,12 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"config_yaml": {
+				Description: "The kubernetes config yml, with its certificates inlined, when output is memory",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 			"calico_config_file_path": {
 				Description: "The absolute path to the calico network config file ",
 				Type:        schema.TypeString,
@@ -120,6 +183,21 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 	}
 }
 
+func dataSourceIBMContainerClusterConfigValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "output",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", clusterConfigOutputFile, clusterConfigOutputMemory),
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_container_cluster_config", Schema: validateSchema}
+	return &resourceValidator
+}
+
 func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -132,6 +210,60 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	configDir := d.Get("config_dir").(string)
 	network := d.Get("network").(bool)
 
+	if d.Get("output").(string) == clusterConfigOutputMemory {
+		if network {
+			return fmt.Errorf("network can't be set with the %s output, as the Calico config is made of files", clusterConfigOutputMemory)
+		}
+		var exec map[string]interface{}
+		if execs := d.Get("exec").([]interface{}); len(execs) > 0 {
+			if admin {
+				return fmt.Errorf("exec can't be set with admin, as the admin config authenticates with certificates")
+			}
+			// An empty exec block has no element, and gets the default command
+			exec, _ = execs[0].(map[string]interface{})
+			if exec == nil {
+				exec = map[string]interface{}{"api_version": clusterConfigExecAPIVersion, "command": clusterConfigExecCommand}
+			}
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		var config clusterConfig
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			var err error
+			config, err = getClusterConfigInMemory(csClient, name, admin, targetEnv)
+			if err != nil {
+				log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+				if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if isResourceTimeoutError(err) {
+			config, err = getClusterConfigInMemory(csClient, name, admin, targetEnv)
+		}
+		if err != nil {
+			return fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err)
+		}
+		if exec != nil {
+			if err = config.setExec(exec, name); err != nil {
+				return fmt.Errorf("Error setting the exec credentials of the cluster config [%s]: %s", name, err)
+			}
+		}
+		d.Set("config_yaml", config.yaml)
+		d.Set("admin_key", config.adminKey)
+		d.Set("admin_certificate", config.adminCertificate)
+		d.Set("ca_certificate", config.caCertificate)
+		d.Set("host", config.host)
+		d.Set("token", config.token)
+		d.Set("config_file_path", "")
+		d.SetId(name)
+		return nil
+	}
+
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
 		if err != nil {
@@ -218,3 +350,268 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// containerRequester is the request methods of the container service client, used for the requests the client
+// doesn't model, such as the download of the cluster config into memory rather than into a directory
+type containerRequester interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+// openShiftTokenFetcher logs in to an OpenShift cluster with a passcode of the IAM session, and adds the resulting
+// token to its kubeconfig
+type openShiftTokenFetcher interface {
+	FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool) ([]byte, error)
+}
+
+// clusterConfig is a kubeconfig of a cluster read in memory, with its certificates inlined
+type clusterConfig struct {
+	yaml             string
+	host             string
+	token            string
+	caCertificate    string
+	adminCertificate string
+	adminKey         string
+}
+
+// getClusterConfigInMemory downloads the config of a cluster like GetClusterConfigDetail of the container service
+// client, without writing it to disk
+func getClusterConfigInMemory(csClient v2.ContainerServiceAPI, name string, admin bool, target v2.ClusterTargetHeader) (clusterConfig, error) {
+	clusterInfo, err := csClient.Clusters().GetCluster(name, target)
+	if err != nil {
+		return clusterConfig{}, err
+	}
+	poster, ok := csClient.(containerRequester)
+	if !ok {
+		return clusterConfig{}, fmt.Errorf("The container service client can't download the cluster config into memory")
+	}
+
+	postBody := map[string]interface{}{
+		"cluster": name,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if clusterInfo.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	}
+	var zipFile bytes.Buffer
+	_, err = poster.Post("/v2/applyRBACAndGetKubeconfig", postBody, &zipFile, target.ToMap())
+	if err != nil {
+		return clusterConfig{}, err
+	}
+	config, err := clusterConfigFromZip(zipFile.Bytes())
+	if err != nil {
+		return clusterConfig{}, err
+	}
+
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		fetcher, ok := csClient.Clusters().(openShiftTokenFetcher)
+		if !ok {
+			return clusterConfig{}, fmt.Errorf("The container service client can't log in to the OpenShift cluster")
+		}
+		kubeconfig, err := fetcher.FetchOCTokenForKubeConfig([]byte(config.yaml), clusterInfo, clusterInfo.IsStagingSatelliteCluster())
+		if err != nil {
+			return clusterConfig{}, err
+		}
+		if err = config.setOpenShiftToken(kubeconfig); err != nil {
+			return clusterConfig{}, err
+		}
+	}
+	return config, nil
+}
+
+// clusterConfigFromZip reads the kubeconfig and the certificates of the zip file of a cluster config, and inlines
+// the certificates, which the kubeconfig references by file name, into it
+func clusterConfigFromZip(data []byte) (clusterConfig, error) {
+	config := clusterConfig{}
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return config, fmt.Errorf("Error reading the cluster config zip: %s", err)
+	}
+	var kubeconfig []byte
+	pems := map[string][]byte{}
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return config, err
+		}
+		content, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return config, err
+		}
+		fileName := filepath.Base(f.Name)
+		switch {
+		case strings.HasSuffix(fileName, ".yml") || strings.HasSuffix(fileName, ".yaml"):
+			kubeconfig = content
+		case fileName == "admin-key.pem":
+			config.adminKey = string(content)
+			pems[fileName] = content
+		case fileName == "admin.pem":
+			config.adminCertificate = string(content)
+			pems[fileName] = content
+		case strings.HasSuffix(fileName, ".pem"):
+			if strings.HasPrefix(fileName, "ca") {
+				config.caCertificate = string(content)
+			}
+			pems[fileName] = content
+		}
+	}
+	if kubeconfig == nil {
+		return config, fmt.Errorf("Unable to locate kube config in zip archive")
+	}
+
+	var cfg map[string]interface{}
+	if err = yaml.Unmarshal(kubeconfig, &cfg); err != nil {
+		return config, fmt.Errorf("Error parsing the kube config: %s", err)
+	}
+	for i, c := range clusterConfigItems(cfg, "clusters", "cluster") {
+		if err = inlineClusterConfigFile(c, "certificate-authority", pems); err != nil {
+			return config, err
+		}
+		if i == 0 {
+			config.host, _ = c["server"].(string)
+		}
+	}
+	for i, u := range clusterConfigItems(cfg, "users", "user") {
+		if err = inlineClusterConfigFile(u, "client-certificate", pems); err != nil {
+			return config, err
+		}
+		if err = inlineClusterConfigFile(u, "client-key", pems); err != nil {
+			return config, err
+		}
+		if i == 0 {
+			if authProvider, ok := u["auth-provider"].(map[string]interface{}); ok {
+				if providerConfig, ok := authProvider["config"].(map[string]interface{}); ok {
+					config.token, _ = providerConfig["id-token"].(string)
+				}
+			}
+			if token, ok := u["token"].(string); ok {
+				config.token = token
+			}
+		}
+	}
+
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return config, err
+	}
+	config.yaml = string(out)
+	return config, nil
+}
+
+// setOpenShiftToken takes the kubeconfig with the token of the OpenShift login, whose cluster has no CA certificate
+func (config *clusterConfig) setOpenShiftToken(kubeconfig []byte) error {
+	var cfg map[string]interface{}
+	if err := yaml.Unmarshal(kubeconfig, &cfg); err != nil {
+		return fmt.Errorf("Error parsing the kube config: %s", err)
+	}
+	users, _ := cfg["users"].([]interface{})
+	for _, u := range users {
+		if user, ok := u.(map[string]interface{}); ok {
+			if userName, _ := user["name"].(string); strings.HasPrefix(userName, "IAM") {
+				if credentials, ok := user["user"].(map[string]interface{}); ok {
+					config.token, _ = credentials["token"].(string)
+				}
+			}
+		}
+	}
+	clusters := clusterConfigItems(cfg, "clusters", "cluster")
+	if len(clusters) != 0 {
+		config.host, _ = clusters[len(clusters)-1]["server"].(string)
+	}
+	config.caCertificate = ""
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	config.yaml = string(out)
+	return nil
+}
+
+// setExec replaces the credentials of all the users of the kubeconfig by the exec credential plugin, and drops the
+// static token and certificates. Without args, the default command prints the token of the cluster with ibmcloud ks.
+func (config *clusterConfig) setExec(exec map[string]interface{}, cluster string) error {
+	var cfg map[string]interface{}
+	if err := yaml.Unmarshal([]byte(config.yaml), &cfg); err != nil {
+		return fmt.Errorf("Error parsing the kube config: %s", err)
+	}
+	execConfig := map[string]interface{}{
+		"apiVersion": exec["api_version"].(string),
+		"command":    exec["command"].(string),
+	}
+	if args, ok := exec["args"].([]interface{}); ok && len(args) > 0 {
+		execConfig["args"] = args
+	} else if execConfig["command"] == clusterConfigExecCommand {
+		execConfig["args"] = clusterConfigExecArgs(cluster)
+	}
+	if env, ok := exec["env"].(map[string]interface{}); ok && len(env) > 0 {
+		// The variables are sorted so that the kubeconfig doesn't change between reads
+		names := make([]string, 0, len(env))
+		for k := range env {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		envList := make([]interface{}, 0, len(env))
+		for _, k := range names {
+			envList = append(envList, map[string]interface{}{"name": k, "value": env[k]})
+		}
+		execConfig["env"] = envList
+	}
+	users, _ := cfg["users"].([]interface{})
+	for _, u := range users {
+		if user, ok := u.(map[string]interface{}); ok {
+			user["user"] = map[string]interface{}{"exec": execConfig}
+		}
+	}
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	config.yaml = string(out)
+	config.token = ""
+	config.adminCertificate = ""
+	config.adminKey = ""
+	return nil
+}
+
+// clusterConfigExecArgs returns the ibmcloud arguments printing the ExecCredential of the cluster
+func clusterConfigExecArgs(cluster string) []interface{} {
+	return []interface{}{"ks", "cluster", "config", "--cluster", cluster, "--output", "exec-credential"}
+}
+
+// clusterConfigItems returns the inner maps of the named items of a kubeconfig list, e.g. the cluster of each
+// item of clusters
+func clusterConfigItems(cfg map[string]interface{}, list, key string) []map[string]interface{} {
+	items, _ := cfg[list].([]interface{})
+	inner := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			if innerMap, ok := itemMap[key].(map[string]interface{}); ok {
+				inner = append(inner, innerMap)
+			}
+		}
+	}
+	return inner
+}
+
+// inlineClusterConfigFile replaces the reference to a file of a kubeconfig entry by its base64 data
+func inlineClusterConfigFile(entry map[string]interface{}, key string, files map[string][]byte) error {
+	fileName, ok := entry[key].(string)
+	if !ok {
+		return nil
+	}
+	content, ok := files[filepath.Base(fileName)]
+	if !ok {
+		return fmt.Errorf("Unable to locate %s in zip archive", fileName)
+	}
+	delete(entry, key)
+	entry[key+"-data"] = base64.StdEncoding.EncodeToString(content)
+	return nil
+}
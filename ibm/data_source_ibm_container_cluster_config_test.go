@@ -4,9 +4,15 @@
 package ibm
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/ghodss/yaml"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/mitchellh/go-homedir"
@@ -34,6 +40,180 @@ func TestAccIBMContainer_ClusterConfigDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainer_ClusterConfigMemoryDataSourceBasic(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterMemoryConfigDataSource(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "config_yaml"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "host"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_container_cluster_config.testacc_ds_cluster", "config_file_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestClusterConfigFromZip(t *testing.T) {
+	kubeconfig := `apiVersion: v1
+clusters:
+- name: mycluster/c3ab
+  cluster:
+    certificate-authority: ca-aaa00-mycluster.pem
+    server: https://c100.us-south.containers.cloud.ibm.com:30426
+contexts:
+- name: mycluster/c3ab
+  context:
+    cluster: mycluster/c3ab
+    user: admin
+current-context: mycluster/c3ab
+kind: Config
+users:
+- name: admin
+  user:
+    client-certificate: admin.pem
+    client-key: admin-key.pem
+`
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"mycluster-admin/kube-config-aaa00-mycluster.yml": kubeconfig,
+		"mycluster-admin/ca-aaa00-mycluster.pem":          "CA",
+		"mycluster-admin/admin.pem":                       "CERT",
+		"mycluster-admin/admin-key.pem":                   "KEY",
+	} {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(content))
+	}
+	w.Close()
+
+	config, err := clusterConfigFromZip(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.host != "https://c100.us-south.containers.cloud.ibm.com:30426" || config.caCertificate != "CA" ||
+		config.adminCertificate != "CERT" || config.adminKey != "KEY" {
+		t.Errorf("unexpected config %+v", config)
+	}
+	for _, want := range []string{
+		"certificate-authority-data: " + base64.StdEncoding.EncodeToString([]byte("CA")),
+		"client-certificate-data: " + base64.StdEncoding.EncodeToString([]byte("CERT")),
+		"client-key-data: " + base64.StdEncoding.EncodeToString([]byte("KEY")),
+	} {
+		if !strings.Contains(config.yaml, want) {
+			t.Errorf("expected %q in the kubeconfig:\n%s", want, config.yaml)
+		}
+	}
+	if strings.Contains(config.yaml, "admin.pem") {
+		t.Errorf("expected no reference to the certificate files in the kubeconfig:\n%s", config.yaml)
+	}
+
+	err = config.setExec(map[string]interface{}{
+		"api_version": clusterConfigExecAPIVersion,
+		"command":     "iks-token",
+		"args":        []interface{}{"--cluster", "mycluster"},
+		"env":         map[string]interface{}{},
+	}, "mycluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(config.yaml, "command: iks-token") || strings.Contains(config.yaml, "client-key-data") {
+		t.Errorf("expected the exec credentials only in the kubeconfig:\n%s", config.yaml)
+	}
+	if config.token != "" || config.adminCertificate != "" || config.adminKey != "" {
+		t.Errorf("expected no static credentials with exec, got %+v", config)
+	}
+
+	err = config.setExec(map[string]interface{}{
+		"api_version": clusterConfigExecAPIVersion,
+		"command":     clusterConfigExecCommand,
+	}, "mycluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(config.yaml, "command: ibmcloud") || !strings.Contains(config.yaml, "- exec-credential") ||
+		!strings.Contains(config.yaml, "- mycluster") {
+		t.Errorf("expected the default ibmcloud ks exec credentials in the kubeconfig:\n%s", config.yaml)
+	}
+}
+
+func TestClusterConfigSetExec(t *testing.T) {
+	exec := func(config *clusterConfig) map[string]interface{} {
+		var cfg struct {
+			Users []struct {
+				User struct {
+					Exec map[string]interface{} `json:"exec"`
+				} `json:"user"`
+			} `json:"users"`
+		}
+		if err := yaml.Unmarshal([]byte(config.yaml), &cfg); err != nil {
+			t.Fatal(err)
+		}
+		if len(cfg.Users) != 1 {
+			t.Fatalf("expected one user in the kubeconfig:\n%s", config.yaml)
+		}
+		return cfg.Users[0].User.Exec
+	}
+	kubeconfig := "apiVersion: v1\nkind: Config\nusers:\n- name: admin\n  user:\n    token: static\n"
+
+	config := &clusterConfig{yaml: kubeconfig, token: "static"}
+	err := config.setExec(map[string]interface{}{
+		"api_version": clusterConfigExecAPIVersion,
+		"command":     clusterConfigExecCommand,
+		"env":         map[string]interface{}{"IBMCLOUD_HOME": "/home/ci", "IBMCLOUD_API_KEY": "key", "HOME": "/root"},
+	}, "mycluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := exec(config)
+	expected := map[string]interface{}{
+		"apiVersion": "client.authentication.k8s.io/v1beta1",
+		"command":    "ibmcloud",
+		"args":       []interface{}{"ks", "cluster", "config", "--cluster", "mycluster", "--output", "exec-credential"},
+		"env": []interface{}{
+			map[string]interface{}{"name": "HOME", "value": "/root"},
+			map[string]interface{}{"name": "IBMCLOUD_API_KEY", "value": "key"},
+			map[string]interface{}{"name": "IBMCLOUD_HOME", "value": "/home/ci"},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected the exec credentials %v, got %v", expected, got)
+	}
+
+	// The kubeconfig is the same whatever the iteration order of the env map
+	first := config.yaml
+	for i := 0; i < 10; i++ {
+		config := &clusterConfig{yaml: kubeconfig}
+		config.setExec(map[string]interface{}{
+			"api_version": clusterConfigExecAPIVersion,
+			"command":     clusterConfigExecCommand,
+			"env":         map[string]interface{}{"IBMCLOUD_HOME": "/home/ci", "IBMCLOUD_API_KEY": "key", "HOME": "/root"},
+		}, "mycluster")
+		if config.yaml != first {
+			t.Fatalf("expected a stable kubeconfig, got:\n%s\nand:\n%s", first, config.yaml)
+		}
+	}
+
+	config = &clusterConfig{yaml: kubeconfig}
+	config.setExec(map[string]interface{}{
+		"api_version": clusterConfigExecAPIVersion,
+		"command":     "iks-token",
+	}, "mycluster")
+	if args, ok := exec(config)["args"]; ok {
+		t.Fatalf("expected no default arguments for another command, got %v", args)
+	}
+}
+
 func TestAccIBMContainer_ClusterConfigCalicoDataSourceBasic(t *testing.T) {
 	homeDir, err := homedir.Dir()
 	if err != nil {
@@ -92,3 +272,21 @@ data "ibm_container_cluster_config" "testacc_ds_cluster" {
   network         = true
 }`, clustername, datacenter, machineType, publicVlanID, privateVlanID)
 }
+
+func testAccCheckIBMContainerClusterMemoryConfigDataSource(clustername string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_cluster" "testacc_cluster" {
+  name            = "%s"
+  datacenter      = "%s"
+  machine_type    = "%s"
+  hardware        = "shared"
+  wait_till       = "MasterNodeReady"
+  public_vlan_id  = "%s"
+  private_vlan_id = "%s"
+}
+
+data "ibm_container_cluster_config" "testacc_ds_cluster" {
+  cluster_name_id = ibm_container_cluster.testacc_cluster.id
+  output          = "memory"
+}`, clustername, datacenter, machineType, publicVlanID, privateVlanID)
+}
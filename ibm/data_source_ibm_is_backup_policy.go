@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBackupPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"identifier", isBackupPolicyName},
+				Description:  "The unique identifier of the backup policy.",
+			},
+			isBackupPolicyName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identifier", isBackupPolicyName},
+				Description:  "The unique user-defined name of the backup policy.",
+			},
+			isBackupPolicyMatchUserTags: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags the backup policy applies to.",
+			},
+			isBackupPolicyMatchResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the resources the backup policy applies to.",
+			},
+			isBackupPolicyResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource group of the backup policy.",
+			},
+			isBackupPolicyPlans: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plans of the backup policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the plan.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the plan.",
+						},
+					},
+				},
+			},
+			isBackupPolicyHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health state of the backup policy.",
+			},
+			isBackupPolicyLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the backup policy.",
+			},
+			isBackupPolicyLastJobCompletedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the most recent job of the backup policy completed.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the backup policy was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this backup policy.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this backup policy.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBackupPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var backupPolicy *vpcext.BackupPolicy
+	if id, ok := d.GetOk("identifier"); ok {
+		var response *core.DetailedResponse
+		backupPolicy, response, err = client.GetBackupPolicy(context, id.(string))
+		if err != nil {
+			log.Printf("[DEBUG] GetBackupPolicy failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		name := d.Get(isBackupPolicyName).(string)
+		backupPolicies, response, err := client.ListBackupPolicies(context)
+		if err != nil {
+			log.Printf("[DEBUG] ListBackupPolicies failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		for i := range backupPolicies {
+			if *backupPolicies[i].Name == name {
+				backupPolicy = &backupPolicies[i]
+				break
+			}
+		}
+		if backupPolicy == nil {
+			return diag.FromErr(fmt.Errorf("No backup policy found with name %s", name))
+		}
+	}
+	d.SetId(*backupPolicy.ID)
+
+	for k, v := range dataSourceIBMISBackupPolicyToMap(*backupPolicy) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+// dataSourceIBMISBackupPolicyToMap returns the attributes of a backup policy common to the ibm_is_backup_policy
+// resource and data source
+func dataSourceIBMISBackupPolicyToMap(backupPolicy vpcext.BackupPolicy) map[string]interface{} {
+	backupPolicyMap := map[string]interface{}{}
+
+	backupPolicyMap[isBackupPolicyName] = backupPolicy.Name
+	backupPolicyMap[isBackupPolicyMatchUserTags] = backupPolicy.MatchUserTags
+	backupPolicyMap[isBackupPolicyMatchResourceType] = backupPolicy.MatchResourceType
+	if backupPolicy.ResourceGroup != nil {
+		backupPolicyMap[isBackupPolicyResourceGroup] = backupPolicy.ResourceGroup.ID
+	}
+	plans := make([]map[string]interface{}, 0, len(backupPolicy.Plans))
+	for _, plan := range backupPolicy.Plans {
+		plans = append(plans, map[string]interface{}{
+			"id":   plan.ID,
+			"name": plan.Name,
+		})
+	}
+	backupPolicyMap[isBackupPolicyPlans] = plans
+	backupPolicyMap[isBackupPolicyHealthState] = backupPolicy.HealthState
+	backupPolicyMap[isBackupPolicyLifecycleState] = backupPolicy.LifecycleState
+	backupPolicyMap[isBackupPolicyLastJobCompletedAt] = backupPolicy.LastJobCompletedAt
+	backupPolicyMap["created_at"] = backupPolicy.CreatedAt
+	backupPolicyMap["crn"] = backupPolicy.CRN
+	backupPolicyMap["href"] = backupPolicy.Href
+	backupPolicyMap["resource_type"] = backupPolicy.ResourceType
+
+	return backupPolicyMap
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBackupPolicyJobs = "jobs"
+)
+
+func dataSourceIBMISBackupPolicyJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBackupPolicyJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			isBackupPolicyPlanBackupPolicy: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the backup policy.",
+			},
+			isBackupPolicyPlanID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The unique identifier of a plan of the backup policy, to list the jobs of this plan only.",
+			},
+			isBackupPolicyJobs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The jobs of the backup policy, which create the backups and delete the ones over the retention of their plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the job.",
+						},
+						isBackupPolicyPlanID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the plan of the job.",
+						},
+						"job_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the job: creation or deletion of backups.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the job: running, succeeded or failed.",
+						},
+						"status_reasons": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The reasons of the status of the job.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A snake case string succinctly identifying the status reason.",
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "An explanation of the status reason.",
+									},
+									"more_info": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "A link to documentation about the status reason.",
+									},
+								},
+							},
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource the job backs up.",
+						},
+						"target_snapshots": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The unique identifiers of the snapshots the job created or deleted.",
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the job is deleted after auto_delete_after days.",
+						},
+						"auto_delete_after": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of days after which the job is deleted.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the job was created.",
+						},
+						"completed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the job completed.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this job.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBackupPolicyJobsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get(isBackupPolicyPlanBackupPolicy).(string)
+	planID := d.Get(isBackupPolicyPlanID).(string)
+	backupPolicyJobs, response, err := client.ListBackupPolicyJobs(context, backupPolicyID, planID)
+	if err != nil {
+		log.Printf("[DEBUG] ListBackupPolicyJobs failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	jobs := make([]map[string]interface{}, 0, len(backupPolicyJobs))
+	for _, job := range backupPolicyJobs {
+		jobs = append(jobs, dataSourceIBMISBackupPolicyJobToMap(job))
+	}
+	if planID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", backupPolicyID, planID))
+	} else {
+		d.SetId(backupPolicyID)
+	}
+	if err = d.Set(isBackupPolicyJobs, jobs); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting %s: %s", isBackupPolicyJobs, err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMISBackupPolicyJobToMap(job vpcext.BackupPolicyJob) map[string]interface{} {
+	jobMap := map[string]interface{}{
+		"id":                job.ID,
+		"job_type":          job.JobType,
+		"status":            job.Status,
+		"auto_delete":       job.AutoDelete,
+		"auto_delete_after": job.AutoDeleteAfter,
+		"created_at":        job.CreatedAt,
+		"completed_at":      job.CompletedAt,
+		"href":              job.Href,
+	}
+	if job.BackupPolicyPlan != nil {
+		jobMap[isBackupPolicyPlanID] = job.BackupPolicyPlan.ID
+	}
+	if job.Source != nil {
+		jobMap["source"] = job.Source.ID
+	}
+	statusReasons := make([]map[string]interface{}, 0, len(job.StatusReasons))
+	for _, reason := range job.StatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			"code":      reason.Code,
+			"message":   reason.Message,
+			"more_info": reason.MoreInfo,
+		})
+	}
+	jobMap["status_reasons"] = statusReasons
+	targetSnapshots := make([]string, 0, len(job.TargetSnapshots))
+	for _, snapshot := range job.TargetSnapshots {
+		targetSnapshots = append(targetSnapshots, *snapshot.ID)
+	}
+	jobMap["target_snapshots"] = targetSnapshots
+	return jobMap
+}
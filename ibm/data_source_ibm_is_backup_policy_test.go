@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBackupPolicyDataSourceBasic(t *testing.T) {
+	policyName := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyDataSourceConfig(policyName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_backup_policy.testacc_ds_backup_policy", "id",
+						"ibm_is_backup_policy.testacc_backup_policy", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policy.testacc_ds_backup_policy", "name", policyName),
+					resource.TestCheckResourceAttr("data.ibm_is_backup_policy.testacc_ds_backup_policy", "plans.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_backup_policy.testacc_ds_backup_policy", "plans.0.id",
+						"ibm_is_backup_policy_plan.testacc_backup_plan", "backup_policy_plan"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_backup_policy_jobs.testacc_ds_jobs", "jobs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBackupPolicyDataSourceConfig(policyName, name string) string {
+	return testAccCheckIBMISBackupPolicyPlanConfig(policyName, name, "0 6 * * *", 30, "") + `
+	data "ibm_is_backup_policy" "testacc_ds_backup_policy" {
+		name       = ibm_is_backup_policy.testacc_backup_policy.name
+		depends_on = [ibm_is_backup_policy_plan.testacc_backup_plan]
+	  }
+
+	  data "ibm_is_backup_policy_jobs" "testacc_ds_jobs" {
+		backup_policy      = ibm_is_backup_policy.testacc_backup_policy.id
+		backup_policy_plan = ibm_is_backup_policy_plan.testacc_backup_plan.backup_policy_plan
+	  }`
+}
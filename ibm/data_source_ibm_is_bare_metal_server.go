@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISBareMetalServer() *schema.Resource {
+	serverSchema := dataSourceIBMISBareMetalServerAttributes()
+	serverSchema["identifier"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"identifier", isBareMetalServerName},
+		Description:  "The unique identifier of the bare metal server.",
+	}
+	serverSchema[isBareMetalServerName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"identifier", isBareMetalServerName},
+		Description:  "The unique user-defined name of the bare metal server.",
+	}
+	serverSchema[isBareMetalServerImage] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The image the bare metal server was provisioned with.",
+	}
+	serverSchema[isBareMetalServerKeys] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         schema.HashString,
+		Description: "The SSH keys the bare metal server was provisioned with.",
+	}
+	nicSchema := map[string]*schema.Schema{}
+	for k, v := range resourceIBMISBareMetalServerNetworkInterfaceSchema(false) {
+		nicSchema[k] = &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Elem:        v.Elem,
+			Set:         v.Set,
+			Description: v.Description,
+		}
+	}
+	serverSchema[isBareMetalServerPrimaryNetworkInterface] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The primary network interface of the bare metal server.",
+		Elem:        &schema.Resource{Schema: nicSchema},
+	}
+	serverSchema[isBareMetalServerNetworkInterfaces] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The secondary network interfaces of the bare metal server.",
+		Elem:        &schema.Resource{Schema: nicSchema},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBareMetalServerRead,
+		Schema:      serverSchema,
+	}
+}
+
+// dataSourceIBMISBareMetalServerAttributes returns the attributes of a bare metal server common to the
+// ibm_is_bare_metal_server and ibm_is_bare_metal_servers data sources
+func dataSourceIBMISBareMetalServerAttributes() map[string]*schema.Schema {
+	resourceSchema := resourceIBMISBareMetalServer().Schema
+	attributes := map[string]*schema.Schema{}
+	for _, k := range []string{isBareMetalServerName, isBareMetalServerProfile, isBareMetalServerZone, isBareMetalServerVPC, isBareMetalServerResourceGroup,
+		isBareMetalServerStatus, "bandwidth", "boot_target", "cpu", "disks", "memory", "created_at", "crn", "href", "resource_type"} {
+		s := resourceSchema[k]
+		attributes[k] = &schema.Schema{
+			Type:        s.Type,
+			Computed:    true,
+			Elem:        s.Elem,
+			Description: s.Description,
+		}
+	}
+	attributes[isBareMetalServerTags] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         resourceIBMVPCHash,
+		Description: "The user tags of the bare metal server.",
+	}
+	return attributes
+}
+
+func dataSourceIBMISBareMetalServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var server *vpcext.BareMetalServer
+	if id, ok := d.GetOk("identifier"); ok {
+		var response *core.DetailedResponse
+		server, response, err = client.GetBareMetalServer(context, id.(string))
+		if err != nil {
+			log.Printf("[DEBUG] GetBareMetalServer failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		name := d.Get(isBareMetalServerName).(string)
+		servers, response, err := client.ListBareMetalServers(context, &vpcext.ListBareMetalServersOptions{Name: &name})
+		if err != nil {
+			log.Printf("[DEBUG] ListBareMetalServers failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		if len(servers) == 0 {
+			return diag.FromErr(fmt.Errorf("No bare metal server found with name %s", name))
+		}
+		server = &servers[0]
+	}
+	d.SetId(*server.ID)
+
+	for k, v := range dataSourceIBMISBareMetalServerToMap(*server) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	initialization, response, err := client.GetBareMetalServerInitialization(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] GetBareMetalServerInitialization failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	if initialization.Image != nil {
+		if err = d.Set(isBareMetalServerImage, initialization.Image.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting image: %s", err))
+		}
+	}
+	keys := make([]string, 0, len(initialization.Keys))
+	for _, key := range initialization.Keys {
+		keys = append(keys, *key.ID)
+	}
+	if err = d.Set(isBareMetalServerKeys, newStringSet(schema.HashString, keys)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting keys: %s", err))
+	}
+
+	nics, response, err := client.ListBareMetalServerNetworkInterfaces(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] ListBareMetalServerNetworkInterfaces failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	primaryNic := []map[string]interface{}{}
+	secondaryNics := []map[string]interface{}{}
+	for _, nic := range nics {
+		if server.PrimaryNetworkInterface != nil && *nic.ID == *server.PrimaryNetworkInterface.ID {
+			primaryNic = append(primaryNic, flattenBareMetalServerNetworkInterface(nic, false))
+		} else {
+			secondaryNics = append(secondaryNics, flattenBareMetalServerNetworkInterface(nic, false))
+		}
+	}
+	if err = d.Set(isBareMetalServerPrimaryNetworkInterface, primaryNic); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting primary_network_interface: %s", err))
+	}
+	if err = d.Set(isBareMetalServerNetworkInterfaces, secondaryNics); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting network_interfaces: %s", err))
+	}
+
+	tags, err := GetTagsUsingCRN(meta, *server.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of bare metal server (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isBareMetalServerTags, tags)
+
+	return nil
+}
+
+// dataSourceIBMISBareMetalServerToMap returns the attributes of dataSourceIBMISBareMetalServerAttributes except the tags
+func dataSourceIBMISBareMetalServerToMap(server vpcext.BareMetalServer) map[string]interface{} {
+	serverMap := map[string]interface{}{}
+
+	serverMap[isBareMetalServerName] = server.Name
+	if server.Profile != nil {
+		serverMap[isBareMetalServerProfile] = server.Profile.Name
+	}
+	if server.Zone != nil {
+		serverMap[isBareMetalServerZone] = server.Zone.Name
+	}
+	if server.VPC != nil {
+		serverMap[isBareMetalServerVPC] = server.VPC.ID
+	}
+	if server.ResourceGroup != nil {
+		serverMap[isBareMetalServerResourceGroup] = server.ResourceGroup.ID
+	}
+	serverMap[isBareMetalServerStatus] = server.Status
+	serverMap["bandwidth"] = server.Bandwidth
+	if server.BootTarget != nil {
+		serverMap["boot_target"] = server.BootTarget.ID
+	}
+	if server.CPU != nil {
+		serverMap["cpu"] = []map[string]interface{}{resourceIBMISBareMetalServerCPUToMap(*server.CPU)}
+	}
+	disks := []map[string]interface{}{}
+	for _, disksItem := range server.Disks {
+		disks = append(disks, resourceIBMISBareMetalServerDiskToMap(disksItem))
+	}
+	serverMap["disks"] = disks
+	serverMap["memory"] = server.Memory
+	serverMap["created_at"] = server.CreatedAt
+	serverMap["crn"] = server.CRN
+	serverMap["href"] = server.Href
+	serverMap["resource_type"] = server.ResourceType
+
+	return serverMap
+}
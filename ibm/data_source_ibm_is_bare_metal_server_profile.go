@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISBareMetalServerProfile() *schema.Resource {
+	profileSchema := dataSourceIBMISBareMetalServerProfileAttributes()
+	profileSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the bare metal server profile.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBareMetalServerProfileRead,
+		Schema:      profileSchema,
+	}
+}
+
+// dataSourceIBMISBareMetalServerProfileAttributes returns the attributes of a bare metal server profile common to the
+// ibm_is_bare_metal_server_profile and ibm_is_bare_metal_server_profiles data sources
+func dataSourceIBMISBareMetalServerProfileAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"family": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The product family this bare metal server profile belongs to.",
+		},
+		"href": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL for this bare metal server profile.",
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type.",
+		},
+		"bandwidth":        bareMetalServerProfileValueSchema("The total bandwidth (in megabits per second) shared across the network interfaces of a bare metal server with this profile."),
+		"cpu_core_count":   bareMetalServerProfileValueSchema("The number of CPU cores of a bare metal server with this profile."),
+		"cpu_socket_count": bareMetalServerProfileValueSchema("The number of CPU sockets of a bare metal server with this profile."),
+		"memory":           bareMetalServerProfileValueSchema("The memory (in gibibytes) of a bare metal server with this profile."),
+		"cpu_architecture": bareMetalServerProfileStringValueSchema("The CPU architecture of a bare metal server with this profile."),
+		"os_architecture":  bareMetalServerProfileStringValueSchema("The supported OS architecture(s) for a bare metal server with this profile."),
+		"disks": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The collection of the disks of a bare metal server with this profile.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"quantity":                  bareMetalServerProfileValueSchema("The number of disks of this configuration."),
+					"size":                      bareMetalServerProfileValueSchema("The size of the disks (in GB) of this configuration."),
+					"supported_interface_types": bareMetalServerProfileStringValueSchema("The disk interface used for attaching the disks."),
+				},
+			},
+		},
+	}
+}
+
+// bareMetalServerProfileValueSchema returns the schema of a fixed, range, enum or dependent property of a profile
+func bareMetalServerProfileValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type for this profile field.",
+				},
+				"value": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The value for this profile field.",
+				},
+				"default": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The default value for this profile field.",
+				},
+				"max": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The maximum value for this profile field.",
+				},
+				"min": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The minimum value for this profile field.",
+				},
+				"step": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The increment step value for this profile field.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The permitted values for this profile field.",
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+				},
+			},
+		},
+	}
+}
+
+// bareMetalServerProfileStringValueSchema returns the schema of a fixed or enum property with string values of a profile
+func bareMetalServerProfileStringValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type for this profile field.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The value for this profile field.",
+				},
+				"default": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The default value for this profile field.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The permitted values for this profile field.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBareMetalServerProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile, response, err := client.GetBareMetalServerProfile(context, d.Get("name").(string))
+	if err != nil {
+		log.Printf("[DEBUG] GetBareMetalServerProfile failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	// For lack of anything better, compose our id from profile name.
+	d.SetId(*profile.Name)
+	for k, v := range dataSourceIBMISBareMetalServerProfileToMap(*profile) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+func dataSourceIBMISBareMetalServerProfileToMap(profile vpcext.BareMetalServerProfile) map[string]interface{} {
+	profileMap := map[string]interface{}{}
+
+	profileMap["name"] = profile.Name
+	profileMap["family"] = profile.Family
+	profileMap["href"] = profile.Href
+	profileMap["resource_type"] = profile.ResourceType
+	profileMap["bandwidth"] = dataSourceBareMetalServerProfileFlattenValue(profile.Bandwidth)
+	profileMap["cpu_core_count"] = dataSourceBareMetalServerProfileFlattenValue(profile.CPUCoreCount)
+	profileMap["cpu_socket_count"] = dataSourceBareMetalServerProfileFlattenValue(profile.CPUSocketCount)
+	profileMap["memory"] = dataSourceBareMetalServerProfileFlattenValue(profile.Memory)
+	profileMap["cpu_architecture"] = dataSourceBareMetalServerProfileFlattenStringValue(profile.CPUArchitecture)
+	profileMap["os_architecture"] = dataSourceBareMetalServerProfileFlattenStringValue(profile.OsArchitecture)
+	disks := []map[string]interface{}{}
+	for _, disk := range profile.Disks {
+		disks = append(disks, map[string]interface{}{
+			"quantity":                  dataSourceBareMetalServerProfileFlattenValue(disk.Quantity),
+			"size":                      dataSourceBareMetalServerProfileFlattenValue(disk.Size),
+			"supported_interface_types": dataSourceBareMetalServerProfileFlattenStringValue(disk.SupportedInterfaceTypes),
+		})
+	}
+	profileMap["disks"] = disks
+
+	return profileMap
+}
+
+func dataSourceBareMetalServerProfileFlattenValue(value *vpcext.ProfileValue) (finalList []map[string]interface{}) {
+	finalList = []map[string]interface{}{}
+	if value == nil {
+		return finalList
+	}
+	valueMap := map[string]interface{}{}
+	if value.Type != nil {
+		valueMap["type"] = *value.Type
+	}
+	if value.Value != nil {
+		valueMap["value"] = *value.Value
+	}
+	if value.Default != nil {
+		valueMap["default"] = *value.Default
+	}
+	if value.Max != nil {
+		valueMap["max"] = *value.Max
+	}
+	if value.Min != nil {
+		valueMap["min"] = *value.Min
+	}
+	if value.Step != nil {
+		valueMap["step"] = *value.Step
+	}
+	if value.Values != nil {
+		values := make([]int, 0, len(value.Values))
+		for _, v := range value.Values {
+			values = append(values, int(v))
+		}
+		valueMap["values"] = values
+	}
+	return append(finalList, valueMap)
+}
+
+func dataSourceBareMetalServerProfileFlattenStringValue(value *vpcext.ProfileStringValue) (finalList []map[string]interface{}) {
+	finalList = []map[string]interface{}{}
+	if value == nil {
+		return finalList
+	}
+	valueMap := map[string]interface{}{}
+	if value.Type != nil {
+		valueMap["type"] = *value.Type
+	}
+	if value.Value != nil {
+		valueMap["value"] = *value.Value
+	}
+	if value.Default != nil {
+		valueMap["default"] = *value.Default
+	}
+	if value.Values != nil {
+		valueMap["values"] = value.Values
+	}
+	return append(finalList, valueMap)
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServerProfileDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_bare_metal_server_profile.ds_bmsprofile"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerProfileDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "name", isBareMetalServerProfileName),
+					resource.TestCheckResourceAttrSet(resName, "family"),
+					resource.TestCheckResourceAttrSet(resName, "cpu_core_count.0.type"),
+					resource.TestCheckResourceAttrSet(resName, "memory.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerProfileDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_bare_metal_server_profile" "ds_bmsprofile" {
+		name = "%s"
+	}`, isBareMetalServerProfileName)
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISBareMetalServerProfiles() *schema.Resource {
+	profileSchema := dataSourceIBMISBareMetalServerProfileAttributes()
+	profileSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the bare metal server profile.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBareMetalServerProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bare metal server profiles.",
+				Elem:        &schema.Resource{Schema: profileSchema},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBareMetalServerProfilesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profiles, response, err := client.ListBareMetalServerProfiles(context)
+	if err != nil {
+		log.Printf("[DEBUG] ListBareMetalServerProfiles failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	profileList := []map[string]interface{}{}
+	for _, profile := range profiles {
+		profileList = append(profileList, dataSourceIBMISBareMetalServerProfileToMap(profile))
+	}
+
+	d.SetId(dataSourceIBMISBareMetalServerProfilesID(d))
+	if err = d.Set("profiles", profileList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting profiles: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMISBareMetalServerProfilesID returns a reasonable ID for the list.
+func dataSourceIBMISBareMetalServerProfilesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
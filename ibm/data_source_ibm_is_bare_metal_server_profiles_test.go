@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServerProfilesDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_bare_metal_server_profiles.ds_bmsprofiles"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerProfilesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "profiles.0.name"),
+					resource.TestCheckResourceAttrSet(resName, "profiles.0.family"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerProfilesDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_bare_metal_server_profiles" "ds_bmsprofiles" {
+	}`)
+}
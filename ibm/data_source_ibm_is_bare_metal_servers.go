@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISBareMetalServers() *schema.Resource {
+	serverSchema := dataSourceIBMISBareMetalServerAttributes()
+	delete(serverSchema, isBareMetalServerTags)
+	serverSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier of the bare metal server.",
+	}
+	nicReferenceSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerNicID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the network interface.",
+			},
+			isBareMetalServerNicName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user-defined name for the network interface.",
+			},
+			isBareMetalServerNicPrimaryIpv4Address: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary IPv4 address of the network interface.",
+			},
+		},
+	}
+	serverSchema[isBareMetalServerPrimaryNetworkInterface] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The primary network interface of the bare metal server.",
+		Elem:        nicReferenceSchema,
+	}
+	serverSchema[isBareMetalServerNetworkInterfaces] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The secondary network interfaces of the bare metal server.",
+		Elem:        nicReferenceSchema,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBareMetalServersRead,
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerVPC: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The unique identifier of a VPC to filter the bare metal servers by.",
+			},
+			isBareMetalServerResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The unique identifier of a resource group to filter the bare metal servers by.",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bare metal servers.",
+				Elem:        &schema.Resource{Schema: serverSchema},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &vpcext.ListBareMetalServersOptions{}
+	if vpcID, ok := d.GetOk(isBareMetalServerVPC); ok {
+		vpcIDStr := vpcID.(string)
+		options.VPCID = &vpcIDStr
+	}
+	if resourceGroup, ok := d.GetOk(isBareMetalServerResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		options.ResourceGroupID = &resourceGroupStr
+	}
+	servers, response, err := client.ListBareMetalServers(context, options)
+	if err != nil {
+		log.Printf("[DEBUG] ListBareMetalServers failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	serverList := []map[string]interface{}{}
+	for _, server := range servers {
+		serverMap := dataSourceIBMISBareMetalServerToMap(server)
+		serverMap["id"] = server.ID
+		primaryNic := []map[string]interface{}{}
+		secondaryNics := []map[string]interface{}{}
+		for _, nic := range server.NetworkInterfaces {
+			nicMap := map[string]interface{}{
+				isBareMetalServerNicID:   nic.ID,
+				isBareMetalServerNicName: nic.Name,
+			}
+			if nic.PrimaryIP != nil {
+				nicMap[isBareMetalServerNicPrimaryIpv4Address] = nic.PrimaryIP.Address
+			}
+			if server.PrimaryNetworkInterface != nil && *nic.ID == *server.PrimaryNetworkInterface.ID {
+				primaryNic = append(primaryNic, nicMap)
+			} else {
+				secondaryNics = append(secondaryNics, nicMap)
+			}
+		}
+		serverMap[isBareMetalServerPrimaryNetworkInterface] = primaryNic
+		serverMap[isBareMetalServerNetworkInterfaces] = secondaryNics
+		serverList = append(serverList, serverMap)
+	}
+
+	d.SetId(dataSourceIBMISBareMetalServersID(d))
+	if err = d.Set("servers", serverList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting servers: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMISBareMetalServersID returns a reasonable ID for the list.
+func dataSourceIBMISBareMetalServersID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServersDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_bare_metal_servers.ds_bms"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServersDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "servers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServersDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_is_bare_metal_servers" "ds_bms" {
+	}`)
+}
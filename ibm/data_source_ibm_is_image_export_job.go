@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISImageExportJob() *schema.Resource {
+	jobSchema := dataSourceIBMISImageExportJobAttributes()
+	jobSchema[isImageExportJobImage] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The unique identifier of the image.",
+	}
+	jobSchema[isImageExportJobID] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The unique identifier of the export job.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISImageExportJobRead,
+		Schema:      jobSchema,
+	}
+}
+
+func dataSourceIBMISImageExportJobRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Get(isImageExportJobImage).(string)
+	jobID := d.Get(isImageExportJobID).(string)
+	job, response, err := client.GetImageExportJob(context, imageID, jobID)
+	if err != nil {
+		log.Printf("[DEBUG] GetImageExportJob failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", imageID, *job.ID))
+
+	for k, v := range dataSourceIBMISImageExportJobToMap(*job) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isImageExportJobs = "export_jobs"
+)
+
+func dataSourceIBMISImageExportJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISImageExportJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			isImageExportJobImage: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the image.",
+			},
+			isImageExportJobName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the export jobs to list.",
+			},
+			isImageExportJobs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The export jobs of the image, newest first.",
+				Elem:        &schema.Resource{Schema: dataSourceIBMISImageExportJobAttributes()},
+			},
+		},
+	}
+}
+
+// dataSourceIBMISImageExportJobAttributes returns the attributes of an export job common to the
+// ibm_is_image_export_job and ibm_is_image_export_jobs data sources
+func dataSourceIBMISImageExportJobAttributes() map[string]*schema.Schema {
+	resourceSchema := resourceIBMISImageExportJob().Schema
+	attributes := map[string]*schema.Schema{}
+	for _, k := range []string{isImageExportJobID, isImageExportJobName, isImageExportJobFormat, isImageExportJobStatus, isImageExportJobStatusReasons,
+		isImageExportJobStorageHref, isImageExportJobStorageObject, isImageExportJobEncryptedDataKey, isImageExportJobStartedAt,
+		isImageExportJobCompletedAt, "created_at", "href", "resource_type"} {
+		s := resourceSchema[k]
+		attributes[k] = &schema.Schema{
+			Type:        s.Type,
+			Computed:    true,
+			Elem:        s.Elem,
+			Description: s.Description,
+		}
+	}
+	attributes[isImageExportJobStorageBucket] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The Cloud Object Storage bucket the image is exported to.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				isImageExportJobBucketName: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The globally unique name of the bucket.",
+				},
+				isImageExportJobBucketCRN: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The CRN of the bucket.",
+				},
+			},
+		},
+	}
+	return attributes
+}
+
+func dataSourceIBMISImageExportJobsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Get(isImageExportJobImage).(string)
+	jobs, response, err := client.ListImageExportJobs(context, imageID)
+	if err != nil {
+		log.Printf("[DEBUG] ListImageExportJobs failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	name := d.Get(isImageExportJobName).(string)
+	jobList := make([]map[string]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		if name != "" && (job.Name == nil || *job.Name != name) {
+			continue
+		}
+		jobList = append(jobList, dataSourceIBMISImageExportJobToMap(job))
+	}
+	d.SetId(imageID)
+	if err = d.Set(isImageExportJobs, jobList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting %s: %s", isImageExportJobs, err))
+	}
+
+	return nil
+}
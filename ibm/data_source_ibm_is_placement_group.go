@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIbmIsPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmIsPlacementGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"identifier", isPlacementGroupName},
+				Description:  "The unique identifier of the placement group.",
+			},
+			isPlacementGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identifier", isPlacementGroupName},
+				Description:  "The unique user-defined name of the placement group.",
+			},
+			isPlacementGroupStrategy: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The strategy for this placement group.",
+			},
+			isPlacementGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource group of the placement group.",
+			},
+			isPlacementGroupLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the placement group.",
+			},
+			isPlacementGroupTags: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags of the placement group",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the placement group was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this placement group.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this placement group.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmIsPlacementGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var placementGroup *vpcext.PlacementGroup
+	if id, ok := d.GetOk("identifier"); ok {
+		var response *core.DetailedResponse
+		placementGroup, response, err = client.GetPlacementGroup(context, id.(string))
+		if err != nil {
+			log.Printf("[DEBUG] GetPlacementGroup failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		name := d.Get(isPlacementGroupName).(string)
+		placementGroups, response, err := client.ListPlacementGroups(context)
+		if err != nil {
+			log.Printf("[DEBUG] ListPlacementGroups failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		for i := range placementGroups {
+			if *placementGroups[i].Name == name {
+				placementGroup = &placementGroups[i]
+				break
+			}
+		}
+		if placementGroup == nil {
+			return diag.FromErr(fmt.Errorf("No placement group found with name %s", name))
+		}
+	}
+	d.SetId(*placementGroup.ID)
+
+	for k, v := range dataSourceIbmIsPlacementGroupToMap(*placementGroup) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	tags, err := GetTagsUsingCRN(meta, *placementGroup.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of placement group (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isPlacementGroupTags, tags)
+
+	return nil
+}
+
+// dataSourceIbmIsPlacementGroupToMap returns the attributes of a placement group common to the ibm_is_placement_group
+// resource and data source
+func dataSourceIbmIsPlacementGroupToMap(placementGroup vpcext.PlacementGroup) map[string]interface{} {
+	placementGroupMap := map[string]interface{}{}
+
+	placementGroupMap[isPlacementGroupName] = placementGroup.Name
+	placementGroupMap[isPlacementGroupStrategy] = placementGroup.Strategy
+	placementGroupMap[isPlacementGroupLifecycleState] = placementGroup.LifecycleState
+	if placementGroup.ResourceGroup != nil {
+		placementGroupMap[isPlacementGroupResourceGroup] = placementGroup.ResourceGroup.ID
+	}
+	placementGroupMap["created_at"] = placementGroup.CreatedAt
+	placementGroupMap["crn"] = placementGroup.CRN
+	placementGroupMap["href"] = placementGroup.Href
+	placementGroupMap["resource_type"] = placementGroup.ResourceType
+
+	return placementGroupMap
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmIsPlacementGroupDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsPlacementGroupDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_is_placement_group.by_name", "id", "ibm_is_placement_group.testacc_pg", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_placement_group.by_name", "strategy", "power_spread"),
+					resource.TestCheckResourceAttr("data.ibm_is_placement_group.by_id", "name", name),
+					resource.TestCheckResourceAttrSet("data.ibm_is_placement_group.by_id", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIsPlacementGroupDataSourceConfig(name string) string {
+	return testAccCheckIbmIsPlacementGroupConfig("power_spread", name) + `
+	data "ibm_is_placement_group" "by_name" {
+		name = ibm_is_placement_group.testacc_pg.name
+	  }
+
+	  data "ibm_is_placement_group" "by_id" {
+		identifier = ibm_is_placement_group.testacc_pg.id
+	  }`
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISShare() *schema.Resource {
+	shareSchema := dataSourceIBMISShareAttributes()
+	shareSchema["identifier"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: []string{"identifier", isShareName},
+		Description:  "The unique identifier of the share.",
+	}
+	shareSchema[isShareName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"identifier", isShareName},
+		Description:  "The unique user-defined name of the share.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISShareRead,
+		Schema:      shareSchema,
+	}
+}
+
+// dataSourceIBMISShareAttributes returns the attributes of a share common to the ibm_is_share and ibm_is_shares data
+// sources
+func dataSourceIBMISShareAttributes() map[string]*schema.Schema {
+	resourceSchema := resourceIBMISShare().Schema
+	attributes := map[string]*schema.Schema{}
+	for _, k := range []string{isShareName, isShareProfile, isShareZone, isShareSize, isShareIops, isShareEncryptionKey, isShareAccessControlMode,
+		isShareResourceGroup, isShareReplicaShare, isShareReplicationRole, isShareReplicationStatus, isShareLastSyncAt, isShareEncryption,
+		isShareMountTargets, isShareLifecycleState, "created_at", "crn", "href", "resource_type"} {
+		s := resourceSchema[k]
+		attributes[k] = &schema.Schema{
+			Type:        s.Type,
+			Computed:    true,
+			Elem:        s.Elem,
+			Description: s.Description,
+		}
+	}
+	attributes[isShareSourceShare] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier of the source share of this replica share.",
+	}
+	attributes[isShareReplicationCronSpec] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The cron specification of the replication of this replica share.",
+	}
+	return attributes
+}
+
+func dataSourceIBMISShareRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var share *vpcext.Share
+	if id, ok := d.GetOk("identifier"); ok {
+		var response *core.DetailedResponse
+		share, response, err = client.GetShare(context, id.(string))
+		if err != nil {
+			log.Printf("[DEBUG] GetShare failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		name := d.Get(isShareName).(string)
+		shares, response, err := client.ListShares(context)
+		if err != nil {
+			log.Printf("[DEBUG] ListShares failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		for i := range shares {
+			if *shares[i].Name == name {
+				share = &shares[i]
+				break
+			}
+		}
+		if share == nil {
+			return diag.FromErr(fmt.Errorf("No share found with name %s", name))
+		}
+	}
+	d.SetId(*share.ID)
+
+	shareMap := dataSourceIBMISShareToMap(*share)
+	if share.SourceShare != nil {
+		shareMap[isShareSourceShare] = share.SourceShare.ID
+	}
+	shareMap[isShareReplicationCronSpec] = share.ReplicationCronSpec
+	for k, v := range shareMap {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+// dataSourceIBMISShareToMap returns the attributes of a share common to the ibm_is_share resource and data sources,
+// the source share and the replication cron spec are left to the callers
+func dataSourceIBMISShareToMap(share vpcext.Share) map[string]interface{} {
+	shareMap := map[string]interface{}{}
+
+	shareMap[isShareName] = share.Name
+	if share.Profile != nil {
+		shareMap[isShareProfile] = share.Profile.Name
+	}
+	if share.Zone != nil {
+		shareMap[isShareZone] = share.Zone.Name
+	}
+	shareMap[isShareSize] = share.Size
+	shareMap[isShareIops] = share.Iops
+	if share.EncryptionKey != nil {
+		shareMap[isShareEncryptionKey] = share.EncryptionKey.CRN
+	}
+	shareMap[isShareAccessControlMode] = share.AccessControlMode
+	if share.ResourceGroup != nil {
+		shareMap[isShareResourceGroup] = share.ResourceGroup.ID
+	}
+	if share.ReplicaShare != nil {
+		shareMap[isShareReplicaShare] = share.ReplicaShare.ID
+	} else {
+		shareMap[isShareReplicaShare] = ""
+	}
+	shareMap[isShareReplicationRole] = share.ReplicationRole
+	shareMap[isShareReplicationStatus] = share.ReplicationStatus
+	shareMap[isShareLastSyncAt] = share.LastSyncAt
+	shareMap[isShareEncryption] = share.Encryption
+	mountTargets := make([]map[string]interface{}, 0, len(share.MountTargets))
+	for _, mountTarget := range share.MountTargets {
+		mountTargets = append(mountTargets, map[string]interface{}{
+			"id":   mountTarget.ID,
+			"name": mountTarget.Name,
+		})
+	}
+	shareMap[isShareMountTargets] = mountTargets
+	shareMap[isShareLifecycleState] = share.LifecycleState
+	shareMap["created_at"] = share.CreatedAt
+	shareMap["crn"] = share.CRN
+	shareMap["href"] = share.Href
+	shareMap["resource_type"] = share.ResourceType
+
+	return shareMap
+}
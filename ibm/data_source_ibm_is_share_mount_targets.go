@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISShareMountTargets() *schema.Resource {
+	resourceSchema := resourceIBMISShareMountTarget().Schema
+	mountTargetSchema := map[string]*schema.Schema{}
+	for _, k := range []string{isShareMountTargetID, isShareMountTargetName, isShareMountTargetVPC, isShareMountTargetTransitEncryption,
+		isShareMountTargetMountPath, isShareMountTargetAccessControlMode, isShareMountTargetSubnet, isShareMountTargetPrimaryIP,
+		isShareMountTargetLifecycleState, "created_at", "href", "resource_type"} {
+		s := resourceSchema[k]
+		mountTargetSchema[k] = &schema.Schema{
+			Type:        s.Type,
+			Computed:    true,
+			Description: s.Description,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISShareMountTargetsRead,
+
+		Schema: map[string]*schema.Schema{
+			isShareMountTargetShare: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the share.",
+			},
+			isShareMountTargets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mount targets of the share.",
+				Elem:        &schema.Resource{Schema: mountTargetSchema},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISShareMountTargetsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get(isShareMountTargetShare).(string)
+	mountTargets, response, err := client.ListShareMountTargets(context, shareID)
+	if err != nil {
+		log.Printf("[DEBUG] ListShareMountTargets failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	mountTargetList := make([]map[string]interface{}, 0, len(mountTargets))
+	for _, mountTarget := range mountTargets {
+		mountTargetList = append(mountTargetList, dataSourceIBMISShareMountTargetToMap(mountTarget))
+	}
+	d.SetId(shareID)
+	if err = d.Set(isShareMountTargets, mountTargetList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting %s: %s", isShareMountTargets, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISShareProfiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISShareProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"profiles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The share profiles.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the share profile.",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The product family this share profile belongs to.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share profile.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+						"capacity": bareMetalServerProfileValueSchema("The permitted sizes (in gigabytes) of a share with this profile."),
+						"iops":     bareMetalServerProfileValueSchema("The permitted IOPS of a share with this profile."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISShareProfilesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profiles, response, err := client.ListShareProfiles(context)
+	if err != nil {
+		log.Printf("[DEBUG] ListShareProfiles failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	profileList := []map[string]interface{}{}
+	for _, profile := range profiles {
+		profileList = append(profileList, map[string]interface{}{
+			"name":          profile.Name,
+			"family":        profile.Family,
+			"href":          profile.Href,
+			"resource_type": profile.ResourceType,
+			"capacity":      dataSourceBareMetalServerProfileFlattenValue(profile.Capacity),
+			"iops":          dataSourceBareMetalServerProfileFlattenValue(profile.Iops),
+		})
+	}
+
+	d.SetId(dataSourceIBMISShareProfilesID(d))
+	if err = d.Set("profiles", profileList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting profiles: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMISShareProfilesID returns a reasonable ID for the list.
+func dataSourceIBMISShareProfilesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISShareDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	shareName := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-mount-target-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareDataSourceConfig(vpcname, shareName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_share.testacc_ds_share", "id",
+						"ibm_is_share.testacc_share", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_share.testacc_ds_share", "name", shareName),
+					resource.TestCheckResourceAttr("data.ibm_is_share.testacc_ds_share", "size", "200"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_shares.testacc_ds_shares", "shares.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_share_profiles.testacc_ds_profiles", "profiles.#"),
+					resource.TestCheckResourceAttr("data.ibm_is_share_mount_targets.testacc_ds_mount_targets", "mount_targets.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_share_mount_targets.testacc_ds_mount_targets", "mount_targets.0.mount_path",
+						"ibm_is_share_mount_target.testacc_mount_target", "mount_path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISShareDataSourceConfig(vpcname, shareName, name string) string {
+	return testAccCheckIBMISShareMountTargetConfig(vpcname, shareName, name) + `
+	data "ibm_is_share" "testacc_ds_share" {
+		name       = ibm_is_share.testacc_share.name
+		depends_on = [ibm_is_share_mount_target.testacc_mount_target]
+	  }
+
+	  data "ibm_is_shares" "testacc_ds_shares" {
+		depends_on = [ibm_is_share.testacc_share]
+	  }
+
+	  data "ibm_is_share_profiles" "testacc_ds_profiles" {
+	  }
+
+	  data "ibm_is_share_mount_targets" "testacc_ds_mount_targets" {
+		share      = ibm_is_share.testacc_share.id
+		depends_on = [ibm_is_share_mount_target.testacc_mount_target]
+	  }`
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISShares() *schema.Resource {
+	shareSchema := dataSourceIBMISShareAttributes()
+	shareSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier of the share.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISSharesRead,
+
+		Schema: map[string]*schema.Schema{
+			"shares": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The shares.",
+				Elem:        &schema.Resource{Schema: shareSchema},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISSharesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shares, response, err := client.ListShares(context)
+	if err != nil {
+		log.Printf("[DEBUG] ListShares failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	shareList := []map[string]interface{}{}
+	for _, share := range shares {
+		shareMap := dataSourceIBMISShareToMap(share)
+		shareMap["id"] = share.ID
+		if share.SourceShare != nil {
+			shareMap[isShareSourceShare] = share.SourceShare.ID
+		}
+		shareMap[isShareReplicationCronSpec] = share.ReplicationCronSpec
+		shareList = append(shareList, shareMap)
+	}
+
+	d.SetId(dataSourceIBMISSharesID(d))
+	if err = d.Set("shares", shareList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting shares: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMISSharesID returns a reasonable ID for the list.
+func dataSourceIBMISSharesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
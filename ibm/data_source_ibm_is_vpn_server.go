@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISVPNServer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPNServerRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"identifier", isVPNServerName},
+				Description:  "The unique identifier of the VPN server.",
+			},
+			isVPNServerName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"identifier", isVPNServerName},
+				Description:  "The unique user-defined name of the VPN server.",
+			},
+			isVPNServerCertificateCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the certificate the VPN server presents to its clients.",
+			},
+			isVPNServerClientAuthentication: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The methods the clients authenticate with.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPNServerAuthMethod: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The authentication method.",
+						},
+						isVPNServerAuthClientCaCRN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the certificate of the CA that issued the client certificates.",
+						},
+						isVPNServerAuthCrl: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The PEM-encoded certificate revocation list of the client certificates.",
+						},
+						isVPNServerAuthIdentityProvider: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identity provider the usernames and passcodes are checked against.",
+						},
+					},
+				},
+			},
+			isVPNServerClientIPPool: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR the IP addresses of the clients are allocated from.",
+			},
+			isVPNServerClientDNSServerIps: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The DNS server addresses pushed to the clients.",
+			},
+			isVPNServerClientIdleTimeout: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of seconds of inactivity after which a client is disconnected.",
+			},
+			isVPNServerClientAutoDelete: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the disconnected clients are deleted after client_auto_delete_timeout.",
+			},
+			isVPNServerClientAutoDeleteTimeout: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of hours after which the disconnected clients are deleted.",
+			},
+			isVPNServerEnableSplitTunneling: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether only the traffic to the destinations of the routes of the VPN server goes through the VPN.",
+			},
+			isVPNServerPort: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port the VPN server listens on.",
+			},
+			isVPNServerProtocol: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The transport protocol of the VPN server.",
+			},
+			isVPNServerResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource group of the VPN server.",
+			},
+			isVPNServerSecurityGroups: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups of the VPN server.",
+			},
+			isVPNServerSubnets: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The subnets the VPN server is deployed in.",
+			},
+			isVPNServerHostname: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hostname the clients connect to.",
+			},
+			isVPNServerHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health state of the VPN server.",
+			},
+			isVPNServerLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the VPN server.",
+			},
+			isVPNServerPrivateIps: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reserved IPs the VPN server uses in its subnets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the reserved IP.",
+						},
+					},
+				},
+			},
+			isVPNServerVPC: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the VPC of the VPN server.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the VPN server was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this VPN server.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this VPN server.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var vpnServer *vpcext.VPNServer
+	if id, ok := d.GetOk("identifier"); ok {
+		var response *core.DetailedResponse
+		vpnServer, response, err = client.GetVPNServer(context, id.(string))
+		if err != nil {
+			log.Printf("[DEBUG] GetVPNServer failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		name := d.Get(isVPNServerName).(string)
+		vpnServers, response, err := client.ListVPNServers(context)
+		if err != nil {
+			log.Printf("[DEBUG] ListVPNServers failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		for i := range vpnServers {
+			if *vpnServers[i].Name == name {
+				vpnServer = &vpnServers[i]
+				break
+			}
+		}
+		if vpnServer == nil {
+			return diag.FromErr(fmt.Errorf("No VPN server found with name %s", name))
+		}
+	}
+	d.SetId(*vpnServer.ID)
+
+	for k, v := range dataSourceIBMISVPNServerToMap(*vpnServer) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+// dataSourceIBMISVPNServerToMap returns the attributes of a VPN server common to the ibm_is_vpn_server resource and
+// data source
+func dataSourceIBMISVPNServerToMap(vpnServer vpcext.VPNServer) map[string]interface{} {
+	vpnServerMap := map[string]interface{}{}
+
+	if vpnServer.Certificate != nil {
+		vpnServerMap[isVPNServerCertificateCRN] = vpnServer.Certificate.CRN
+	}
+	vpnServerMap[isVPNServerClientAuthentication] = flattenVPNServerClientAuthentication(vpnServer.ClientAuthentication)
+	dnsServerIps := make([]string, 0, len(vpnServer.ClientDNSServerIps))
+	for _, ip := range vpnServer.ClientDNSServerIps {
+		dnsServerIps = append(dnsServerIps, *ip.Address)
+	}
+	vpnServerMap[isVPNServerClientDNSServerIps] = dnsServerIps
+	vpnServerMap[isVPNServerClientIdleTimeout] = vpnServer.ClientIdleTimeout
+	vpnServerMap[isVPNServerClientIPPool] = vpnServer.ClientIPPool
+	vpnServerMap[isVPNServerClientAutoDelete] = vpnServer.ClientAutoDelete
+	vpnServerMap[isVPNServerClientAutoDeleteTimeout] = vpnServer.ClientAutoDeleteTimeout
+	vpnServerMap[isVPNServerEnableSplitTunneling] = vpnServer.EnableSplitTunneling
+	vpnServerMap[isVPNServerName] = vpnServer.Name
+	vpnServerMap[isVPNServerPort] = vpnServer.Port
+	vpnServerMap[isVPNServerProtocol] = vpnServer.Protocol
+	if vpnServer.ResourceGroup != nil {
+		vpnServerMap[isVPNServerResourceGroup] = vpnServer.ResourceGroup.ID
+	}
+	securityGroups := make([]string, 0, len(vpnServer.SecurityGroups))
+	for _, securityGroup := range vpnServer.SecurityGroups {
+		securityGroups = append(securityGroups, *securityGroup.ID)
+	}
+	vpnServerMap[isVPNServerSecurityGroups] = securityGroups
+	subnets := make([]string, 0, len(vpnServer.Subnets))
+	for _, subnet := range vpnServer.Subnets {
+		subnets = append(subnets, *subnet.ID)
+	}
+	vpnServerMap[isVPNServerSubnets] = subnets
+	vpnServerMap[isVPNServerHostname] = vpnServer.Hostname
+	vpnServerMap[isVPNServerHealthState] = vpnServer.HealthState
+	vpnServerMap[isVPNServerLifecycleState] = vpnServer.LifecycleState
+	privateIps := make([]map[string]interface{}, 0, len(vpnServer.PrivateIps))
+	for _, privateIP := range vpnServer.PrivateIps {
+		privateIps = append(privateIps, map[string]interface{}{
+			"address": privateIP.Address,
+			"id":      privateIP.ID,
+		})
+	}
+	vpnServerMap[isVPNServerPrivateIps] = privateIps
+	if vpnServer.VPC != nil {
+		vpnServerMap[isVPNServerVPC] = vpnServer.VPC.ID
+	}
+	vpnServerMap["created_at"] = vpnServer.CreatedAt
+	vpnServerMap["crn"] = vpnServer.CRN
+	vpnServerMap["href"] = vpnServer.Href
+	vpnServerMap["resource_type"] = vpnServer.ResourceType
+
+	return vpnServerMap
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPNServerClients = "clients"
+)
+
+func dataSourceIBMISVPNServerClients() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPNServerClientsRead,
+
+		Schema: map[string]*schema.Schema{
+			isVPNServerClientVPNServer: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique identifier of the VPN server.",
+			},
+			isVPNServerClients: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The clients of the VPN server, connected or disconnected.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the client.",
+						},
+						isVPNServerClientStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the client: connected or disconnected.",
+						},
+						"common_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The common name of the client certificate, with the certificate method.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username of the client, with the username method.",
+						},
+						"client_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address allocated to the client from the client IP pool.",
+						},
+						"remote_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address the client connects from.",
+						},
+						"remote_port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The port the client connects from.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the client connected.",
+						},
+						"disconnected_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the client disconnected.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this client.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNServerClientsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get(isVPNServerClientVPNServer).(string)
+	vpnServerClients, response, err := client.ListVPNServerClients(context, vpnServerID)
+	if err != nil {
+		log.Printf("[DEBUG] ListVPNServerClients failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	clients := make([]map[string]interface{}, 0, len(vpnServerClients))
+	for _, vpnServerClient := range vpnServerClients {
+		clients = append(clients, dataSourceIBMISVPNServerClientToMap(vpnServerClient))
+	}
+	d.SetId(vpnServerID)
+	if err = d.Set(isVPNServerClients, clients); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting %s: %s", isVPNServerClients, err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMISVPNServerClientToMap(vpnServerClient vpcext.VPNServerClient) map[string]interface{} {
+	clientMap := map[string]interface{}{
+		"id":                    vpnServerClient.ID,
+		isVPNServerClientStatus: vpnServerClient.Status,
+		"common_name":           vpnServerClient.CommonName,
+		"username":              vpnServerClient.Username,
+		"remote_port":           vpnServerClient.RemotePort,
+		"created_at":            vpnServerClient.CreatedAt,
+		"disconnected_at":       vpnServerClient.DisconnectedAt,
+		"href":                  vpnServerClient.Href,
+	}
+	if vpnServerClient.ClientIP != nil {
+		clientMap["client_ip"] = vpnServerClient.ClientIP.Address
+	}
+	if vpnServerClient.RemoteIP != nil {
+		clientMap["remote_ip"] = vpnServerClient.RemoteIP.Address
+	}
+	return clientMap
+}
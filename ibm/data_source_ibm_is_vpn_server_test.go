@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVPNServerDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNServerDataSourceConfig(vpcname, subnetname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_vpn_server.testacc_ds_vpn_server", "id",
+						"ibm_is_vpn_server.testacc_vpn_server", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_server.testacc_ds_vpn_server", "name", name),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_vpn_server.testacc_ds_vpn_server", "hostname",
+						"ibm_is_vpn_server.testacc_vpn_server", "hostname"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_server.testacc_ds_vpn_server", "client_authentication.0.method", "certificate"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_server_clients.testacc_ds_clients", "clients.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNServerDataSourceConfig(vpcname, subnetname, name string) string {
+	return testAccCheckIBMISVPNServerConfig(vpcname, subnetname, name, 600) + `
+	data "ibm_is_vpn_server" "testacc_ds_vpn_server" {
+		name = ibm_is_vpn_server.testacc_vpn_server.name
+	  }
+
+	  data "ibm_is_vpn_server_clients" "testacc_ds_clients" {
+		vpn_server = ibm_is_vpn_server.testacc_vpn_server.id
+	  }`
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// attributeError is an error caused by the value of a resource attribute
+type attributeError struct {
+	path cty.Path
+	err  error
+}
+
+func (e *attributeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *attributeError) Unwrap() error {
+	return e.err
+}
+
+// errorAt attaches the attribute, e.g. boot_volume.0.encryption, which caused the error so that
+// Terraform can point to it in the configuration
+func errorAt(attribute string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &attributeError{path: attributePath(attribute), err: err}
+}
+
+// attributePath converts a flatmap attribute address to a cty.Path
+func attributePath(attribute string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(attribute, ".") {
+		if i, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(i)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
+
+// diagFromErr converts the error of a CRUD function to diagnostics, keeping the attribute path of an
+// attributeError and describing a failed API request in the detail. An error caused by Terraform
+// interrupting the operation is reported as such.
+func diagFromErr(ctx context.Context, err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		d.Summary = fmt.Sprintf("Operation interrupted: %s", err)
+		d.Detail = "Terraform stopped waiting for the operation, which may still be in progress. Run terraform refresh to update the state."
+	} else if apiErr, ok := asAPIError(err); ok {
+		d.Detail = apiErr.Detail()
+	}
+	var attrErr *attributeError
+	if errors.As(err, &attrErr) {
+		d.AttributePath = attrErr.path
+	}
+	return diag.Diagnostics{d}
+}
+
+// withDiagnostics converts the CRUD functions of a resource returning an error to context aware ones,
+// so that their errors are reported with diagFromErr
+func withDiagnostics(r *schema.Resource) *schema.Resource {
+	if createFunc := r.Create; createFunc != nil {
+		r.Create = nil
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diagFromErr(ctx, createFunc(d, meta))
+		}
+	}
+	if readFunc := r.Read; readFunc != nil {
+		r.Read = nil
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diagFromErr(ctx, readFunc(d, meta))
+		}
+	}
+	if updateFunc := r.Update; updateFunc != nil {
+		r.Update = nil
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diagFromErr(ctx, updateFunc(d, meta))
+		}
+	}
+	if deleteFunc := r.Delete; deleteFunc != nil {
+		r.Delete = nil
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diagFromErr(ctx, deleteFunc(d, meta))
+		}
+	}
+	return r
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	if diags := diagFromErr(context.Background(), nil); diags != nil {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+
+	err := fmt.Errorf("Error updating volume: %w", errorAt("boot_volume.0.encryption", fmt.Errorf("invalid key")))
+	diags := diagFromErr(context.Background(), err)
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("boot_volume").IndexInt(0).GetAttr("encryption")) {
+		t.Fatalf("unexpected attribute path %#v", diags[0].AttributePath)
+	}
+	if diags[0].Summary != "Error updating volume: invalid key" {
+		t.Fatalf("unexpected summary %q", diags[0].Summary)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	diags = diagFromErr(ctx, ctx.Err())
+	if !strings.HasPrefix(diags[0].Summary, "Operation interrupted") {
+		t.Fatalf("expected an interrupted diagnostic, got %q", diags[0].Summary)
+	}
+}
+
+func TestProviderDiagnostics(t *testing.T) {
+	p := Provider()
+	for _, r := range []*schema.Resource{p.DataSourcesMap["ibm_is_vpc"], p.ResourcesMap["ibm_is_vpc"], p.DataSourcesMap["ibm_iam_access_group"]} {
+		if r.Read != nil || r.ReadContext == nil {
+			t.Fatal("expected the VPC and IAM resources and data sources to report diagnostics")
+		}
+	}
+}
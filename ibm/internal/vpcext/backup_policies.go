@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Backup policy match resource types
+const (
+	BackupPolicyMatchResourceTypeInstance = "instance"
+	BackupPolicyMatchResourceTypeVolume   = "volume"
+)
+
+// BackupPolicy : a backup policy, which snapshots the resources with any of its user tags on the schedules of its
+// plans
+type BackupPolicy struct {
+	CreatedAt          *string     `json:"created_at,omitempty"`
+	CRN                *string     `json:"crn,omitempty"`
+	HealthState        *string     `json:"health_state,omitempty"`
+	Href               *string     `json:"href,omitempty"`
+	ID                 *string     `json:"id,omitempty"`
+	LastJobCompletedAt *string     `json:"last_job_completed_at,omitempty"`
+	LifecycleState     *string     `json:"lifecycle_state,omitempty"`
+	MatchResourceType  *string     `json:"match_resource_type,omitempty"`
+	MatchUserTags      []string    `json:"match_user_tags,omitempty"`
+	Name               *string     `json:"name,omitempty"`
+	Plans              []Reference `json:"plans,omitempty"`
+	ResourceGroup      *Reference  `json:"resource_group,omitempty"`
+	ResourceType       *string     `json:"resource_type,omitempty"`
+}
+
+// BackupPolicyPrototype : the request of the creation of a backup policy
+type BackupPolicyPrototype struct {
+	MatchResourceType *string    `json:"match_resource_type,omitempty"`
+	MatchUserTags     []string   `json:"match_user_tags"`
+	Name              *string    `json:"name,omitempty"`
+	ResourceGroup     *Reference `json:"resource_group,omitempty"`
+}
+
+// BackupPolicyPlan : a plan of a backup policy, which schedules the backups and their retention
+type BackupPolicyPlan struct {
+	Active               *bool                                `json:"active,omitempty"`
+	AttachUserTags       []string                             `json:"attach_user_tags,omitempty"`
+	CopyUserTags         *bool                                `json:"copy_user_tags,omitempty"`
+	CreatedAt            *string                              `json:"created_at,omitempty"`
+	CronSpec             *string                              `json:"cron_spec,omitempty"`
+	DeletionTrigger      *BackupPolicyPlanDeletionTrigger     `json:"deletion_trigger,omitempty"`
+	Href                 *string                              `json:"href,omitempty"`
+	ID                   *string                              `json:"id,omitempty"`
+	LifecycleState       *string                              `json:"lifecycle_state,omitempty"`
+	Name                 *string                              `json:"name,omitempty"`
+	RemoteRegionPolicies []BackupPolicyPlanRemoteRegionPolicy `json:"remote_region_policies,omitempty"`
+	ResourceType         *string                              `json:"resource_type,omitempty"`
+}
+
+// BackupPolicyPlanDeletionTrigger : the retention of the backups of a plan, by age in days and by count
+type BackupPolicyPlanDeletionTrigger struct {
+	DeleteAfter     *int64 `json:"delete_after,omitempty"`
+	DeleteOverCount *int64 `json:"delete_over_count,omitempty"`
+}
+
+// BackupPolicyPlanRemoteRegionPolicy : the copy of the backups of a plan to another region, and its retention by
+// count
+type BackupPolicyPlanRemoteRegionPolicy struct {
+	DeleteOverCount *int64     `json:"delete_over_count,omitempty"`
+	EncryptionKey   *Reference `json:"encryption_key,omitempty"`
+	Region          *Reference `json:"region"`
+}
+
+// BackupPolicyPlanPrototype : the request of the creation of a plan of a backup policy
+type BackupPolicyPlanPrototype struct {
+	Active               *bool                                `json:"active,omitempty"`
+	AttachUserTags       []string                             `json:"attach_user_tags,omitempty"`
+	CopyUserTags         *bool                                `json:"copy_user_tags,omitempty"`
+	CronSpec             *string                              `json:"cron_spec"`
+	DeletionTrigger      *BackupPolicyPlanDeletionTrigger     `json:"deletion_trigger,omitempty"`
+	Name                 *string                              `json:"name,omitempty"`
+	RemoteRegionPolicies []BackupPolicyPlanRemoteRegionPolicy `json:"remote_region_policies,omitempty"`
+}
+
+// BackupPolicyJob : a run of a plan of a backup policy, which creates the backup of a resource or deletes the
+// backups over the retention of the plan
+type BackupPolicyJob struct {
+	AutoDelete       *bool          `json:"auto_delete,omitempty"`
+	AutoDeleteAfter  *int64         `json:"auto_delete_after,omitempty"`
+	BackupPolicyPlan *Reference     `json:"backup_policy_plan,omitempty"`
+	CompletedAt      *string        `json:"completed_at,omitempty"`
+	CreatedAt        *string        `json:"created_at,omitempty"`
+	Href             *string        `json:"href,omitempty"`
+	ID               *string        `json:"id,omitempty"`
+	JobType          *string        `json:"job_type,omitempty"`
+	ResourceType     *string        `json:"resource_type,omitempty"`
+	Source           *Reference     `json:"source,omitempty"`
+	Status           *string        `json:"status,omitempty"`
+	StatusReasons    []StatusReason `json:"status_reasons,omitempty"`
+	TargetSnapshots  []Reference    `json:"target_snapshots,omitempty"`
+}
+
+// ListBackupPolicies lists all the backup policies of the region
+func (vpc *VpcExtV1) ListBackupPolicies(ctx context.Context) (result []BackupPolicy, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/backup_policies", nil, nil, "backup_policies", &result)
+	return
+}
+
+// CreateBackupPolicy creates a backup policy
+func (vpc *VpcExtV1) CreateBackupPolicy(ctx context.Context, prototype *BackupPolicyPrototype) (result *BackupPolicy, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/backup_policies", nil, prototype, &result)
+	return
+}
+
+// GetBackupPolicy retrieves a backup policy
+func (vpc *VpcExtV1) GetBackupPolicy(ctx context.Context, id string) (result *BackupPolicy, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/backup_policies/{id}", map[string]string{"id": id}, &result)
+	return
+}
+
+// UpdateBackupPolicy updates a backup policy with a merge patch
+func (vpc *VpcExtV1) UpdateBackupPolicy(ctx context.Context, id string, patch map[string]interface{}) (result *BackupPolicy, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/backup_policies/{id}", map[string]string{"id": id}, patch, &result)
+	return
+}
+
+// DeleteBackupPolicy deletes a backup policy and its plans. The backups it created are kept.
+func (vpc *VpcExtV1) DeleteBackupPolicy(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/backup_policies/{id}", map[string]string{"id": id})
+}
+
+// ListBackupPolicyPlans lists the plans of a backup policy
+func (vpc *VpcExtV1) ListBackupPolicyPlans(ctx context.Context, policyID string) (result []BackupPolicyPlan, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/backup_policies/{backup_policy_id}/plans", map[string]string{"backup_policy_id": policyID}, nil, "plans", &result)
+	return
+}
+
+// CreateBackupPolicyPlan adds a plan to a backup policy
+func (vpc *VpcExtV1) CreateBackupPolicyPlan(ctx context.Context, policyID string, prototype *BackupPolicyPlanPrototype) (result *BackupPolicyPlan, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/backup_policies/{backup_policy_id}/plans", map[string]string{"backup_policy_id": policyID}, prototype, &result)
+	return
+}
+
+// GetBackupPolicyPlan retrieves a plan of a backup policy
+func (vpc *VpcExtV1) GetBackupPolicyPlan(ctx context.Context, policyID, id string) (result *BackupPolicyPlan, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/backup_policies/{backup_policy_id}/plans/{id}", map[string]string{"backup_policy_id": policyID, "id": id}, &result)
+	return
+}
+
+// UpdateBackupPolicyPlan updates a plan of a backup policy with a merge patch
+func (vpc *VpcExtV1) UpdateBackupPolicyPlan(ctx context.Context, policyID, id string, patch map[string]interface{}) (result *BackupPolicyPlan, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/backup_policies/{backup_policy_id}/plans/{id}", map[string]string{"backup_policy_id": policyID, "id": id}, patch, &result)
+	return
+}
+
+// DeleteBackupPolicyPlan removes a plan from a backup policy
+func (vpc *VpcExtV1) DeleteBackupPolicyPlan(ctx context.Context, policyID, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/backup_policies/{backup_policy_id}/plans/{id}", map[string]string{"backup_policy_id": policyID, "id": id})
+}
+
+// ListBackupPolicyJobs lists the jobs of a backup policy, optionally of one of its plans only
+func (vpc *VpcExtV1) ListBackupPolicyJobs(ctx context.Context, policyID, planID string) (result []BackupPolicyJob, response *core.DetailedResponse, err error) {
+	var query map[string]string
+	if planID != "" {
+		query = map[string]string{"backup_policy_plan.id": planID}
+	}
+	response, err = vpc.list(ctx, "/backup_policies/{backup_policy_id}/jobs", map[string]string{"backup_policy_id": policyID}, query, "jobs", &result)
+	return
+}
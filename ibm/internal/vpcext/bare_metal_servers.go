@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Bare metal server network interface types
+const (
+	BareMetalServerNetworkInterfaceTypePci  = "pci"
+	BareMetalServerNetworkInterfaceTypeVlan = "vlan"
+)
+
+// Bare metal server stop types
+const (
+	BareMetalServerStopTypeHard = "hard"
+	BareMetalServerStopTypeSoft = "soft"
+)
+
+// BareMetalServer : a VPC bare metal server
+type BareMetalServer struct {
+	Bandwidth               *int64                                     `json:"bandwidth,omitempty"`
+	BootTarget              *Reference                                 `json:"boot_target,omitempty"`
+	CPU                     *BareMetalServerCPU                        `json:"cpu,omitempty"`
+	CreatedAt               *string                                    `json:"created_at,omitempty"`
+	CRN                     *string                                    `json:"crn,omitempty"`
+	Disks                   []BareMetalServerDisk                      `json:"disks,omitempty"`
+	Href                    *string                                    `json:"href,omitempty"`
+	ID                      *string                                    `json:"id,omitempty"`
+	Memory                  *int64                                     `json:"memory,omitempty"`
+	Name                    *string                                    `json:"name,omitempty"`
+	NetworkInterfaces       []BareMetalServerNetworkInterfaceReference `json:"network_interfaces,omitempty"`
+	PrimaryNetworkInterface *BareMetalServerNetworkInterfaceReference  `json:"primary_network_interface,omitempty"`
+	Profile                 *Reference                                 `json:"profile,omitempty"`
+	ResourceGroup           *Reference                                 `json:"resource_group,omitempty"`
+	ResourceType            *string                                    `json:"resource_type,omitempty"`
+	Status                  *string                                    `json:"status,omitempty"`
+	StatusReasons           []StatusReason                             `json:"status_reasons,omitempty"`
+	VPC                     *Reference                                 `json:"vpc,omitempty"`
+	Zone                    *Reference                                 `json:"zone,omitempty"`
+}
+
+// BareMetalServerCPU : the processors of a bare metal server
+type BareMetalServerCPU struct {
+	Architecture   *string `json:"architecture,omitempty"`
+	CoreCount      *int64  `json:"core_count,omitempty"`
+	SocketCount    *int64  `json:"socket_count,omitempty"`
+	ThreadsPerCore *int64  `json:"threads_per_core,omitempty"`
+}
+
+// BareMetalServerDisk : a local disk of a bare metal server
+type BareMetalServerDisk struct {
+	Href          *string `json:"href,omitempty"`
+	ID            *string `json:"id,omitempty"`
+	InterfaceType *string `json:"interface_type,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Size          *int64  `json:"size,omitempty"`
+}
+
+// BareMetalServerNetworkInterfaceReference : a network interface of a bare metal server
+type BareMetalServerNetworkInterfaceReference struct {
+	Href      *string              `json:"href,omitempty"`
+	ID        *string              `json:"id,omitempty"`
+	Name      *string              `json:"name,omitempty"`
+	PrimaryIP *ReservedIPReference `json:"primary_ip,omitempty"`
+}
+
+// BareMetalServerPrototype : the request of the creation of a bare metal server
+type BareMetalServerPrototype struct {
+	Initialization          *BareMetalServerInitializationPrototype    `json:"initialization"`
+	Name                    *string                                    `json:"name,omitempty"`
+	NetworkInterfaces       []BareMetalServerNetworkInterfacePrototype `json:"network_interfaces,omitempty"`
+	PrimaryNetworkInterface *BareMetalServerNetworkInterfacePrototype  `json:"primary_network_interface"`
+	Profile                 *Reference                                 `json:"profile"`
+	ResourceGroup           *Reference                                 `json:"resource_group,omitempty"`
+	VPC                     *Reference                                 `json:"vpc,omitempty"`
+	Zone                    *Reference                                 `json:"zone"`
+}
+
+// BareMetalServerInitializationPrototype : the image, keys and user data of a new bare metal server
+type BareMetalServerInitializationPrototype struct {
+	Image    *Reference  `json:"image"`
+	Keys     []Reference `json:"keys"`
+	UserData *string     `json:"user_data,omitempty"`
+}
+
+// BareMetalServerInitialization : the initialization of a bare metal server
+type BareMetalServerInitialization struct {
+	Image *Reference  `json:"image,omitempty"`
+	Keys  []Reference `json:"keys,omitempty"`
+}
+
+// BareMetalServerNetworkInterfacePrototype : the request of the creation of a network interface of a bare metal server.
+// AllowedVlans only applies to the pci interfaces, AllowInterfaceToFloat and Vlan to the vlan interfaces.
+type BareMetalServerNetworkInterfacePrototype struct {
+	AllowIPSpoofing         *bool                        `json:"allow_ip_spoofing,omitempty"`
+	AllowInterfaceToFloat   *bool                        `json:"allow_interface_to_float,omitempty"`
+	AllowedVlans            []int64                      `json:"allowed_vlans,omitempty"`
+	EnableInfrastructureNat *bool                        `json:"enable_infrastructure_nat,omitempty"`
+	InterfaceType           *string                      `json:"interface_type"`
+	Name                    *string                      `json:"name,omitempty"`
+	PrimaryIP               *NetworkInterfaceIPPrototype `json:"primary_ip,omitempty"`
+	SecurityGroups          []Reference                  `json:"security_groups,omitempty"`
+	Subnet                  *Reference                   `json:"subnet"`
+	Vlan                    *int64                       `json:"vlan,omitempty"`
+}
+
+// NetworkInterfaceIPPrototype : the primary IP of a new network interface, by address or reserved IP ID
+type NetworkInterfaceIPPrototype struct {
+	Address    *string `json:"address,omitempty"`
+	AutoDelete *bool   `json:"auto_delete,omitempty"`
+	ID         *string `json:"id,omitempty"`
+	Name       *string `json:"name,omitempty"`
+}
+
+// BareMetalServerNetworkInterface : a network interface of a bare metal server
+type BareMetalServerNetworkInterface struct {
+	AllowIPSpoofing         *bool                 `json:"allow_ip_spoofing,omitempty"`
+	AllowInterfaceToFloat   *bool                 `json:"allow_interface_to_float,omitempty"`
+	AllowedVlans            []int64               `json:"allowed_vlans,omitempty"`
+	CreatedAt               *string               `json:"created_at,omitempty"`
+	EnableInfrastructureNat *bool                 `json:"enable_infrastructure_nat,omitempty"`
+	FloatingIPs             []FloatingIPReference `json:"floating_ips,omitempty"`
+	Href                    *string               `json:"href,omitempty"`
+	ID                      *string               `json:"id,omitempty"`
+	InterfaceType           *string               `json:"interface_type,omitempty"`
+	MacAddress              *string               `json:"mac_address,omitempty"`
+	Name                    *string               `json:"name,omitempty"`
+	PortSpeed               *int64                `json:"port_speed,omitempty"`
+	PrimaryIP               *ReservedIPReference  `json:"primary_ip,omitempty"`
+	ResourceType            *string               `json:"resource_type,omitempty"`
+	SecurityGroups          []Reference           `json:"security_groups,omitempty"`
+	Status                  *string               `json:"status,omitempty"`
+	Subnet                  *Reference            `json:"subnet,omitempty"`
+	Type                    *string               `json:"type,omitempty"`
+	Vlan                    *int64                `json:"vlan,omitempty"`
+}
+
+// BareMetalServerProfile : a profile of bare metal servers
+type BareMetalServerProfile struct {
+	Bandwidth       *ProfileValue                `json:"bandwidth,omitempty"`
+	CPUArchitecture *ProfileStringValue          `json:"cpu_architecture,omitempty"`
+	CPUCoreCount    *ProfileValue                `json:"cpu_core_count,omitempty"`
+	CPUSocketCount  *ProfileValue                `json:"cpu_socket_count,omitempty"`
+	Disks           []BareMetalServerProfileDisk `json:"disks,omitempty"`
+	Family          *string                      `json:"family,omitempty"`
+	Href            *string                      `json:"href,omitempty"`
+	Memory          *ProfileValue                `json:"memory,omitempty"`
+	Name            *string                      `json:"name,omitempty"`
+	OsArchitecture  *ProfileStringValue          `json:"os_architecture,omitempty"`
+	ResourceType    *string                      `json:"resource_type,omitempty"`
+}
+
+// BareMetalServerProfileDisk : the local disks of a profile of bare metal servers
+type BareMetalServerProfileDisk struct {
+	Quantity                *ProfileValue       `json:"quantity,omitempty"`
+	Size                    *ProfileValue       `json:"size,omitempty"`
+	SupportedInterfaceTypes *ProfileStringValue `json:"supported_interface_types,omitempty"`
+}
+
+// ListBareMetalServersOptions : the filters of the list of the bare metal servers
+type ListBareMetalServersOptions struct {
+	Name            *string
+	ResourceGroupID *string
+	VPCID           *string
+}
+
+// ListBareMetalServers lists all the bare metal servers of the region
+func (vpc *VpcExtV1) ListBareMetalServers(ctx context.Context, options *ListBareMetalServersOptions) (result []BareMetalServer, response *core.DetailedResponse, err error) {
+	query := map[string]string{}
+	if options != nil {
+		if options.Name != nil {
+			query["name"] = *options.Name
+		}
+		if options.ResourceGroupID != nil {
+			query["resource_group.id"] = *options.ResourceGroupID
+		}
+		if options.VPCID != nil {
+			query["vpc.id"] = *options.VPCID
+		}
+	}
+	response, err = vpc.list(ctx, "/bare_metal_servers", nil, query, "bare_metal_servers", &result)
+	return
+}
+
+// CreateBareMetalServer creates a bare metal server
+func (vpc *VpcExtV1) CreateBareMetalServer(ctx context.Context, prototype *BareMetalServerPrototype) (result *BareMetalServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/bare_metal_servers", nil, prototype, &result)
+	return
+}
+
+// GetBareMetalServer retrieves a bare metal server
+func (vpc *VpcExtV1) GetBareMetalServer(ctx context.Context, id string) (result *BareMetalServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/bare_metal_servers/{id}", map[string]string{"id": id}, &result)
+	return
+}
+
+// UpdateBareMetalServer updates a bare metal server with a merge patch
+func (vpc *VpcExtV1) UpdateBareMetalServer(ctx context.Context, id string, patch map[string]interface{}) (result *BareMetalServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/bare_metal_servers/{id}", map[string]string{"id": id}, patch, &result)
+	return
+}
+
+// DeleteBareMetalServer deletes a bare metal server, which must be stopped
+func (vpc *VpcExtV1) DeleteBareMetalServer(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/bare_metal_servers/{id}", map[string]string{"id": id})
+}
+
+// GetBareMetalServerInitialization retrieves the image and keys a bare metal server was initialized with
+func (vpc *VpcExtV1) GetBareMetalServerInitialization(ctx context.Context, id string) (result *BareMetalServerInitialization, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/bare_metal_servers/{id}/initialization", map[string]string{"id": id}, &result)
+	return
+}
+
+// StartBareMetalServer starts a bare metal server
+func (vpc *VpcExtV1) StartBareMetalServer(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.post(ctx, "/bare_metal_servers/{id}/start", map[string]string{"id": id}, nil, nil)
+}
+
+// StopBareMetalServer stops a bare metal server, with a stop type of hard or soft
+func (vpc *VpcExtV1) StopBareMetalServer(ctx context.Context, id, stopType string) (*core.DetailedResponse, error) {
+	body := map[string]interface{}{"type": stopType}
+	return vpc.post(ctx, "/bare_metal_servers/{id}/stop", map[string]string{"id": id}, body, nil)
+}
+
+// RestartBareMetalServer restarts a bare metal server
+func (vpc *VpcExtV1) RestartBareMetalServer(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.post(ctx, "/bare_metal_servers/{id}/restart", map[string]string{"id": id}, nil, nil)
+}
+
+// ListBareMetalServerNetworkInterfaces lists the network interfaces of a bare metal server
+func (vpc *VpcExtV1) ListBareMetalServerNetworkInterfaces(ctx context.Context, serverID string) (result []BareMetalServerNetworkInterface, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces", map[string]string{"bare_metal_server_id": serverID}, nil, "network_interfaces", &result)
+	return
+}
+
+// CreateBareMetalServerNetworkInterface adds a network interface to a bare metal server
+func (vpc *VpcExtV1) CreateBareMetalServerNetworkInterface(ctx context.Context, serverID string, prototype *BareMetalServerNetworkInterfacePrototype) (result *BareMetalServerNetworkInterface, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces", map[string]string{"bare_metal_server_id": serverID}, prototype, &result)
+	return
+}
+
+// GetBareMetalServerNetworkInterface retrieves a network interface of a bare metal server
+func (vpc *VpcExtV1) GetBareMetalServerNetworkInterface(ctx context.Context, serverID, id string) (result *BareMetalServerNetworkInterface, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{id}", map[string]string{"bare_metal_server_id": serverID, "id": id}, &result)
+	return
+}
+
+// UpdateBareMetalServerNetworkInterface updates a network interface of a bare metal server with a merge patch
+func (vpc *VpcExtV1) UpdateBareMetalServerNetworkInterface(ctx context.Context, serverID, id string, patch map[string]interface{}) (result *BareMetalServerNetworkInterface, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{id}", map[string]string{"bare_metal_server_id": serverID, "id": id}, patch, &result)
+	return
+}
+
+// DeleteBareMetalServerNetworkInterface removes a network interface from a bare metal server
+func (vpc *VpcExtV1) DeleteBareMetalServerNetworkInterface(ctx context.Context, serverID, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{id}", map[string]string{"bare_metal_server_id": serverID, "id": id})
+}
+
+// AddBareMetalServerNetworkInterfaceFloatingIP associates a floating IP with a network interface of a bare metal server
+func (vpc *VpcExtV1) AddBareMetalServerNetworkInterfaceFloatingIP(ctx context.Context, serverID, nicID, id string) (result *FloatingIPReference, response *core.DetailedResponse, err error) {
+	pathParams := map[string]string{"bare_metal_server_id": serverID, "network_interface_id": nicID, "id": id}
+	response, err = vpc.put(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{network_interface_id}/floating_ips/{id}", pathParams, nil, &result)
+	return
+}
+
+// RemoveBareMetalServerNetworkInterfaceFloatingIP disassociates a floating IP from a network interface of a bare metal server
+func (vpc *VpcExtV1) RemoveBareMetalServerNetworkInterfaceFloatingIP(ctx context.Context, serverID, nicID, id string) (*core.DetailedResponse, error) {
+	pathParams := map[string]string{"bare_metal_server_id": serverID, "network_interface_id": nicID, "id": id}
+	return vpc.delete(ctx, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{network_interface_id}/floating_ips/{id}", pathParams)
+}
+
+// ListBareMetalServerProfiles lists the profiles of bare metal servers
+func (vpc *VpcExtV1) ListBareMetalServerProfiles(ctx context.Context) (result []BareMetalServerProfile, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/bare_metal_server/profiles", nil, nil, "profiles", &result)
+	return
+}
+
+// GetBareMetalServerProfile retrieves a profile of bare metal servers
+func (vpc *VpcExtV1) GetBareMetalServerProfile(ctx context.Context, name string) (result *BareMetalServerProfile, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/bare_metal_server/profiles/{name}", map[string]string{"name": name}, &result)
+	return
+}
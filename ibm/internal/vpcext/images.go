@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Image export job formats
+const (
+	ImageExportJobFormatQcow2 = "qcow2"
+	ImageExportJobFormatVHD   = "vhd"
+)
+
+// ImageExportJob : a job exporting an image to an object of a Cloud Object Storage bucket
+type ImageExportJob struct {
+	CompletedAt      *string        `json:"completed_at,omitempty"`
+	CreatedAt        *string        `json:"created_at,omitempty"`
+	EncryptedDataKey *string        `json:"encrypted_data_key,omitempty"`
+	Format           *string        `json:"format,omitempty"`
+	Href             *string        `json:"href,omitempty"`
+	ID               *string        `json:"id,omitempty"`
+	Name             *string        `json:"name,omitempty"`
+	ResourceType     *string        `json:"resource_type,omitempty"`
+	StartedAt        *string        `json:"started_at,omitempty"`
+	Status           *string        `json:"status,omitempty"`
+	StatusReasons    []StatusReason `json:"status_reasons,omitempty"`
+	StorageBucket    *Reference     `json:"storage_bucket,omitempty"`
+	StorageHref      *string        `json:"storage_href,omitempty"`
+	StorageObject    *Reference     `json:"storage_object,omitempty"`
+}
+
+// ImageExportJobPrototype : the request of the export of an image. The storage bucket is referenced by name or CRN.
+type ImageExportJobPrototype struct {
+	Format        *string    `json:"format,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+	StorageBucket *Reference `json:"storage_bucket"`
+}
+
+// ListImageExportJobs lists the export jobs of an image
+func (vpc *VpcExtV1) ListImageExportJobs(ctx context.Context, imageID string) (result []ImageExportJob, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/images/{image_id}/export_jobs", map[string]string{"image_id": imageID}, nil, "export_jobs", &result)
+	return
+}
+
+// CreateImageExportJob starts the export of an image
+func (vpc *VpcExtV1) CreateImageExportJob(ctx context.Context, imageID string, prototype *ImageExportJobPrototype) (result *ImageExportJob, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/images/{image_id}/export_jobs", map[string]string{"image_id": imageID}, prototype, &result)
+	return
+}
+
+// GetImageExportJob retrieves an export job of an image
+func (vpc *VpcExtV1) GetImageExportJob(ctx context.Context, imageID, id string) (result *ImageExportJob, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/images/{image_id}/export_jobs/{id}", map[string]string{"image_id": imageID, "id": id}, &result)
+	return
+}
+
+// UpdateImageExportJob updates an export job of an image with a merge patch
+func (vpc *VpcExtV1) UpdateImageExportJob(ctx context.Context, imageID, id string, patch map[string]interface{}) (result *ImageExportJob, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/images/{image_id}/export_jobs/{id}", map[string]string{"image_id": imageID, "id": id}, patch, &result)
+	return
+}
+
+// DeleteImageExportJob cancels an export job of an image if it is still running, and deletes it. The exported object
+// is left in its bucket.
+func (vpc *VpcExtV1) DeleteImageExportJob(ctx context.Context, imageID, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/images/{image_id}/export_jobs/{id}", map[string]string{"image_id": imageID, "id": id})
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Placement group strategies
+const (
+	PlacementGroupStrategyHostSpread  = "host_spread"
+	PlacementGroupStrategyPowerSpread = "power_spread"
+)
+
+// PlacementGroup : a placement group, which spreads the instances placed in it across hosts or power domains
+type PlacementGroup struct {
+	CreatedAt      *string    `json:"created_at,omitempty"`
+	CRN            *string    `json:"crn,omitempty"`
+	Href           *string    `json:"href,omitempty"`
+	ID             *string    `json:"id,omitempty"`
+	LifecycleState *string    `json:"lifecycle_state,omitempty"`
+	Name           *string    `json:"name,omitempty"`
+	ResourceGroup  *Reference `json:"resource_group,omitempty"`
+	ResourceType   *string    `json:"resource_type,omitempty"`
+	Strategy       *string    `json:"strategy,omitempty"`
+}
+
+// PlacementGroupPrototype : the request of the creation of a placement group
+type PlacementGroupPrototype struct {
+	Name          *string    `json:"name,omitempty"`
+	ResourceGroup *Reference `json:"resource_group,omitempty"`
+	Strategy      *string    `json:"strategy"`
+}
+
+// ListPlacementGroups lists all the placement groups of the region
+func (vpc *VpcExtV1) ListPlacementGroups(ctx context.Context) (result []PlacementGroup, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/placement_groups", nil, nil, "placement_groups", &result)
+	return
+}
+
+// CreatePlacementGroup creates a placement group
+func (vpc *VpcExtV1) CreatePlacementGroup(ctx context.Context, prototype *PlacementGroupPrototype) (result *PlacementGroup, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/placement_groups", nil, prototype, &result)
+	return
+}
+
+// GetPlacementGroup retrieves a placement group
+func (vpc *VpcExtV1) GetPlacementGroup(ctx context.Context, id string) (result *PlacementGroup, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/placement_groups/{id}", map[string]string{"id": id}, &result)
+	return
+}
+
+// UpdatePlacementGroup updates a placement group with a merge patch
+func (vpc *VpcExtV1) UpdatePlacementGroup(ctx context.Context, id string, patch map[string]interface{}) (result *PlacementGroup, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/placement_groups/{id}", map[string]string{"id": id}, patch, &result)
+	return
+}
+
+// DeletePlacementGroup deletes a placement group, which must not have any instances placed in it
+func (vpc *VpcExtV1) DeletePlacementGroup(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/placement_groups/{id}", map[string]string{"id": id})
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Share access control modes
+const (
+	ShareAccessControlModeSecurityGroup = "security_group"
+	ShareAccessControlModeVPC           = "vpc"
+)
+
+// Share replica failover fallback policies
+const (
+	ShareFailoverFallbackPolicyFail  = "fail"
+	ShareFailoverFallbackPolicySplit = "split"
+)
+
+// Share : a file share, mounted over NFS through its mount targets
+type Share struct {
+	AccessControlMode   *string        `json:"access_control_mode,omitempty"`
+	CreatedAt           *string        `json:"created_at,omitempty"`
+	CRN                 *string        `json:"crn,omitempty"`
+	Encryption          *string        `json:"encryption,omitempty"`
+	EncryptionKey       *Reference     `json:"encryption_key,omitempty"`
+	Href                *string        `json:"href,omitempty"`
+	ID                  *string        `json:"id,omitempty"`
+	Iops                *int64         `json:"iops,omitempty"`
+	LastSyncAt          *string        `json:"last_sync_at,omitempty"`
+	LifecycleState      *string        `json:"lifecycle_state,omitempty"`
+	MountTargets        []Reference    `json:"mount_targets,omitempty"`
+	Name                *string        `json:"name,omitempty"`
+	Profile             *Reference     `json:"profile,omitempty"`
+	ReplicaShare        *Reference     `json:"replica_share,omitempty"`
+	ReplicationCronSpec *string        `json:"replication_cron_spec,omitempty"`
+	ReplicationRole     *string        `json:"replication_role,omitempty"`
+	ReplicationStatus   *string        `json:"replication_status,omitempty"`
+	ReplicationReasons  []StatusReason `json:"replication_status_reasons,omitempty"`
+	ResourceGroup       *Reference     `json:"resource_group,omitempty"`
+	ResourceType        *string        `json:"resource_type,omitempty"`
+	Size                *int64         `json:"size,omitempty"`
+	SourceShare         *Reference     `json:"source_share,omitempty"`
+	Zone                *Reference     `json:"zone,omitempty"`
+}
+
+// SharePrototype : the request of the creation of a share. A replica share has a source share and a replication cron
+// spec, and no size.
+type SharePrototype struct {
+	AccessControlMode   *string    `json:"access_control_mode,omitempty"`
+	EncryptionKey       *Reference `json:"encryption_key,omitempty"`
+	Iops                *int64     `json:"iops,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	Profile             *Reference `json:"profile"`
+	ReplicationCronSpec *string    `json:"replication_cron_spec,omitempty"`
+	ResourceGroup       *Reference `json:"resource_group,omitempty"`
+	Size                *int64     `json:"size,omitempty"`
+	SourceShare         *Reference `json:"source_share,omitempty"`
+	Zone                *Reference `json:"zone"`
+}
+
+// ShareFailoverPrototype : the request of the failover of a replica share to its source share
+type ShareFailoverPrototype struct {
+	FallbackPolicy *string `json:"fallback_policy,omitempty"`
+	Timeout        *int64  `json:"timeout,omitempty"`
+}
+
+// ShareMountTarget : a mount target of a share, through which the share is mounted from a VPC
+type ShareMountTarget struct {
+	AccessControlMode       *string              `json:"access_control_mode,omitempty"`
+	CreatedAt               *string              `json:"created_at,omitempty"`
+	Href                    *string              `json:"href,omitempty"`
+	ID                      *string              `json:"id,omitempty"`
+	LifecycleState          *string              `json:"lifecycle_state,omitempty"`
+	MountPath               *string              `json:"mount_path,omitempty"`
+	Name                    *string              `json:"name,omitempty"`
+	PrimaryIP               *ReservedIPReference `json:"primary_ip,omitempty"`
+	ResourceType            *string              `json:"resource_type,omitempty"`
+	Subnet                  *Reference           `json:"subnet,omitempty"`
+	TransitEncryption       *string              `json:"transit_encryption,omitempty"`
+	VirtualNetworkInterface *Reference           `json:"virtual_network_interface,omitempty"`
+	VPC                     *Reference           `json:"vpc,omitempty"`
+}
+
+// ShareMountTargetPrototype : the request of the creation of a mount target. A mount target of a share with the vpc
+// access control mode is bound to a VPC, one of a share with the security_group mode has a virtual network interface
+// in a subnet.
+type ShareMountTargetPrototype struct {
+	Name                    *string                                  `json:"name,omitempty"`
+	TransitEncryption       *string                                  `json:"transit_encryption,omitempty"`
+	VirtualNetworkInterface *ShareMountTargetVirtualNetworkInterface `json:"virtual_network_interface,omitempty"`
+	VPC                     *Reference                               `json:"vpc,omitempty"`
+}
+
+// ShareMountTargetVirtualNetworkInterface : the virtual network interface of a mount target created with it
+type ShareMountTargetVirtualNetworkInterface struct {
+	Name           *string     `json:"name,omitempty"`
+	ResourceGroup  *Reference  `json:"resource_group,omitempty"`
+	SecurityGroups []Reference `json:"security_groups,omitempty"`
+	Subnet         *Reference  `json:"subnet"`
+}
+
+// ShareProfile : a profile of shares
+type ShareProfile struct {
+	Capacity     *ProfileValue `json:"capacity,omitempty"`
+	Family       *string       `json:"family,omitempty"`
+	Href         *string       `json:"href,omitempty"`
+	Iops         *ProfileValue `json:"iops,omitempty"`
+	Name         *string       `json:"name,omitempty"`
+	ResourceType *string       `json:"resource_type,omitempty"`
+}
+
+// ListShares lists all the shares of the region
+func (vpc *VpcExtV1) ListShares(ctx context.Context) (result []Share, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/shares", nil, nil, "shares", &result)
+	return
+}
+
+// CreateShare creates a share, or a replica of a share
+func (vpc *VpcExtV1) CreateShare(ctx context.Context, prototype *SharePrototype) (result *Share, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/shares", nil, prototype, &result)
+	return
+}
+
+// GetShare retrieves a share
+func (vpc *VpcExtV1) GetShare(ctx context.Context, id string) (result *Share, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/shares/{id}", map[string]string{"id": id}, &result)
+	return
+}
+
+// UpdateShare updates a share with a merge patch
+func (vpc *VpcExtV1) UpdateShare(ctx context.Context, id string, patch map[string]interface{}) (result *Share, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/shares/{id}", map[string]string{"id": id}, patch, &result)
+	return
+}
+
+// DeleteShare deletes a share, which must not have any mount targets
+func (vpc *VpcExtV1) DeleteShare(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/shares/{id}", map[string]string{"id": id})
+}
+
+// FailoverShare fails a replica share over, making it the source share of its source share
+func (vpc *VpcExtV1) FailoverShare(ctx context.Context, id string, prototype *ShareFailoverPrototype) (*core.DetailedResponse, error) {
+	return vpc.post(ctx, "/shares/{id}/failover", map[string]string{"id": id}, prototype, nil)
+}
+
+// DeleteShareSource splits a replica share from its source share, making both of them standalone shares
+func (vpc *VpcExtV1) DeleteShareSource(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/shares/{id}/source", map[string]string{"id": id})
+}
+
+// ListShareMountTargets lists the mount targets of a share
+func (vpc *VpcExtV1) ListShareMountTargets(ctx context.Context, shareID string) (result []ShareMountTarget, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/shares/{share_id}/mount_targets", map[string]string{"share_id": shareID}, nil, "mount_targets", &result)
+	return
+}
+
+// CreateShareMountTarget adds a mount target to a share
+func (vpc *VpcExtV1) CreateShareMountTarget(ctx context.Context, shareID string, prototype *ShareMountTargetPrototype) (result *ShareMountTarget, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/shares/{share_id}/mount_targets", map[string]string{"share_id": shareID}, prototype, &result)
+	return
+}
+
+// GetShareMountTarget retrieves a mount target of a share
+func (vpc *VpcExtV1) GetShareMountTarget(ctx context.Context, shareID, id string) (result *ShareMountTarget, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/shares/{share_id}/mount_targets/{id}", map[string]string{"share_id": shareID, "id": id}, &result)
+	return
+}
+
+// UpdateShareMountTarget updates a mount target of a share with a merge patch
+func (vpc *VpcExtV1) UpdateShareMountTarget(ctx context.Context, shareID, id string, patch map[string]interface{}) (result *ShareMountTarget, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/shares/{share_id}/mount_targets/{id}", map[string]string{"share_id": shareID, "id": id}, patch, &result)
+	return
+}
+
+// DeleteShareMountTarget removes a mount target from a share
+func (vpc *VpcExtV1) DeleteShareMountTarget(ctx context.Context, shareID, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/shares/{share_id}/mount_targets/{id}", map[string]string{"share_id": shareID, "id": id})
+}
+
+// ListShareProfiles lists the profiles of shares
+func (vpc *VpcExtV1) ListShareProfiles(ctx context.Context) (result []ShareProfile, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/share/profiles", nil, nil, "profiles", &result)
+	return
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Snapshot : the properties of a snapshot missing from vpcv1.Snapshot
+type Snapshot struct {
+	BackupPolicyPlan *Reference  `json:"backup_policy_plan,omitempty"`
+	Copies           []Reference `json:"copies,omitempty"`
+	CRN              *string     `json:"crn,omitempty"`
+	ID               *string     `json:"id,omitempty"`
+	LifecycleState   *string     `json:"lifecycle_state,omitempty"`
+	SourceSnapshot   *Reference  `json:"source_snapshot,omitempty"`
+	UserTags         []string    `json:"user_tags,omitempty"`
+}
+
+// SnapshotCopyPrototype : the request of the copy of a snapshot of another region into the region of the client
+type SnapshotCopyPrototype struct {
+	EncryptionKey  *Reference `json:"encryption_key,omitempty"`
+	Name           *string    `json:"name,omitempty"`
+	ResourceGroup  *Reference `json:"resource_group,omitempty"`
+	SourceSnapshot *Reference `json:"source_snapshot"`
+}
+
+// CreateSnapshotCopy copies a snapshot, referenced by CRN, into the region of the client
+func (vpc *VpcExtV1) CreateSnapshotCopy(ctx context.Context, prototype *SnapshotCopyPrototype) (result *Snapshot, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/snapshots", nil, prototype, &result)
+	return
+}
+
+// GetSnapshot retrieves the properties of a snapshot missing from vpcv1.Snapshot
+func (vpc *VpcExtV1) GetSnapshot(ctx context.Context, id string) (result *Snapshot, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/snapshots/{id}", map[string]string{"id": id}, &result)
+	return
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package vpcext is a client for the VPC APIs which the vpc-go-sdk version of the provider does not cover yet.
+// It sends its requests with the service of a vpcv1 client, so they share its endpoint, authentication, retries
+// and rate limits. The models follow the vpcv1 conventions, so that they can be replaced by the SDK ones later.
+//
+// The provider is pinned to vpc-go-sdk v0.7.0, which has no bare metal server, placement group, VPN server, backup
+// policy, file share or image export APIs. Upgrading the SDK touches the vpcv1 models which every VPC resource of the
+// provider uses, so it is a migration of its own rather than a part of these resources.
+// This package only covers the requests of these APIs, at the single API version of Version, and is meant to be
+// deleted resource by resource once the SDK is upgraded.
+package vpcext
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// Version is the VPC API version date of the models of this package
+const Version = "2023-12-19"
+
+// VpcExtV1 sends the requests of the VPC APIs missing from vpcv1
+type VpcExtV1 struct {
+	Service *core.BaseService
+}
+
+// New returns a client sharing the service of a vpcv1 client
+func New(vpc *vpcv1.VpcV1) *VpcExtV1 {
+	return &VpcExtV1{Service: vpc.Service}
+}
+
+// Reference is the identity of a resource referenced by another one, by ID, CRN or name
+type Reference struct {
+	CRN          *string `json:"crn,omitempty"`
+	Href         *string `json:"href,omitempty"`
+	ID           *string `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	ResourceType *string `json:"resource_type,omitempty"`
+}
+
+// ReservedIPReference is a reserved IP bound to a network interface
+type ReservedIPReference struct {
+	Address      *string `json:"address,omitempty"`
+	Href         *string `json:"href,omitempty"`
+	ID           *string `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	ResourceType *string `json:"resource_type,omitempty"`
+}
+
+// FloatingIPReference is a floating IP bound to a network interface
+type FloatingIPReference struct {
+	Address *string `json:"address,omitempty"`
+	CRN     *string `json:"crn,omitempty"`
+	Href    *string `json:"href,omitempty"`
+	ID      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+}
+
+// StatusReason explains the status of a resource
+type StatusReason struct {
+	Code     *string `json:"code,omitempty"`
+	Message  *string `json:"message,omitempty"`
+	MoreInfo *string `json:"more_info,omitempty"`
+}
+
+// ProfileValue is a property of a profile, of type fixed, range, enum or dependent
+type ProfileValue struct {
+	Type    *string `json:"type,omitempty"`
+	Value   *int64  `json:"value,omitempty"`
+	Default *int64  `json:"default,omitempty"`
+	Max     *int64  `json:"max,omitempty"`
+	Min     *int64  `json:"min,omitempty"`
+	Step    *int64  `json:"step,omitempty"`
+	Values  []int64 `json:"values,omitempty"`
+}
+
+// ProfileStringValue is a property of a profile with string values
+type ProfileStringValue struct {
+	Type    *string  `json:"type,omitempty"`
+	Value   *string  `json:"value,omitempty"`
+	Default *string  `json:"default,omitempty"`
+	Values  []string `json:"values,omitempty"`
+}
+
+func (vpc *VpcExtV1) request(ctx context.Context, method, path string, pathParams, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = vpc.Service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(vpc.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", Version)
+	builder.AddQuery("generation", "2")
+	for k, v := range query {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		if method == core.PATCH {
+			builder.AddHeader("Content-Type", "application/merge-patch+json")
+		} else {
+			builder.AddHeader("Content-Type", "application/json")
+		}
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return vpc.Service.Request(request, result)
+}
+
+func (vpc *VpcExtV1) get(ctx context.Context, path string, pathParams map[string]string, result interface{}) (*core.DetailedResponse, error) {
+	return vpc.request(ctx, core.GET, path, pathParams, nil, nil, result)
+}
+
+func (vpc *VpcExtV1) post(ctx context.Context, path string, pathParams map[string]string, body, result interface{}) (*core.DetailedResponse, error) {
+	return vpc.request(ctx, core.POST, path, pathParams, nil, body, result)
+}
+
+func (vpc *VpcExtV1) put(ctx context.Context, path string, pathParams map[string]string, body, result interface{}) (*core.DetailedResponse, error) {
+	return vpc.request(ctx, core.PUT, path, pathParams, nil, body, result)
+}
+
+func (vpc *VpcExtV1) patch(ctx context.Context, path string, pathParams map[string]string, patch map[string]interface{}, result interface{}) (*core.DetailedResponse, error) {
+	return vpc.request(ctx, core.PATCH, path, pathParams, nil, patch, result)
+}
+
+func (vpc *VpcExtV1) delete(ctx context.Context, path string, pathParams map[string]string) (*core.DetailedResponse, error) {
+	return vpc.request(ctx, core.DELETE, path, pathParams, nil, nil, nil)
+}
+
+// list sends the requests of all the pages of a collection, and decodes the items of the collection property
+// named key into result, a pointer to a slice
+func (vpc *VpcExtV1) list(ctx context.Context, path string, pathParams, query map[string]string, key string, result interface{}) (*core.DetailedResponse, error) {
+	items := []json.RawMessage{}
+	pageQuery := map[string]string{}
+	for k, v := range query {
+		pageQuery[k] = v
+	}
+	var response *core.DetailedResponse
+	for {
+		page := map[string]json.RawMessage{}
+		var err error
+		response, err = vpc.request(ctx, core.GET, path, pathParams, pageQuery, nil, &page)
+		if err != nil {
+			return response, err
+		}
+		if raw, ok := page[key]; ok {
+			var pageItems []json.RawMessage
+			if err := json.Unmarshal(raw, &pageItems); err != nil {
+				return response, fmt.Errorf("Error decoding the %s of %s: %w", key, path, err)
+			}
+			items = append(items, pageItems...)
+		}
+		start, err := nextStart(page["next"])
+		if err != nil {
+			return response, err
+		}
+		if start == "" {
+			break
+		}
+		pageQuery["start"] = start
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return response, err
+	}
+	return response, json.Unmarshal(data, result)
+}
+
+// nextStart returns the start parameter of the href of the next page of a collection
+func nextStart(next json.RawMessage) (string, error) {
+	if len(next) == 0 {
+		return "", nil
+	}
+	var reference struct {
+		Href string `json:"href"`
+	}
+	if err := json.Unmarshal(next, &reference); err != nil {
+		return "", fmt.Errorf("invalid next page reference: %s", err)
+	}
+	if reference.Href == "" {
+		return "", nil
+	}
+	u, err := url.Parse(reference.Href)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page href %q: %s", reference.Href, err)
+	}
+	start := u.Query().Get("start")
+	if start == "" {
+		return "", fmt.Errorf("the next page href %q has no start parameter", reference.Href)
+	}
+	return start, nil
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func testClient(t *testing.T, handler http.HandlerFunc) *VpcExtV1 {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	vpc, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(vpc)
+}
+
+func TestListFollowsPages(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bare_metal_servers" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("version") != Version || q.Get("generation") != "2" || q.Get("vpc.id") != "r006-vpc" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("start") {
+		case "":
+			fmt.Fprint(w, `{"bare_metal_servers":[{"id":"1"},{"id":"2"}],"next":{"href":"https://vpc/v1/bare_metal_servers?start=abc&limit=2"}}`)
+		case "abc":
+			fmt.Fprint(w, `{"bare_metal_servers":[{"id":"3"}]}`)
+		default:
+			t.Errorf("unexpected start %s", q.Get("start"))
+		}
+	})
+
+	vpcID := "r006-vpc"
+	servers, _, err := client.ListBareMetalServers(context.Background(), &ListBareMetalServersOptions{VPCID: &vpcID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 3 || *servers[0].ID != "1" || *servers[2].ID != "3" {
+		t.Fatalf("unexpected servers %v", servers)
+	}
+}
+
+func TestListFailsOnInvalidNextPage(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"bare_metal_servers":[{"id":"1"}],"next":{"href":"https://vpc/v1/bare_metal_servers?limit=1"}}`)
+	})
+
+	_, _, err := client.ListBareMetalServers(context.Background(), &ListBareMetalServersOptions{})
+	if err == nil || !strings.Contains(err.Error(), "start") {
+		t.Fatalf("expected an error on the next page without a start, got %v", err)
+	}
+}
+
+func TestUpdateSendsMergePatch(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/bare_metal_servers/r006-bms/network_interfaces/r006-nic" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/merge-patch+json" {
+			t.Errorf("unexpected content type %s", ct)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		var patch map[string]interface{}
+		if err := json.Unmarshal(body, &patch); err != nil || patch["name"] != "eth1" {
+			t.Errorf("unexpected body %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"r006-nic","name":"eth1","interface_type":"vlan","vlan":4}`)
+	})
+
+	nic, _, err := client.UpdateBareMetalServerNetworkInterface(context.Background(), "r006-bms", "r006-nic", map[string]interface{}{"name": "eth1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *nic.Name != "eth1" || *nic.InterfaceType != BareMetalServerNetworkInterfaceTypeVlan || *nic.Vlan != 4 {
+		t.Fatalf("unexpected network interface %v", nic)
+	}
+}
+
+func TestStopAndErrors(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bare_metal_servers/r006-bms/stop":
+			body, _ := ioutil.ReadAll(r.Body)
+			if strings.TrimSpace(string(body)) != `{"type":"hard"}` {
+				t.Errorf("unexpected body %s", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"code":"not_found","message":"Bare metal server not found"}],"trace":"abc"}`)
+		}
+	})
+
+	if _, err := client.StopBareMetalServer(context.Background(), "r006-bms", BareMetalServerStopTypeHard); err != nil {
+		t.Fatal(err)
+	}
+	_, response, err := client.GetBareMetalServer(context.Background(), "r006-missing")
+	if err == nil || response == nil || response.StatusCode != 404 {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if err.Error() != "Bare metal server not found" {
+		t.Fatalf("unexpected error message %s", err)
+	}
+}
+
+func TestCreateSnapshotCopy(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/snapshots" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if strings.TrimSpace(string(body)) != `{"name":"dr-copy","source_snapshot":{"crn":"crn:v1:bluemix:public:is:us-east:a/123::snapshot:r014-src"}}` {
+			t.Errorf("unexpected body %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"r006-copy","lifecycle_state":"pending","source_snapshot":{"crn":"crn:v1:bluemix:public:is:us-east:a/123::snapshot:r014-src"}}`)
+	})
+
+	name := "dr-copy"
+	crn := "crn:v1:bluemix:public:is:us-east:a/123::snapshot:r014-src"
+	snapshot, _, err := client.CreateSnapshotCopy(context.Background(), &SnapshotCopyPrototype{
+		Name:           &name,
+		SourceSnapshot: &Reference{CRN: &crn},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *snapshot.ID != "r006-copy" || *snapshot.SourceSnapshot.CRN != crn {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+}
+
+func TestListBackupPolicyJobsOfPlan(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/backup_policies/r006-policy/jobs" || r.URL.Query().Get("backup_policy_plan.id") != "r006-plan" {
+			t.Errorf("unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"jobs":[{"id":"r006-job","job_type":"deletion","status":"succeeded","target_snapshots":[{"id":"r006-snap"}]}]}`)
+	})
+
+	jobs, _, err := client.ListBackupPolicyJobs(context.Background(), "r006-policy", "r006-plan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || *jobs[0].JobType != "deletion" || *jobs[0].TargetSnapshots[0].ID != "r006-snap" {
+		t.Fatalf("unexpected jobs %v", jobs)
+	}
+}
+
+func TestFailoverShare(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/shares/r006-replica/failover" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if strings.TrimSpace(string(body)) != `{"fallback_policy":"split","timeout":600}` {
+			t.Errorf("unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	fallbackPolicy := ShareFailoverFallbackPolicySplit
+	timeout := int64(600)
+	_, err := client.FailoverShare(context.Background(), "r006-replica", &ShareFailoverPrototype{
+		FallbackPolicy: &fallbackPolicy,
+		Timeout:        &timeout,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateImageExportJob(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/images/r006-image/export_jobs" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if strings.TrimSpace(string(body)) != `{"format":"vhd","storage_bucket":{"name":"golden-images"}}` {
+			t.Errorf("unexpected body %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"r006-job","format":"vhd","status":"queued","storage_href":"cos://us-south/golden-images/my-image.vhd"}`)
+	})
+
+	format := ImageExportJobFormatVHD
+	bucket := "golden-images"
+	job, _, err := client.CreateImageExportJob(context.Background(), "r006-image", &ImageExportJobPrototype{
+		Format:        &format,
+		StorageBucket: &Reference{Name: &bucket},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *job.ID != "r006-job" || *job.StorageHref != "cos://us-south/golden-images/my-image.vhd" {
+		t.Fatalf("unexpected job %v", job)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpcext
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// VPN server client authentication methods
+const (
+	VPNServerAuthenticationMethodCertificate = "certificate"
+	VPNServerAuthenticationMethodUsername    = "username"
+)
+
+// VPN server route actions
+const (
+	VPNServerRouteActionDeliver   = "deliver"
+	VPNServerRouteActionDrop      = "drop"
+	VPNServerRouteActionTranslate = "translate"
+)
+
+// VPNServer : a client-to-site VPN server
+type VPNServer struct {
+	Certificate             *CertificateInstanceReference `json:"certificate,omitempty"`
+	ClientAuthentication    []VPNServerAuthentication     `json:"client_authentication,omitempty"`
+	ClientAutoDelete        *bool                         `json:"client_auto_delete,omitempty"`
+	ClientAutoDeleteTimeout *int64                        `json:"client_auto_delete_timeout,omitempty"`
+	ClientDNSServerIps      []IP                          `json:"client_dns_server_ips,omitempty"`
+	ClientIdleTimeout       *int64                        `json:"client_idle_timeout,omitempty"`
+	ClientIPPool            *string                       `json:"client_ip_pool,omitempty"`
+	CreatedAt               *string                       `json:"created_at,omitempty"`
+	CRN                     *string                       `json:"crn,omitempty"`
+	EnableSplitTunneling    *bool                         `json:"enable_split_tunneling,omitempty"`
+	HealthState             *string                       `json:"health_state,omitempty"`
+	Hostname                *string                       `json:"hostname,omitempty"`
+	Href                    *string                       `json:"href,omitempty"`
+	ID                      *string                       `json:"id,omitempty"`
+	LifecycleState          *string                       `json:"lifecycle_state,omitempty"`
+	Name                    *string                       `json:"name,omitempty"`
+	Port                    *int64                        `json:"port,omitempty"`
+	PrivateIps              []ReservedIPReference         `json:"private_ips,omitempty"`
+	Protocol                *string                       `json:"protocol,omitempty"`
+	ResourceGroup           *Reference                    `json:"resource_group,omitempty"`
+	ResourceType            *string                       `json:"resource_type,omitempty"`
+	SecurityGroups          []Reference                   `json:"security_groups,omitempty"`
+	Subnets                 []Reference                   `json:"subnets,omitempty"`
+	VPC                     *Reference                    `json:"vpc,omitempty"`
+}
+
+// CertificateInstanceReference : a certificate of a certificate manager or secrets manager instance, by CRN
+type CertificateInstanceReference struct {
+	CRN *string `json:"crn"`
+}
+
+// IP : an IP address
+type IP struct {
+	Address *string `json:"address"`
+}
+
+// VPNServerAuthentication : a method the clients of a VPN server authenticate with. ClientCa and Crl apply to the
+// certificate method, IdentityProvider to the username method.
+type VPNServerAuthentication struct {
+	Method           *string                              `json:"method"`
+	ClientCa         *CertificateInstanceReference        `json:"client_ca,omitempty"`
+	Crl              *string                              `json:"crl,omitempty"`
+	IdentityProvider *VPNServerAuthenticationByUsernameID `json:"identity_provider,omitempty"`
+}
+
+// VPNServerAuthenticationByUsernameID : the identity provider of the username authentication
+type VPNServerAuthenticationByUsernameID struct {
+	ProviderType *string `json:"provider_type"`
+}
+
+// VPNServerPrototype : the request of the creation of a VPN server
+type VPNServerPrototype struct {
+	Certificate          *CertificateInstanceReference `json:"certificate"`
+	ClientAuthentication []VPNServerAuthentication     `json:"client_authentication"`
+	ClientDNSServerIps   []IP                          `json:"client_dns_server_ips,omitempty"`
+	ClientIdleTimeout    *int64                        `json:"client_idle_timeout,omitempty"`
+	ClientIPPool         *string                       `json:"client_ip_pool"`
+	EnableSplitTunneling *bool                         `json:"enable_split_tunneling,omitempty"`
+	Name                 *string                       `json:"name,omitempty"`
+	Port                 *int64                        `json:"port,omitempty"`
+	Protocol             *string                       `json:"protocol,omitempty"`
+	ResourceGroup        *Reference                    `json:"resource_group,omitempty"`
+	SecurityGroups       []Reference                   `json:"security_groups,omitempty"`
+	Subnets              []Reference                   `json:"subnets"`
+}
+
+// VPNServerRoute : a route of a VPN server, applied to the traffic of its clients
+type VPNServerRoute struct {
+	Action         *string `json:"action,omitempty"`
+	CreatedAt      *string `json:"created_at,omitempty"`
+	Destination    *string `json:"destination,omitempty"`
+	HealthState    *string `json:"health_state,omitempty"`
+	Href           *string `json:"href,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	LifecycleState *string `json:"lifecycle_state,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	ResourceType   *string `json:"resource_type,omitempty"`
+}
+
+// VPNServerRoutePrototype : the request of the creation of a route of a VPN server
+type VPNServerRoutePrototype struct {
+	Action      *string `json:"action,omitempty"`
+	Destination *string `json:"destination"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// VPNServerClient : a client connected to a VPN server
+type VPNServerClient struct {
+	ClientIP       *IP     `json:"client_ip,omitempty"`
+	CommonName     *string `json:"common_name,omitempty"`
+	CreatedAt      *string `json:"created_at,omitempty"`
+	DisconnectedAt *string `json:"disconnected_at,omitempty"`
+	Href           *string `json:"href,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	RemoteIP       *IP     `json:"remote_ip,omitempty"`
+	RemotePort     *int64  `json:"remote_port,omitempty"`
+	ResourceType   *string `json:"resource_type,omitempty"`
+	Status         *string `json:"status,omitempty"`
+	Username       *string `json:"username,omitempty"`
+}
+
+// ListVPNServers lists all the VPN servers of the region
+func (vpc *VpcExtV1) ListVPNServers(ctx context.Context) (result []VPNServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/vpn_servers", nil, nil, "vpn_servers", &result)
+	return
+}
+
+// CreateVPNServer creates a VPN server
+func (vpc *VpcExtV1) CreateVPNServer(ctx context.Context, prototype *VPNServerPrototype) (result *VPNServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/vpn_servers", nil, prototype, &result)
+	return
+}
+
+// GetVPNServer retrieves a VPN server
+func (vpc *VpcExtV1) GetVPNServer(ctx context.Context, id string) (result *VPNServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/vpn_servers/{id}", map[string]string{"id": id}, &result)
+	return
+}
+
+// UpdateVPNServer updates a VPN server with a merge patch
+func (vpc *VpcExtV1) UpdateVPNServer(ctx context.Context, id string, patch map[string]interface{}) (result *VPNServer, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/vpn_servers/{id}", map[string]string{"id": id}, patch, &result)
+	return
+}
+
+// DeleteVPNServer deletes a VPN server and its routes and clients
+func (vpc *VpcExtV1) DeleteVPNServer(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/vpn_servers/{id}", map[string]string{"id": id})
+}
+
+// ListVPNServerRoutes lists the routes of a VPN server
+func (vpc *VpcExtV1) ListVPNServerRoutes(ctx context.Context, serverID string) (result []VPNServerRoute, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/vpn_servers/{vpn_server_id}/routes", map[string]string{"vpn_server_id": serverID}, nil, "routes", &result)
+	return
+}
+
+// CreateVPNServerRoute adds a route to a VPN server
+func (vpc *VpcExtV1) CreateVPNServerRoute(ctx context.Context, serverID string, prototype *VPNServerRoutePrototype) (result *VPNServerRoute, response *core.DetailedResponse, err error) {
+	response, err = vpc.post(ctx, "/vpn_servers/{vpn_server_id}/routes", map[string]string{"vpn_server_id": serverID}, prototype, &result)
+	return
+}
+
+// GetVPNServerRoute retrieves a route of a VPN server
+func (vpc *VpcExtV1) GetVPNServerRoute(ctx context.Context, serverID, id string) (result *VPNServerRoute, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/vpn_servers/{vpn_server_id}/routes/{id}", map[string]string{"vpn_server_id": serverID, "id": id}, &result)
+	return
+}
+
+// UpdateVPNServerRoute updates a route of a VPN server with a merge patch
+func (vpc *VpcExtV1) UpdateVPNServerRoute(ctx context.Context, serverID, id string, patch map[string]interface{}) (result *VPNServerRoute, response *core.DetailedResponse, err error) {
+	response, err = vpc.patch(ctx, "/vpn_servers/{vpn_server_id}/routes/{id}", map[string]string{"vpn_server_id": serverID, "id": id}, patch, &result)
+	return
+}
+
+// DeleteVPNServerRoute removes a route from a VPN server
+func (vpc *VpcExtV1) DeleteVPNServerRoute(ctx context.Context, serverID, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/vpn_servers/{vpn_server_id}/routes/{id}", map[string]string{"vpn_server_id": serverID, "id": id})
+}
+
+// ListVPNServerClients lists the clients of a VPN server
+func (vpc *VpcExtV1) ListVPNServerClients(ctx context.Context, serverID string) (result []VPNServerClient, response *core.DetailedResponse, err error) {
+	response, err = vpc.list(ctx, "/vpn_servers/{vpn_server_id}/clients", map[string]string{"vpn_server_id": serverID}, nil, "clients", &result)
+	return
+}
+
+// GetVPNServerClient retrieves a client of a VPN server
+func (vpc *VpcExtV1) GetVPNServerClient(ctx context.Context, serverID, id string) (result *VPNServerClient, response *core.DetailedResponse, err error) {
+	response, err = vpc.get(ctx, "/vpn_servers/{vpn_server_id}/clients/{id}", map[string]string{"vpn_server_id": serverID, "id": id}, &result)
+	return
+}
+
+// DisconnectVPNServerClient disconnects a client from a VPN server, which keeps its record
+func (vpc *VpcExtV1) DisconnectVPNServerClient(ctx context.Context, serverID, id string) (*core.DetailedResponse, error) {
+	return vpc.post(ctx, "/vpn_servers/{vpn_server_id}/clients/{id}/disconnect", map[string]string{"vpn_server_id": serverID, "id": id}, nil, nil)
+}
+
+// DeleteVPNServerClient disconnects a client from a VPN server and deletes its record
+func (vpc *VpcExtV1) DeleteVPNServerClient(ctx context.Context, serverID, id string) (*core.DetailedResponse, error) {
+	return vpc.delete(ctx, "/vpn_servers/{vpn_server_id}/clients/{id}", map[string]string{"vpn_server_id": serverID, "id": id})
+}
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,7 +19,7 @@ var ibmMutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -155,6 +156,26 @@ func Provider() *schema.Provider {
 				Description: "IAM Authentication refresh token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, nil),
 			},
+			"iam_profile_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "IAM Trusted Profile ID to assume with the compute resource token",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
+				ConflictsWith: []string{"iam_profile_name"},
+			},
+			"iam_profile_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "IAM Trusted Profile Name to assume with the compute resource token",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"}, nil),
+				ConflictsWith: []string{"iam_profile_id"},
+			},
+			"cr_token_filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File containing the compute resource token which is exchanged for an IAM Trusted Profile token",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_CR_TOKEN_FILENAME", "IBMCLOUD_CR_TOKEN_FILENAME"}, DefaultCRTokenFilename),
+			},
 			"visibility": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -162,6 +183,16 @@ func Provider() *schema.Provider {
 				Description:  "Visibility of the provider if it is private or public.",
 				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public"),
 			},
+			"endpoints":    providerEndpointsSchema(),
+			"retry":        providerRetrySchema(),
+			"default_tags": providerDefaultTagsSchema(),
+			"rate_limits": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeFloat},
+				ValidateFunc: validateRateLimits,
+				Description:  "Maximum number of API requests per second sent to a service, keyed by the service names of the endpoints block",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -238,6 +269,10 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_id":                     dataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                 dataSourceIBMIAMServicePolicy(),
 			"ibm_iam_api_key":                        dataSourceIbmIamApiKey(),
+			"ibm_is_bare_metal_server":               dataSourceIBMISBareMetalServer(),
+			"ibm_is_bare_metal_servers":              dataSourceIBMISBareMetalServers(),
+			"ibm_is_bare_metal_server_profile":       dataSourceIBMISBareMetalServerProfile(),
+			"ibm_is_bare_metal_server_profiles":      dataSourceIBMISBareMetalServerProfiles(),
 			"ibm_is_dedicated_host":                  dataSourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_hosts":                 dataSourceIbmIsDedicatedHosts(),
 			"ibm_is_dedicated_host_profile":          dataSourceIbmIsDedicatedHostProfile(),
@@ -250,6 +285,8 @@ func Provider() *schema.Provider {
 			"ibm_is_flow_logs":                       dataSourceIBMISFlowLogs(),
 			"ibm_is_image":                           dataSourceIBMISImage(),
 			"ibm_is_images":                          dataSourceIBMISImages(),
+			"ibm_is_image_export_job":                dataSourceIBMISImageExportJob(),
+			"ibm_is_image_export_jobs":               dataSourceIBMISImageExportJobs(),
 			"ibm_is_endpoint_gateway_targets":        dataSourceIBMISEndpointGatewayTargets(),
 			"ibm_is_instance_group":                  dataSourceIBMISInstanceGroup(),
 			"ibm_is_instance_group_memberships":      dataSourceIBMISInstanceGroupMemberships(),
@@ -275,6 +312,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb":                              dataSourceIBMISLB(),
 			"ibm_is_lb_profiles":                     dataSourceIBMISLbProfiles(),
 			"ibm_is_lbs":                             dataSourceIBMISLBS(),
+			"ibm_is_placement_group":                 dataSourceIbmIsPlacementGroup(),
 			"ibm_is_public_gateway":                  dataSourceIBMISPublicGateway(),
 			"ibm_is_public_gateways":                 dataSourceIBMISPublicGateways(),
 			"ibm_is_region":                          dataSourceIBMISRegion(),
@@ -288,6 +326,12 @@ func Provider() *schema.Provider {
 			"ibm_is_security_group_targets":          dataSourceIBMISSecurityGroupTargets(),
 			"ibm_is_snapshot":                        dataSourceSnapshot(),
 			"ibm_is_snapshots":                       dataSourceSnapshots(),
+			"ibm_is_backup_policy":                   dataSourceIBMISBackupPolicy(),
+			"ibm_is_backup_policy_jobs":              dataSourceIBMISBackupPolicyJobs(),
+			"ibm_is_share":                           dataSourceIBMISShare(),
+			"ibm_is_shares":                          dataSourceIBMISShares(),
+			"ibm_is_share_profiles":                  dataSourceIBMISShareProfiles(),
+			"ibm_is_share_mount_targets":             dataSourceIBMISShareMountTargets(),
 			"ibm_is_volume":                          dataSourceIBMISVolume(),
 			"ibm_is_volume_profile":                  dataSourceIBMISVolumeProfile(),
 			"ibm_is_volume_profiles":                 dataSourceIBMISVolumeProfiles(),
@@ -295,6 +339,8 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateways":                    dataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixs":             dataSourceIbmIsVpcAddressPrefix(),
 			"ibm_is_vpn_gateway_connections":         dataSourceIBMISVPNGatewayConnections(),
+			"ibm_is_vpn_server":                      dataSourceIBMISVPNServer(),
+			"ibm_is_vpn_server_clients":              dataSourceIBMISVPNServerClients(),
 			"ibm_is_vpc_default_routing_table":       dataSourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_tables":              dataSourceIBMISVPCRoutingTables(),
 			"ibm_is_vpc_routing_table_routes":        dataSourceIBMISVPCRoutingTableRoutes(),
@@ -418,6 +464,9 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                             resourceIBMFunctionNamespace(),
 			"ibm_cis":                                            resourceIBMCISInstance(),
 			"ibm_database":                                       resourceIBMDatabaseInstance(),
+			"ibm_database_allowlist_entry":                       resourceIBMDatabaseAllowlistEntry(),
+			"ibm_database_read_replica":                          resourceIBMDatabaseReadReplica(),
+			"ibm_database_user":                                  resourceIBMDatabaseUser(),
 			"ibm_certificate_manager_import":                     resourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":                      resourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                                     resourceIBMCISDomain(),
@@ -465,6 +514,7 @@ func Provider() *schema.Provider {
 			"ibm_container_cluster_feature":                      resourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                         resourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                          resourceIBMContainerWorkerPool(),
+			"ibm_container_worker_pool_autoscaling":              resourceIBMContainerWorkerPoolAutoscaling(),
 			"ibm_container_worker_pool_zone_attachment":          resourceIBMContainerWorkerPoolZoneAttachment(),
 			"ibm_cr_namespace":                                   resourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                            resourceIBMCrRetentionPolicy(),
@@ -495,12 +545,15 @@ func Provider() *schema.Provider {
 			"ibm_iam_user_invite":                                resourceIBMUserInvite(),
 			"ibm_iam_api_key":                                    resourceIbmIamApiKey(),
 			"ibm_ipsec_vpn":                                      resourceIBMIPSecVPN(),
+			"ibm_is_bare_metal_server":                           resourceIBMISBareMetalServer(),
+			"ibm_is_bare_metal_server_network_interface":         resourceIBMISBareMetalServerNetworkInterface(),
 			"ibm_is_dedicated_host":                              resourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_host_group":                        resourceIbmIsDedicatedHostGroup(),
 			"ibm_is_dedicated_host_disk_management":              resourceIBMISDedicatedHostDiskManagement(),
 			"ibm_is_floating_ip":                                 resourceIBMISFloatingIP(),
 			"ibm_is_flow_log":                                    resourceIBMISFlowLog(),
 			"ibm_is_instance":                                    resourceIBMISInstance(),
+			"ibm_is_instance_network_interface":                  resourceIBMISInstanceNetworkInterface(),
 			"ibm_is_instance_disk_management":                    resourceIBMISInstanceDiskManagement(),
 			"ibm_is_instance_group":                              resourceIBMISInstanceGroup(),
 			"ibm_is_instance_group_membership":                   resourceIBMISInstanceGroupMembership(),
@@ -519,8 +572,10 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                     resourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     resourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              resourceIBMISLBPoolMember(),
+			"ibm_is_lb_pool_members":                             resourceIBMISLBPoolMembers(),
 			"ibm_is_network_acl":                                 resourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                            resourceIBMISNetworkACLRule(),
+			"ibm_is_placement_group":                             resourceIbmIsPlacementGroup(),
 			"ibm_is_public_gateway":                              resourceIBMISPublicGateway(),
 			"ibm_is_security_group":                              resourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                         resourceIBMISSecurityGroupRule(),
@@ -531,15 +586,25 @@ func Provider() *schema.Provider {
 			"ibm_is_subnet_network_acl_attachment":               resourceIBMISSubnetNetworkACLAttachment(),
 			"ibm_is_ssh_key":                                     resourceIBMISSSHKey(),
 			"ibm_is_snapshot":                                    resourceIBMSnapshot(),
+			"ibm_is_snapshot_copy":                               resourceIBMISSnapshotCopy(),
+			"ibm_is_backup_policy":                               resourceIBMISBackupPolicy(),
+			"ibm_is_backup_policy_plan":                          resourceIBMISBackupPolicyPlan(),
+			"ibm_is_share":                                       resourceIBMISShare(),
+			"ibm_is_share_mount_target":                          resourceIBMISShareMountTarget(),
+			"ibm_is_share_replica_operations":                    resourceIBMISShareReplicaOperations(),
 			"ibm_is_volume":                                      resourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                                 resourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                      resourceIBMISVPNGatewayConnection(),
+			"ibm_is_vpn_server":                                  resourceIBMISVPNServer(),
+			"ibm_is_vpn_server_route":                            resourceIBMISVPNServerRoute(),
+			"ibm_is_vpn_server_client":                           resourceIBMISVPNServerClient(),
 			"ibm_is_vpc":                                         resourceIBMISVPC(),
 			"ibm_is_vpc_address_prefix":                          resourceIBMISVpcAddressPrefix(),
 			"ibm_is_vpc_route":                                   resourceIBMISVpcRoute(),
 			"ibm_is_vpc_routing_table":                           resourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":                     resourceIBMISVPCRoutingTableRoute(),
 			"ibm_is_image":                                       resourceIBMISImage(),
+			"ibm_is_image_export_job":                            resourceIBMISImageExportJob(),
 			"ibm_lb":                                             resourceIBMLb(),
 			"ibm_lbaas":                                          resourceIBMLbaas(),
 			"ibm_lbaas_health_monitor":                           resourceIBMLbaasHealthMonitor(),
@@ -641,6 +706,28 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	// Describe the failed API requests of the VPC, CIS and IAM resources and data sources in their diagnostics
+	for name, r := range provider.DataSourcesMap {
+		if describesAPIErrors(name) {
+			withDiagnostics(r)
+		}
+	}
+	for name, r := range provider.ResourcesMap {
+		if describesAPIErrors(name) {
+			withDiagnostics(r)
+		}
+		// Merge the default tags of the provider into the tags of the resource
+		if name != "ibm_resource_tag" && supportsDefaultTags(r) {
+			withDefaultTags(r)
+		}
+	}
+	return provider
+}
+
+// describesAPIErrors tells whether the errors of a resource or data source describe their failed API request
+func describesAPIErrors(name string) bool {
+	return strings.HasPrefix(name, "ibm_is_") || strings.HasPrefix(name, "ibm_cis") || strings.HasPrefix(name, "ibm_iam_")
 }
 
 var globalValidatorDict ValidatorDict
@@ -651,101 +738,117 @@ func Validator() ValidatorDict {
 	initOnce.Do(func() {
 		globalValidatorDict = ValidatorDict{
 			ResourceValidatorDictionary: map[string]*ResourceValidator{
-				"ibm_iam_account_settings":              resourceIBMIAMAccountSettingsValidator(),
-				"ibm_iam_custom_role":                   resourceIBMIAMCustomRoleValidator(),
-				"ibm_cis_healthcheck":                   resourceIBMCISHealthCheckValidator(),
-				"ibm_cis_rate_limit":                    resourceIBMCISRateLimitValidator(),
-				"ibm_cis":                               resourceIBMCISValidator(),
-				"ibm_cis_domain_settings":               resourceIBMCISDomainSettingValidator(),
-				"ibm_cis_tls_settings":                  resourceIBMCISTLSSettingsValidator(),
-				"ibm_cis_routing":                       resourceIBMCISRoutingValidator(),
-				"ibm_cis_page_rule":                     resourceCISPageRuleValidator(),
-				"ibm_cis_waf_package":                   resourceIBMCISWAFPackageValidator(),
-				"ibm_cis_waf_group":                     resourceIBMCISWAFGroupValidator(),
-				"ibm_cis_certificate_upload":            resourceCISCertificateUploadValidator(),
-				"ibm_cis_cache_settings":                resourceIBMCISCacheSettingsValidator(),
-				"ibm_cis_custom_page":                   resourceIBMCISCustomPageValidator(),
-				"ibm_cis_firewall":                      resourceIBMCISFirewallValidator(),
-				"ibm_cis_range_app":                     resourceIBMCISRangeAppValidator(),
-				"ibm_cis_waf_rule":                      resourceIBMCISWAFRuleValidator(),
-				"ibm_cis_certificate_order":             resourceIBMCISCertificateOrderValidator(),
-				"ibm_cis_filter":                        resourceIBMCISFilterValidator(),
-				"ibm_cr_namespace":                      resourceIBMCrNamespaceValidator(),
-				"ibm_tg_gateway":                        resourceIBMTGValidator(),
-				"ibm_app_config_feature":                resourceIbmAppConfigFeatureValidator(),
-				"ibm_tg_connection":                     resourceIBMTransitGatewayConnectionValidator(),
-				"ibm_dl_virtual_connection":             resourceIBMdlGatewayVCValidator(),
-				"ibm_dl_gateway":                        resourceIBMDLGatewayValidator(),
-				"ibm_dl_provider_gateway":               resourceIBMDLProviderGatewayValidator(),
-				"ibm_database":                          resourceIBMICDValidator(),
-				"ibm_function_package":                  resourceIBMFuncPackageValidator(),
-				"ibm_function_action":                   resourceIBMFuncActionValidator(),
-				"ibm_function_rule":                     resourceIBMFuncRuleValidator(),
-				"ibm_function_trigger":                  resourceIBMFuncTriggerValidator(),
-				"ibm_function_namespace":                resourceIBMFuncNamespaceValidator(),
-				"ibm_is_dedicated_host_group":           resourceIbmIsDedicatedHostGroupValidator(),
-				"ibm_is_dedicated_host":                 resourceIbmIsDedicatedHostValidator(),
-				"ibm_is_dedicated_host_disk_management": resourceIBMISDedicatedHostDiskManagementValidator(),
-				"ibm_is_flow_log":                       resourceIBMISFlowLogValidator(),
-				"ibm_is_instance_group":                 resourceIBMISInstanceGroupValidator(),
-				"ibm_is_instance_group_membership":      resourceIBMISInstanceGroupMembershipValidator(),
-				"ibm_is_instance_group_manager":         resourceIBMISInstanceGroupManagerValidator(),
-				"ibm_is_instance_group_manager_policy":  resourceIBMISInstanceGroupManagerPolicyValidator(),
-				"ibm_is_instance_group_manager_action":  resourceIBMISInstanceGroupManagerActionValidator(),
-				"ibm_is_floating_ip":                    resourceIBMISFloatingIPValidator(),
-				"ibm_is_ike_policy":                     resourceIBMISIKEValidator(),
-				"ibm_is_image":                          resourceIBMISImageValidator(),
-				"ibm_is_instance":                       resourceIBMISInstanceValidator(),
-				"ibm_is_instance_disk_management":       resourceIBMISInstanceDiskManagementValidator(),
-				"ibm_is_instance_volume_attachment":     resourceIBMISInstanceVolumeAttachmentValidator(),
-				"ibm_is_ipsec_policy":                   resourceIBMISIPSECValidator(),
-				"ibm_is_lb_listener_policy_rule":        resourceIBMISLBListenerPolicyRuleValidator(),
-				"ibm_is_lb_listener_policy":             resourceIBMISLBListenerPolicyValidator(),
-				"ibm_is_lb_listener":                    resourceIBMISLBListenerValidator(),
-				"ibm_is_lb_pool":                        resourceIBMISLBPoolValidator(),
-				"ibm_is_lb":                             resourceIBMISLBValidator(),
-				"ibm_is_network_acl":                    resourceIBMISNetworkACLValidator(),
-				"ibm_is_network_acl_rule":               resourceIBMISNetworkACLRuleValidator(),
-				"ibm_is_public_gateway":                 resourceIBMISPublicGatewayValidator(),
-				"ibm_is_security_group_target":          resourceIBMISSecurityGroupTargetValidator(),
-				"ibm_is_security_group_rule":            resourceIBMISSecurityGroupRuleValidator(),
-				"ibm_is_security_group":                 resourceIBMISSecurityGroupValidator(),
-				"ibm_is_snapshot":                       resourceIBMISSnapshotValidator(),
-				"ibm_is_ssh_key":                        resourceIBMISSHKeyValidator(),
-				"ibm_is_subnet":                         resourceIBMISSubnetValidator(),
-				"ibm_is_subnet_reserved_ip":             resourceIBMISSubnetReservedIPValidator(),
-				"ibm_is_volume":                         resourceIBMISVolumeValidator(),
-				"ibm_is_address_prefix":                 resourceIBMISAddressPrefixValidator(),
-				"ibm_is_route":                          resourceIBMISRouteValidator(),
-				"ibm_is_vpc":                            resourceIBMISVPCValidator(),
-				"ibm_is_vpc_routing_table":              resourceIBMISVPCRoutingTableValidator(),
-				"ibm_is_vpc_routing_table_route":        resourceIBMISVPCRoutingTableRouteValidator(),
-				"ibm_is_vpn_gateway_connection":         resourceIBMISVPNGatewayConnectionValidator(),
-				"ibm_is_vpn_gateway":                    resourceIBMISVPNGatewayValidator(),
-				"ibm_kms_key_rings":                     resourceIBMKeyRingValidator(),
-				"ibm_dns_glb_monitor":                   resourceIBMPrivateDNSGLBMonitorValidator(),
-				"ibm_dns_glb_pool":                      resourceIBMPrivateDNSGLBPoolValidator(),
-				"ibm_schematics_action":                 resourceIBMSchematicsActionValidator(),
-				"ibm_schematics_job":                    resourceIBMSchematicsJobValidator(),
-				"ibm_schematics_workspace":              resourceIBMSchematicsWorkspaceValidator(),
-				"ibm_resource_instance":                 resourceIBMResourceInstanceValidator(),
-				"ibm_is_virtual_endpoint_gateway":       resourceIBMISEndpointGatewayValidator(),
-				"ibm_container_vpc_cluster":             resourceIBMContainerVpcClusterValidator(),
-				"ibm_container_cluster":                 resourceIBMContainerClusterValidator(),
-				"ibm_resource_tag":                      resourceIBMResourceTagValidator(),
-				"ibm_satellite_location":                resourceIBMSatelliteLocationValidator(),
-				"ibm_satellite_cluster":                 resourceIBMSatelliteClusterValidator(),
-				"ibm_pi_volume":                         resourceIBMPIVolumeValidator(),
+				"ibm_iam_account_settings":                   resourceIBMIAMAccountSettingsValidator(),
+				"ibm_iam_custom_role":                        resourceIBMIAMCustomRoleValidator(),
+				"ibm_cis_healthcheck":                        resourceIBMCISHealthCheckValidator(),
+				"ibm_cis_rate_limit":                         resourceIBMCISRateLimitValidator(),
+				"ibm_cis":                                    resourceIBMCISValidator(),
+				"ibm_cis_domain_settings":                    resourceIBMCISDomainSettingValidator(),
+				"ibm_cis_tls_settings":                       resourceIBMCISTLSSettingsValidator(),
+				"ibm_cis_routing":                            resourceIBMCISRoutingValidator(),
+				"ibm_cis_page_rule":                          resourceCISPageRuleValidator(),
+				"ibm_cis_waf_package":                        resourceIBMCISWAFPackageValidator(),
+				"ibm_cis_waf_group":                          resourceIBMCISWAFGroupValidator(),
+				"ibm_cis_certificate_upload":                 resourceCISCertificateUploadValidator(),
+				"ibm_cis_cache_settings":                     resourceIBMCISCacheSettingsValidator(),
+				"ibm_cis_custom_page":                        resourceIBMCISCustomPageValidator(),
+				"ibm_cis_firewall":                           resourceIBMCISFirewallValidator(),
+				"ibm_cis_range_app":                          resourceIBMCISRangeAppValidator(),
+				"ibm_cis_waf_rule":                           resourceIBMCISWAFRuleValidator(),
+				"ibm_cis_certificate_order":                  resourceIBMCISCertificateOrderValidator(),
+				"ibm_cis_filter":                             resourceIBMCISFilterValidator(),
+				"ibm_cr_namespace":                           resourceIBMCrNamespaceValidator(),
+				"ibm_tg_gateway":                             resourceIBMTGValidator(),
+				"ibm_app_config_feature":                     resourceIbmAppConfigFeatureValidator(),
+				"ibm_tg_connection":                          resourceIBMTransitGatewayConnectionValidator(),
+				"ibm_dl_virtual_connection":                  resourceIBMdlGatewayVCValidator(),
+				"ibm_dl_gateway":                             resourceIBMDLGatewayValidator(),
+				"ibm_dl_provider_gateway":                    resourceIBMDLProviderGatewayValidator(),
+				"ibm_database":                               resourceIBMICDValidator(),
+				"ibm_function_package":                       resourceIBMFuncPackageValidator(),
+				"ibm_function_action":                        resourceIBMFuncActionValidator(),
+				"ibm_function_rule":                          resourceIBMFuncRuleValidator(),
+				"ibm_function_trigger":                       resourceIBMFuncTriggerValidator(),
+				"ibm_function_namespace":                     resourceIBMFuncNamespaceValidator(),
+				"ibm_is_bare_metal_server":                   resourceIBMISBareMetalServerValidator(),
+				"ibm_is_bare_metal_server_network_interface": resourceIBMISBareMetalServerNetworkInterfaceValidator(),
+				"ibm_is_dedicated_host_group":                resourceIbmIsDedicatedHostGroupValidator(),
+				"ibm_is_dedicated_host":                      resourceIbmIsDedicatedHostValidator(),
+				"ibm_is_dedicated_host_disk_management":      resourceIBMISDedicatedHostDiskManagementValidator(),
+				"ibm_is_flow_log":                            resourceIBMISFlowLogValidator(),
+				"ibm_is_instance_group":                      resourceIBMISInstanceGroupValidator(),
+				"ibm_is_instance_group_membership":           resourceIBMISInstanceGroupMembershipValidator(),
+				"ibm_is_instance_group_manager":              resourceIBMISInstanceGroupManagerValidator(),
+				"ibm_is_instance_group_manager_policy":       resourceIBMISInstanceGroupManagerPolicyValidator(),
+				"ibm_is_instance_group_manager_action":       resourceIBMISInstanceGroupManagerActionValidator(),
+				"ibm_is_floating_ip":                         resourceIBMISFloatingIPValidator(),
+				"ibm_is_ike_policy":                          resourceIBMISIKEValidator(),
+				"ibm_is_image":                               resourceIBMISImageValidator(),
+				"ibm_is_image_export_job":                    resourceIBMISImageExportJobValidator(),
+				"ibm_is_instance":                            resourceIBMISInstanceValidator(),
+				"ibm_is_instance_network_interface":          resourceIBMISInstanceNetworkInterfaceValidator(),
+				"ibm_is_instance_disk_management":            resourceIBMISInstanceDiskManagementValidator(),
+				"ibm_is_instance_volume_attachment":          resourceIBMISInstanceVolumeAttachmentValidator(),
+				"ibm_is_ipsec_policy":                        resourceIBMISIPSECValidator(),
+				"ibm_is_lb_listener_policy_rule":             resourceIBMISLBListenerPolicyRuleValidator(),
+				"ibm_is_lb_listener_policy":                  resourceIBMISLBListenerPolicyValidator(),
+				"ibm_is_lb_listener":                         resourceIBMISLBListenerValidator(),
+				"ibm_is_lb_pool":                             resourceIBMISLBPoolValidator(),
+				"ibm_is_lb_pool_members":                     resourceIBMISLBPoolMembersValidator(),
+				"ibm_is_lb":                                  resourceIBMISLBValidator(),
+				"ibm_is_network_acl":                         resourceIBMISNetworkACLValidator(),
+				"ibm_is_network_acl_rule":                    resourceIBMISNetworkACLRuleValidator(),
+				"ibm_is_placement_group":                     resourceIbmIsPlacementGroupValidator(),
+				"ibm_is_public_gateway":                      resourceIBMISPublicGatewayValidator(),
+				"ibm_is_security_group_target":               resourceIBMISSecurityGroupTargetValidator(),
+				"ibm_is_security_group_rule":                 resourceIBMISSecurityGroupRuleValidator(),
+				"ibm_is_security_group":                      resourceIBMISSecurityGroupValidator(),
+				"ibm_is_snapshot":                            resourceIBMISSnapshotValidator(),
+				"ibm_is_backup_policy":                       resourceIBMISBackupPolicyValidator(),
+				"ibm_is_backup_policy_plan":                  resourceIBMISBackupPolicyPlanValidator(),
+				"ibm_is_share":                               resourceIBMISShareValidator(),
+				"ibm_is_share_mount_target":                  resourceIBMISShareMountTargetValidator(),
+				"ibm_is_share_replica_operations":            resourceIBMISShareReplicaOperationsValidator(),
+				"ibm_is_ssh_key":                             resourceIBMISSHKeyValidator(),
+				"ibm_is_subnet":                              resourceIBMISSubnetValidator(),
+				"ibm_is_subnet_reserved_ip":                  resourceIBMISSubnetReservedIPValidator(),
+				"ibm_is_volume":                              resourceIBMISVolumeValidator(),
+				"ibm_is_address_prefix":                      resourceIBMISAddressPrefixValidator(),
+				"ibm_is_route":                               resourceIBMISRouteValidator(),
+				"ibm_is_vpc":                                 resourceIBMISVPCValidator(),
+				"ibm_is_vpc_routing_table":                   resourceIBMISVPCRoutingTableValidator(),
+				"ibm_is_vpc_routing_table_route":             resourceIBMISVPCRoutingTableRouteValidator(),
+				"ibm_is_vpn_gateway_connection":              resourceIBMISVPNGatewayConnectionValidator(),
+				"ibm_is_vpn_gateway":                         resourceIBMISVPNGatewayValidator(),
+				"ibm_is_vpn_server":                          resourceIBMISVPNServerValidator(),
+				"ibm_is_vpn_server_route":                    resourceIBMISVPNServerRouteValidator(),
+				"ibm_kms_key_rings":                          resourceIBMKeyRingValidator(),
+				"ibm_dns_glb_monitor":                        resourceIBMPrivateDNSGLBMonitorValidator(),
+				"ibm_dns_glb_pool":                           resourceIBMPrivateDNSGLBPoolValidator(),
+				"ibm_schematics_action":                      resourceIBMSchematicsActionValidator(),
+				"ibm_schematics_job":                         resourceIBMSchematicsJobValidator(),
+				"ibm_schematics_workspace":                   resourceIBMSchematicsWorkspaceValidator(),
+				"ibm_resource_instance":                      resourceIBMResourceInstanceValidator(),
+				"ibm_is_virtual_endpoint_gateway":            resourceIBMISEndpointGatewayValidator(),
+				"ibm_container_vpc_cluster":                  resourceIBMContainerVpcClusterValidator(),
+				"ibm_container_vpc_worker_pool":              resourceIBMContainerVpcWorkerPoolValidator(),
+				"ibm_container_cluster":                      resourceIBMContainerClusterValidator(),
+				"ibm_container_worker_pool_autoscaling":      resourceIBMContainerWorkerPoolAutoscalingValidator(),
+				"ibm_resource_tag":                           resourceIBMResourceTagValidator(),
+				"ibm_satellite_location":                     resourceIBMSatelliteLocationValidator(),
+				"ibm_satellite_cluster":                      resourceIBMSatelliteClusterValidator(),
+				"ibm_pi_volume":                              resourceIBMPIVolumeValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*ResourceValidator{
-				"ibm_is_subnet":               dataSourceIBMISSubnetValidator(),
-				"ibm_is_snapshot":             dataSourceIBMISSnapshotValidator(),
-				"ibm_dl_offering_speeds":      datasourceIBMDLOfferingSpeedsValidator(),
-				"ibm_dl_routers":              datasourceIBMDLRoutersValidator(),
-				"ibm_is_vpc":                  dataSourceIBMISVpcValidator(),
-				"ibm_is_volume":               dataSourceIBMISVolumeValidator(),
-				"ibm_secrets_manager_secret":  datasourceIBMSecretsManagerSecretValidator(),
-				"ibm_secrets_manager_secrets": datasourceIBMSecretsManagerSecretsValidator(),
+				"ibm_is_subnet":                dataSourceIBMISSubnetValidator(),
+				"ibm_container_cluster_config": dataSourceIBMContainerClusterConfigValidator(),
+				"ibm_is_snapshot":              dataSourceIBMISSnapshotValidator(),
+				"ibm_dl_offering_speeds":       datasourceIBMDLOfferingSpeedsValidator(),
+				"ibm_dl_routers":               datasourceIBMDLRoutersValidator(),
+				"ibm_is_vpc":                   dataSourceIBMISVpcValidator(),
+				"ibm_is_volume":                dataSourceIBMISVolumeValidator(),
+				"ibm_secrets_manager_secret":   datasourceIBMSecretsManagerSecretValidator(),
+				"ibm_secrets_manager_secrets":  datasourceIBMSecretsManagerSecretsValidator(),
 			},
 		}
 	})
@@ -768,6 +871,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if rtoken, ok := d.GetOk("iam_refresh_token"); ok {
 		iamRefreshToken = rtoken.(string)
 	}
+	var iamProfileID, iamProfileName, crTokenFilename string
+	if id, ok := d.GetOk("iam_profile_id"); ok {
+		iamProfileID = id.(string)
+	}
+	if name, ok := d.GetOk("iam_profile_name"); ok {
+		iamProfileName = name.(string)
+	}
+	if filename, ok := d.GetOk("cr_token_filename"); ok {
+		crTokenFilename = filename.(string)
+	}
 	var softlayerUsername, softlayerAPIKey, softlayerEndpointUrl string
 	var softlayerTimeout int
 	if username, ok := d.GetOk("softlayer_username"); ok {
@@ -813,6 +926,15 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
+	endpoints, err := expandProviderEndpoints(d)
+	if err != nil {
+		return nil, err
+	}
+	retryPolicy, err := expandProviderRetryPolicy(d, retryCount)
+	if err != nil {
+		return nil, err
+	}
+
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
 		return nil, err
@@ -822,23 +944,33 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		os.Setenv("FUNCTION_NAMESPACE", wskNameSpace)
 	}
 
+	defaultTags, defaultAccessTags := expandProviderDefaultTags(d)
+
 	config := Config{
-		BluemixAPIKey:        bluemixAPIKey,
-		Region:               region,
-		ResourceGroup:        resourceGrp,
-		BluemixTimeout:       time.Duration(bluemixTimeout) * time.Second,
-		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
-		SoftLayerUserName:    softlayerUsername,
-		SoftLayerAPIKey:      softlayerAPIKey,
-		RetryCount:           retryCount,
-		SoftLayerEndpointURL: softlayerEndpointUrl,
-		RetryDelay:           RetryAPIDelay,
-		FunctionNameSpace:    wskNameSpace,
-		RiaasEndPoint:        riaasEndPoint,
-		IAMToken:             iamToken,
-		IAMRefreshToken:      iamRefreshToken,
-		Zone:                 zone,
-		Visibility:           visibility,
+		BluemixAPIKey:         bluemixAPIKey,
+		Region:                region,
+		ResourceGroup:         resourceGrp,
+		BluemixTimeout:        time.Duration(bluemixTimeout) * time.Second,
+		SoftLayerTimeout:      time.Duration(softlayerTimeout) * time.Second,
+		SoftLayerUserName:     softlayerUsername,
+		SoftLayerAPIKey:       softlayerAPIKey,
+		RetryCount:            retryCount,
+		SoftLayerEndpointURL:  softlayerEndpointUrl,
+		RetryDelay:            RetryAPIDelay,
+		RetryPolicy:           retryPolicy,
+		RateLimits:            expandProviderRateLimits(d),
+		DefaultTags:           defaultTags,
+		DefaultAccessTags:     defaultAccessTags,
+		FunctionNameSpace:     wskNameSpace,
+		RiaasEndPoint:         riaasEndPoint,
+		IAMToken:              iamToken,
+		IAMRefreshToken:       iamRefreshToken,
+		IAMTrustedProfileID:   iamProfileID,
+		IAMTrustedProfileName: iamProfileName,
+		CRTokenFilename:       crTokenFilename,
+		Zone:                  zone,
+		Visibility:            visibility,
+		Endpoints:             endpoints,
 		//PowerServiceInstance: powerServiceInstance,
 	}
 
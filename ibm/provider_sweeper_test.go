@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// sweepDryRun lists the resources the sweepers would delete, without deleting them
+var sweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources left behind by the acceptance tests without deleting them")
+
+// defaultSweepPrefixes are the name prefixes of the resources created by the acceptance tests of the swept
+// resource types. IBM_SWEEP_PREFIXES overrides them with a comma separated list.
+var defaultSweepPrefixes = []string{
+	"tf-vpc-", "terraformvpcuat-", "terraformvpcsg-",
+	"tf-subnet-", "tfsubnet-",
+	"tfsg-",
+	"tf-instnace-",
+	"tf-acctest-",
+	"terraform_",
+	"tf-pi-instance-",
+}
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForRegion configures the provider for the region from the environment of the acceptance tests
+func sharedClientForRegion(region string) (ClientSession, error) {
+	if os.Getenv("IC_API_KEY") == "" && os.Getenv("IBMCLOUD_API_KEY") == "" {
+		return nil, fmt.Errorf("IC_API_KEY must be set to run the sweepers")
+	}
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": region,
+	}))
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return nil, fmt.Errorf("Error configuring the provider for region %s: %s", region, d.Summary)
+		}
+	}
+	return p.Meta().(ClientSession), nil
+}
+
+// sweepPrefixes returns the name prefixes of the resources to sweep
+func sweepPrefixes() []string {
+	if v := os.Getenv("IBM_SWEEP_PREFIXES"); v != "" {
+		var prefixes []string
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		return prefixes
+	}
+	return defaultSweepPrefixes
+}
+
+// isSweepable tells whether a resource was created by the acceptance tests, from its name
+func isSweepable(name string) bool {
+	for _, prefix := range sweepPrefixes() {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sweepResource deletes a resource with the Delete function of its Terraform resource, so that the
+// sweepers wait for the deletion like terraform destroy does. In dry-run mode it only logs the resource.
+func sweepResource(r *schema.Resource, resourceType, id, name string, attributes map[string]interface{}, meta interface{}) error {
+	if *sweepDryRun {
+		log.Printf("[INFO] Would delete %s %s (%s)", resourceType, name, id)
+		return nil
+	}
+	log.Printf("[INFO] Deleting %s %s (%s)", resourceType, name, id)
+
+	d := r.Data(nil)
+	d.SetId(id)
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	if r.Delete != nil {
+		err := r.Delete(d, meta)
+		if err != nil {
+			return fmt.Errorf("Error deleting %s %s (%s): %s", resourceType, name, id, err)
+		}
+		return nil
+	}
+	if diags := r.DeleteContext(context.Background(), d, meta); diags.HasError() {
+		return fmt.Errorf("Error deleting %s %s (%s): %s", resourceType, name, id, diags[0].Summary)
+	}
+	return nil
+}
+
+// sweepErrors combines the errors of the resources which could not be swept
+func sweepErrors(resourceType string, errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Errorf("Error sweeping %d %s:\n%s", len(errs), resourceType, strings.Join(messages, "\n"))
+}
+
+func TestIsSweepable(t *testing.T) {
+	for _, name := range []string{"tf-vpc-42", "terraformvpcuat-42", "tfsubnet-42", "tfsg-createname-42", "tf-instnace-42", "terraform_42", "tf-pi-instance-42"} {
+		if !isSweepable(name) {
+			t.Fatalf("expected the test resource %s to be swept", name)
+		}
+	}
+	if isSweepable("production-vpc") {
+		t.Fatal("expected only the resources of the acceptance tests to be swept")
+	}
+	os.Setenv("IBM_SWEEP_PREFIXES", "tf-test-, tfsubnet-")
+	defer os.Unsetenv("IBM_SWEEP_PREFIXES")
+	if !isSweepable("tfsubnet-12") || isSweepable("tf-vpc-42") {
+		t.Fatal("expected IBM_SWEEP_PREFIXES to override the prefixes")
+	}
+}
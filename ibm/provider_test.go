@@ -57,6 +57,10 @@ var ISAddressPrefixCIDR string
 var instanceProfileName string
 var instanceProfileNameUpdate string
 var dedicatedHostProfileName string
+var isBareMetalServerProfileName string
+var isBareMetalServerImageID string
+var isVPNServerClientCaCRN string
+var isSnapshotCopySourceCRN string
 var dedicatedHostGroupID string
 var instanceDiskProfileName string
 var dedicatedHostGroupFamily string
@@ -93,6 +97,7 @@ var IsImageEncryptionKey string
 var isWinImage string
 var image_cos_url string
 var image_cos_url_encrypted string
+var isImageExportBucket string
 var image_operating_system string
 
 // Transit Gateway cross account
@@ -386,6 +391,30 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IS_DEDICATED_HOST_PROFILE for testing ibm_is_instance resource else it is set to default value 'bx2d-host-152x608'")
 	}
 
+	isBareMetalServerProfileName = os.Getenv("IS_BARE_METAL_SERVER_PROFILE")
+	if isBareMetalServerProfileName == "" {
+		isBareMetalServerProfileName = "bx2-metal-192x768" // for next gen infrastructure
+		fmt.Println("[INFO] Set the environment variable IS_BARE_METAL_SERVER_PROFILE for testing ibm_is_bare_metal_server resource else it is set to default value 'bx2-metal-192x768'")
+	}
+
+	isBareMetalServerImageID = os.Getenv("IS_BARE_METAL_SERVER_IMAGE")
+	if isBareMetalServerImageID == "" {
+		isBareMetalServerImageID = "r006-2d1f36b0-df65-4570-82eb-df7ae5f778b1" // for next gen infrastructure
+		fmt.Println("[INFO] Set the environment variable IS_BARE_METAL_SERVER_IMAGE for testing ibm_is_bare_metal_server resource else it is set to default value 'r006-2d1f36b0-df65-4570-82eb-df7ae5f778b1'")
+	}
+
+	isVPNServerClientCaCRN = os.Getenv("IS_VPN_SERVER_CLIENT_CA_CRN")
+	if isVPNServerClientCaCRN == "" {
+		isVPNServerClientCaCRN = certCRN
+		fmt.Println("[INFO] Set the environment variable IS_VPN_SERVER_CLIENT_CA_CRN for testing ibm_is_vpn_server resource else it is set to the value of IBM_CERT_CRN")
+	}
+
+	isSnapshotCopySourceCRN = os.Getenv("IS_SNAPSHOT_COPY_SOURCE_CRN")
+	if isSnapshotCopySourceCRN == "" {
+		isSnapshotCopySourceCRN = "crn:v1:bluemix:public:is:us-east:a/2d1bace7b46e4815a81e52c6ffeba5cf::snapshot:r014-ab8bd4a7-2a8d-4a1b-a1f4-4a3e3e7cc3f5" // for next gen infrastructure
+		fmt.Println("[INFO] Set the environment variable IS_SNAPSHOT_COPY_SOURCE_CRN for testing ibm_is_snapshot_copy resource else it is set to default value 'crn:v1:bluemix:public:is:us-east:a/2d1bace7b46e4815a81e52c6ffeba5cf::snapshot:r014-ab8bd4a7-2a8d-4a1b-a1f4-4a3e3e7cc3f5'")
+	}
+
 	dedicatedHostGroupClass = os.Getenv("IS_DEDICATED_HOST_GROUP_CLASS")
 	if dedicatedHostGroupClass == "" {
 		dedicatedHostGroupClass = "bx2d" // for next gen infrastructure
@@ -492,6 +521,12 @@ func init() {
 		image_cos_url_encrypted = "cos://us-south/cosbucket-vpc-image-gen2/rhel-guest-image-7.0-encrypted.qcow2"
 		fmt.Println("[WARN] Set the environment variable IMAGE_COS_URL_ENCRYPTED with a VALID COS Image SQL URL for testing ibm_is_image resources on staging/test")
 	}
+	isImageExportBucket = os.Getenv("IS_IMAGE_EXPORT_BUCKET")
+	if isImageExportBucket == "" {
+		isImageExportBucket = "cosbucket-vpc-image-gen2"
+		fmt.Println("[WARN] Set the environment variable IS_IMAGE_EXPORT_BUCKET with a COS bucket the VPC service can write to for testing ibm_is_image_export_job resources")
+	}
+
 	image_operating_system = os.Getenv("IMAGE_OPERATING_SYSTEM")
 	if image_operating_system == "" {
 		image_operating_system = "red-7-amd64"
@@ -579,7 +614,34 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+// testAccRecorder records or replays the API requests of the test in its cassette when IBM_TF_RECORD_MODE is set.
+// Tests without a cassette are skipped in replay mode, which provides placeholder credentials.
+func testAccRecorder(t *testing.T) {
+	switch recordMode() {
+	case "":
+		return
+	case recordModeReplay:
+		if _, err := os.Stat(cassettePath(t.Name())); err != nil {
+			t.Skipf("No cassette recorded for %s", t.Name())
+		}
+		for _, env := range []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, "replay")
+			}
+		}
+	}
+	if err := startRecording(t.Name()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := stopRecording(); err != nil {
+			t.Errorf("Error saving the cassette of %s: %s", t.Name(), err)
+		}
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
+	testAccRecorder(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -592,6 +654,7 @@ func testAccPreCheck(t *testing.T) {
 }
 
 func testAccPreCheckEnterprise(t *testing.T) {
+	testAccRecorder(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -599,6 +662,7 @@ func testAccPreCheckEnterprise(t *testing.T) {
 }
 
 func testAccPreCheckEnterpriseAccountImport(t *testing.T) {
+	testAccRecorder(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
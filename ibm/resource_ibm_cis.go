@@ -177,18 +177,18 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return fmt.Errorf("Error retrieving service offering: %w", err)
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return fmt.Errorf("Error retrieving plan: %w", err)
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return fmt.Errorf("Error retrieving deployment for plan %s : %w", plan, err)
 	}
 	if len(deployments) == 0 {
 		return fmt.Errorf("No deployment found for service plan : %s", plan)
@@ -222,7 +222,7 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating resource instance: %s %s", err, response)
+		return fmt.Errorf("Error creating resource instance: %w", newAPIError(err, response))
 	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
@@ -267,7 +267,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource instance: %s %s", err, response)
+		return fmt.Errorf("Error retrieving resource instance: %w", newAPIError(err, response))
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -302,7 +302,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return fmt.Errorf("Error retrieving plan: %w", err)
 	}
 	d.Set("plan", servicePlan)
 
@@ -348,12 +348,12 @@ func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return fmt.Errorf("Error retrieving service offering: %w", err)
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return fmt.Errorf("Error retrieving plan: %w", err)
 		}
 
 		updateReq.ResourcePlanID = &servicePlan
@@ -371,7 +371,7 @@ func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 	if err != nil {
-		return fmt.Errorf("Error updating resource instance: %s %s", err, response)
+		return fmt.Errorf("Error updating resource instance: %w", newAPIError(err, response))
 	}
 
 	_, err = waitForCISInstanceUpdate(d, meta)
@@ -404,7 +404,7 @@ func resourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n %s", err, response)
 			err = nil
 		} else {
-			return fmt.Errorf("Error deleting resource instance: %s %s", err, response)
+			return fmt.Errorf("Error deleting resource instance: %w", newAPIError(err, response))
 		}
 	}
 
@@ -435,7 +435,7 @@ func resourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s %s", err, response)
+		return false, fmt.Errorf("Error communicating with the API: %w", newAPIError(err, response))
 	}
 	if instance != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, cisInstanceReclamation)) {
 		log.Printf("[WARN] Removing instance from state because it's in removed or pending_reclamation state")
@@ -464,9 +464,9 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
+					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %w", d.Id(), newAPIError(err, response))
 				}
-				return nil, "", err
+				return nil, "", newAPIError(err, response)
 			}
 			if *instance.State == cisInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("The resource instance %s failed: %v %s", d.Id(), err, response)
@@ -499,9 +499,9 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
+					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %w", d.Id(), newAPIError(err, response))
 				}
-				return nil, "", err
+				return nil, "", newAPIError(err, response)
 			}
 			if *instance.State == cisInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("The resource instance %s failed: %v %s", d.Id(), err, response)
@@ -535,7 +535,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, cisInstanceSuccessStatus, nil
 				}
-				return nil, "", err
+				return nil, "", newAPIError(err, response)
 			}
 			if *instance.State == cisInstanceFailStatus {
 				return instance, *instance.State, fmt.Errorf("The resource instance %s failed to delete: %v %s", d.Id(), err, response)
@@ -199,7 +199,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateCacheLevel(opt)
 			if err != nil {
 				log.Printf("Update caching level failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 		// Serve Stale Content Setting
@@ -209,7 +209,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateServeStaleContent(opt)
 			if err != nil {
 				log.Printf("Update Serve Stale Content Setting failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 
@@ -220,7 +220,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateBrowserCacheTTL(opt)
 			if err != nil {
 				log.Printf("Update browser expiration setting failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 
@@ -231,7 +231,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateDevelopmentMode(opt)
 			if err != nil {
 				log.Printf("Update development mode setting failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 		// Query string sort setting
@@ -241,7 +241,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateQueryStringSort(opt)
 			if err != nil {
 				log.Printf("Update query string sort setting failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 
@@ -251,7 +251,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 				result, response, err := cisClient.PurgeAll(opt)
 				if err != nil {
 					log.Printf("Purge all failed : %v", response)
-					return err
+					return newAPIError(err, response)
 				}
 				log.Printf("Purge all successful : %s", *result.Result.ID)
 			}
@@ -263,7 +263,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, response, err := cisClient.PurgeByUrls(opt)
 			if err != nil {
 				log.Printf("Purge by urls failed : %v", response)
-				return err
+				return newAPIError(err, response)
 			}
 		}
 		if value, ok := d.GetOk(cisCachePurgeByCacheTags); ok {
@@ -273,7 +273,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			result, response, err := cisClient.PurgeByCacheTags(opt)
 			if err != nil {
 				log.Printf("Purge by cache tags failed : %v", response)
-				return err
+				return newAPIError(err, response)
 			}
 			log.Printf("Purge by tags successful : %s", *result.Result.ID)
 
@@ -285,7 +285,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			result, response, err := cisClient.PurgeByHosts(opt)
 			if err != nil {
 				log.Printf("Purge by hosts failed : %v", response)
-				return err
+				return newAPIError(err, response)
 			}
 			log.Printf("Purge by hosts successful : %s", *result.Result.ID)
 		}
@@ -307,14 +307,14 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	cacheLevel, resp, err := cisClient.GetCacheLevel(cisClient.NewGetCacheLevelOptions())
 	if err != nil {
 		log.Printf("Get caching leve setting failed : %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 
 	// Serve Stale Content setting
 	servestaleContent, resp, err := cisClient.GetServeStaleContent(cisClient.NewGetServeStaleContentOptions())
 	if err != nil {
 		log.Printf("Get Serve Stale Content setting failed : %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 
 	// Browser Expiration setting
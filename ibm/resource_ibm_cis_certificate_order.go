@@ -105,7 +105,7 @@ func resourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	result, resp, err := cisClient.OrderCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate order failed: %v", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
@@ -128,7 +128,7 @@ func resourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	result, resp, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate read failed: %v", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -155,7 +155,7 @@ func resourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface
 	resp, err := cisClient.DeleteCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate delete failed: %v", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 
 	_, err = waitForCISCertificateOrderDelete(d, meta)
@@ -186,7 +186,7 @@ func resourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{
 			return false, nil
 		}
 		log.Printf("Get Certificate failed: %v", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	return true, nil
 }
@@ -164,7 +164,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 	result, response, err := cisClient.UploadCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Upload custom certificate failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	certID := *result.Result.ID
 	d.SetId(convertCisToTfThreeVar(certID, zoneID, crn))
@@ -182,7 +182,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 		priorityResponse, err := cisClient.ChangeCertificatePriority(priorityOpt)
 		if err != nil {
 			log.Printf("Change certificate priority failed: %v", priorityResponse)
-			return err
+			return newAPIError(err, priorityResponse)
 		}
 	}
 
@@ -205,7 +205,7 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	result, response, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Get custom certificate failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -245,7 +245,7 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.UpdateCustomCertificate(opt)
 		if err != nil {
 			log.Printf("Update custom certificate failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 
@@ -136,7 +136,7 @@ func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error
 		result, response, err := cisClient.UpdateZoneCustomPage(opt)
 		if err != nil {
 			log.Printf("Update custom page failed : %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 		d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
@@ -162,7 +162,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get custom page failed : %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 
 	d.Set(cisID, crn)
@@ -411,7 +411,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 	result, response, err := sess.CreateDnsRecord(opt)
 	if err != nil {
 		log.Printf("Error creating dns record: %s, error %s", response, err)
-		return err
+		return newAPIError(err, response)
 	}
 
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
@@ -445,7 +445,7 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Error reading dns record: %s", response)
-		return err
+		return newAPIError(err, response)
 	}
 
 	d.Set(cisID, crn)
@@ -776,7 +776,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 		result, response, err := sess.UpdateDnsRecord(opt)
 		if err != nil {
 			log.Printf("Error updating dns record: %s, error %s", response, err)
-			return err
+			return newAPIError(err, response)
 		}
 		log.Printf("record id: %s", *result.Result.ID)
 	}
@@ -839,7 +839,7 @@ func resourceIBMCISDnsRecordExist(d *schema.ResourceData, meta interface{}) (boo
 			return false, nil
 		}
 		log.Printf("get DNS record failed")
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	return true, nil
 }
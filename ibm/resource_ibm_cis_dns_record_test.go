@@ -5,13 +5,22 @@ package ibm
 
 import (
 	"fmt"
+	"log"
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_cis_dns_record", &resource.Sweeper{
+		Name: "ibm_cis_dns_record",
+		F:    testSweepCisDNSRecords,
+	})
+}
+
 func TestAccIBMCisDNSRecord_Basic(t *testing.T) {
 	//t.Parallel()
 	var record string
@@ -431,3 +440,73 @@ func testAccCheckIBMCisDNSRecordConfigProxied(resourceID string, cisDomainStatic
 	  }
 `, resourceID)
 }
+
+func testSweepCisDNSRecords(region string) error {
+	if cisInstance == "" {
+		log.Printf("[INFO] Skipping ibm_cis_dns_record sweeper, IBM_CIS_INSTANCE is not set")
+		return nil
+	}
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	rsConClient, err := meta.ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	instances, rcResponse, err := rsConClient.ListResourceInstances(&rc.ListResourceInstancesOptions{
+		Name: &cisInstance,
+	})
+	if err != nil {
+		return fmt.Errorf("Error Fetching CIS instance %s: %s\n%s", cisInstance, err, rcResponse)
+	}
+	if len(instances.Resources) == 0 {
+		return fmt.Errorf("No CIS instance found with name %s", cisInstance)
+	}
+	crn := *instances.Resources[0].CRN
+
+	zonesClient, err := meta.CisZonesV1ClientSession()
+	if err != nil {
+		return err
+	}
+	zonesClient.Crn = core.StringPtr(crn)
+	zoneOpt := zonesClient.NewListZonesOptions()
+	zoneOpt.SetPage(1)
+	zoneOpt.SetPerPage(1000)
+	zones, response, err := zonesClient.ListZones(zoneOpt)
+	if err != nil {
+		return fmt.Errorf("Error Fetching CIS zones: %s\n%s", err, response)
+	}
+
+	sess, err := meta.CisDNSRecordClientSession()
+	if err != nil {
+		return err
+	}
+	sess.Crn = core.StringPtr(crn)
+	var errs []error
+	for _, zone := range zones.Result {
+		sess.ZoneIdentifier = zone.ID
+		for page := int64(1); ; page++ {
+			opt := sess.NewListAllDnsRecordsOptions()
+			opt.SetPage(page)
+			opt.SetPerPage(1000)
+			records, response, err := sess.ListAllDnsRecords(opt)
+			if err != nil {
+				return fmt.Errorf("Error Fetching DNS records of zone %s: %s\n%s", *zone.Name, err, response)
+			}
+			for _, record := range records.Result {
+				if !isSweepable(*record.Name) {
+					continue
+				}
+				id := convertCisToTfThreeVar(*record.ID, *zone.ID, crn)
+				if err := sweepResource(resourceIBMCISDnsRecord(), "ibm_cis_dns_record", id, *record.Name, nil, meta); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			if records.ResultInfo == nil || page**records.ResultInfo.PerPage >= *records.ResultInfo.TotalCount {
+				break
+			}
+		}
+	}
+	return sweepErrors("ibm_cis_dns_record", errs)
+}
@@ -80,7 +80,7 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 	result, response, err := cisClient.PostDnsRecordsBulk(opt)
 	if err != nil {
 		log.Printf("Error importing dns records: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	id := fmt.Sprintf("%v:%v:%s:%s:%s", *result.Result.TotalRecordsParsed,
 		*result.Result.RecsAdded, file, zoneID, crn)
@@ -78,7 +78,7 @@ func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateZone(opt)
 	if err != nil {
 		log.Printf("CreateZones Failed %s", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
 	return resourceCISdomainRead(d, meta)
@@ -99,7 +99,7 @@ func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.GetZone(opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, result.Result.ID)
@@ -132,7 +132,7 @@ func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, er
 			return false, nil
 		}
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return false, err
+		return false, newAPIError(err, resp)
 	}
 	return true, nil
 }
@@ -158,13 +158,13 @@ func resourceCISdomainDelete(d *schema.ResourceData, meta interface{}) error {
 	_, resp, err := cisClient.GetZone(opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	delOpt := cisClient.NewDeleteZoneOptions(zoneID)
 	_, resp, err = cisClient.DeleteZone(delOpt)
 	if err != nil {
 		log.Printf("[ERR] Error deleting zone %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	return nil
 }
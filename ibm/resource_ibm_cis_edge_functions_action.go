@@ -73,7 +73,7 @@ func resourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interf
 
 	_, _, err = cisClient.UpdateEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", err)
+		return fmt.Errorf("Error: %w", err)
 	}
 	d.SetId(convertCisToTfThreeVar(scriptName, zoneID, crn))
 	return resourceIBMCISEdgeFunctionsActionRead(d, meta)
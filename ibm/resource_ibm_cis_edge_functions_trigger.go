@@ -81,7 +81,7 @@ func resourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta inter
 
 	result, _, err := cisClient.CreateEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error creating edge function trigger route : %v", err)
+		return fmt.Errorf("Error creating edge function trigger route : %w", err)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	return resourceIBMCISEdgeFunctionsTriggerRead(d, meta)
@@ -109,7 +109,7 @@ func resourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta inter
 
 		_, _, err := cisClient.UpdateEdgeFunctionsTrigger(opt)
 		if err != nil {
-			return fmt.Errorf("Error updating edge function trigger route : %v", err)
+			return fmt.Errorf("Error updating edge function trigger route : %w", err)
 		}
 	}
 	return resourceIBMCISEdgeFunctionsTriggerRead(d, meta)
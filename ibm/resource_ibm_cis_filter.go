@@ -65,13 +65,13 @@ func resourceIBMCISFilter() *schema.Resource {
 func resourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return fmt.Errorf("Error while Getting IAM Access Token using BluemixSession %s", err)
+		return fmt.Errorf("Error while Getting IAM Access Token using BluemixSession %w", err)
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 
 	cisClient, err := meta.(ClientSession).CisFiltersSession()
 	if err != nil {
-		return fmt.Errorf("Error while getting the CisFiltersSession %s", err)
+		return fmt.Errorf("Error while getting the CisFiltersSession %w", err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -107,13 +107,13 @@ func resourceIBMCISFilterCreate(d *schema.ResourceData, meta interface{}) error
 func resourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return fmt.Errorf("Error while Getting IAM Access Token using BluemixSession %s", err)
+		return fmt.Errorf("Error while Getting IAM Access Token using BluemixSession %w", err)
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 
 	cisClient, err := meta.(ClientSession).CisFiltersSession()
 	if err != nil {
-		return fmt.Errorf("Error while getting the CisFiltersSession %s", err)
+		return fmt.Errorf("Error while getting the CisFiltersSession %w", err)
 	}
 	filterid, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
@@ -128,7 +128,7 @@ func resourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error finding GetFilter %q: %s %s", d.Id(), err, response)
+		return fmt.Errorf("Error finding GetFilter %q: %w", d.Id(), newAPIError(err, response))
 	}
 	if result.Result != nil {
 		d.Set(cisID, crn)
@@ -143,13 +143,13 @@ func resourceIBMCISFilterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return fmt.Errorf("Error while Getting IAM Access Token using BluemixSession %s", err)
+		return fmt.Errorf("Error while Getting IAM Access Token using BluemixSession %w", err)
 	}
 	xAuthtoken := sess.Config.IAMAccessToken
 
 	cisClient, err := meta.(ClientSession).CisFiltersSession()
 	if err != nil {
-		return fmt.Errorf("Error while getting the CisFiltersSession %s", err)
+		return fmt.Errorf("Error while getting the CisFiltersSession %w", err)
 	}
 
 	filterid, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -183,7 +183,7 @@ func resourceIBMCISFilterUpdate(d *schema.ResourceData, meta interface{}) error
 
 		result, resp, err := cisClient.UpdateFilters(opt)
 		if err != nil {
-			return fmt.Errorf("Error updating Filter for zone %q: %s %s", zoneID, err, resp)
+			return fmt.Errorf("Error updating Filter for zone %q: %w", zoneID, newAPIError(err, resp))
 		}
 
 		if *result.Result[0].ID == "" {
@@ -209,7 +209,7 @@ func resourceIBMCISFilterDelete(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewDeleteFiltersOptions(xAuthtoken, crn, zoneID, filterid)
 	_, _, err = cisClient.DeleteFilters(opt)
 	if err != nil {
-		return fmt.Errorf("Error deleting Filter: %s", err)
+		return fmt.Errorf("Error deleting Filter: %w", err)
 	}
 
 	return nil
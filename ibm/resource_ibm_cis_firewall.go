@@ -350,7 +350,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneLockdownRule(opt)
 		if err != nil {
 			log.Printf("Create zone firewall lockdown failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
@@ -388,7 +388,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Create zone firewall access rule failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
@@ -430,7 +430,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Create zone user agent rule failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 	}
@@ -456,7 +456,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		result, response, err := cisClient.GetLockdown(opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 		lockdownList := []interface{}{}
 		lockdown := map[string]interface{}{}
@@ -489,7 +489,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		result, response, err := cisClient.GetZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 
 		config := map[string]interface{}{}
@@ -520,7 +520,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		result, response, err := cisClient.GetUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Get zone user agent rule failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 
 		config := map[string]interface{}{}
@@ -587,7 +587,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateLockdownRule(opt)
 			if err != nil {
 				log.Printf("Update zone firewall lockdown failed: %v", response)
-				return err
+				return newAPIError(err, response)
 			}
 
 		} else if firewallType == cisFirewallTypeAccessRules {
@@ -612,7 +612,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateZoneAccessRule(opt)
 			if err != nil {
 				log.Printf("Update zone firewall access rule failed: %v", response)
-				return err
+				return newAPIError(err, response)
 			}
 
 		} else if firewallType == cisFirewallTypeUARules {
@@ -650,7 +650,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateUserAgentRule(opt)
 			if err != nil {
 				log.Printf("Update zone user agent rule failed: %v", response)
-				return err
+				return newAPIError(err, response)
 			}
 		}
 
@@ -676,7 +676,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneLockdownRule(opt)
 		if err != nil {
 			log.Printf("Delete zone firewall lockdown failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 
 	} else if firewallType == cisFirewallTypeAccessRules {
@@ -694,7 +694,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Delete zone firewall access rule failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 
 	} else if firewallType == cisFirewallTypeUARules {
@@ -710,7 +710,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Delete zone user agent rule failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 
@@ -738,7 +738,7 @@ func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 				return false, nil
 			}
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return false, err
+			return false, newAPIError(err, response)
 		}
 
 	} else if firewallType == cisFirewallTypeAccessRules {
@@ -760,7 +760,7 @@ func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 				return false, nil
 			}
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return false, err
+			return false, newAPIError(err, response)
 		}
 
 	} else if firewallType == cisFirewallTypeUARules {
@@ -780,7 +780,7 @@ func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}
 				return false, nil
 			}
 			log.Printf("Get zone user agent rule failed: %v", response)
-			return false, err
+			return false, newAPIError(err, response)
 		}
 
 	}
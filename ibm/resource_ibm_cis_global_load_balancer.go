@@ -211,7 +211,7 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateLoadBalancer(opt)
 	if err != nil {
 		log.Printf("Create GLB failed %s\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	return resourceCISGlbUpdate(d, meta)
@@ -237,7 +237,7 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.GetLoadBalancerSettings(opt)
 	if err != nil {
 		log.Printf("[WARN] GLB Read failed: %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	glbObj := result.Result
 	d.Set(cisID, crn)
@@ -318,7 +318,7 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, resp, err := cisClient.EditLoadBalancer(opt)
 		if err != nil {
 			log.Printf("[WARN] Error updating GLB %v\n", resp)
-			return err
+			return newAPIError(err, resp)
 		}
 	}
 
@@ -342,7 +342,7 @@ func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.DeleteLoadBalancer(opt)
 	if err != nil {
 		log.Printf("[WARN] Error deleting GLB %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	log.Printf("Deletion successful : %s", *result.Result.ID)
 	return nil
@@ -369,7 +369,7 @@ func resourceCISGlbExists(d *schema.ResourceData, meta interface{}) (bool, error
 			return false, nil
 		}
 		log.Printf("[WARN] Error getting GLB %v\n", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	return true, nil
 }
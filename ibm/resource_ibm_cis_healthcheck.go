@@ -272,7 +272,7 @@ func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := sess.CreateLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("create global load balancer health check failed %s", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	log.Printf("global load balancer created successfully : %s", *result.Result.ID)
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
@@ -296,7 +296,7 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 	result, resp, err := sess.GetLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("Error reading global load balancer health check detail: %s", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	d.Set(cisGLBHealthCheckID, result.Result.ID)
 	d.Set(cisID, crn)
@@ -389,7 +389,7 @@ func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) erro
 		result, resp, err := sess.EditLoadBalancerMonitor(opt)
 		if err != nil {
 			log.Printf("Error updating global load balancer health check detail: %s", resp)
-			return err
+			return newAPIError(err, resp)
 		}
 		log.Printf("Monitor update succesful : %s", *result.Result.ID)
 	}
@@ -414,7 +414,7 @@ func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := sess.DeleteLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("Error deleting global load balancer health check detail: %s", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	log.Printf("Monitor ID: %s", *result.Result.ID)
 	return nil
@@ -441,7 +441,7 @@ func resourceCISHealthCheckExists(d *schema.ResourceData, meta interface{}) (boo
 			return false, nil
 		}
 		log.Printf("Error : %s", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	log.Printf("global load balancer health check exists: %s", *result.Result.ID)
 	return true, nil
@@ -208,7 +208,7 @@ func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	//Set unique TF Id from concatenated CIS Ids
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
@@ -229,7 +229,7 @@ func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.GetLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 
 	poolObj := *result.Result
@@ -318,7 +318,7 @@ func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, resp, err := cisClient.EditLoadBalancerPool(opt)
 		if err != nil {
 			log.Printf("[WARN] Error getting zone during PoolUpdate %v\n", resp)
-			return err
+			return newAPIError(err, resp)
 		}
 	}
 	return resourceCISPoolRead(d, meta)
@@ -338,7 +338,7 @@ func resourceCISPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.DeleteLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Delete GLB Pools failed %s\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 	log.Printf("Pool %s deleted successfully.", *result.Result.ID)
 	return nil
@@ -362,7 +362,7 @@ func resourceCISPoolExists(d *schema.ResourceData, meta interface{}) (bool, erro
 			return false, nil
 		}
 		log.Printf("Error : %s", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	log.Printf("global load balancer pool exist : %s", *result.Result.ID)
 	return true, nil
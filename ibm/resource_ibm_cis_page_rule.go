@@ -199,7 +199,7 @@ func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.CreatePageRule(opt)
 	if err != nil {
 		log.Printf("Create page rule failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	return resourceCISPageRuleRead(d, meta)
@@ -221,7 +221,7 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.GetPageRule(opt)
 	if err != nil {
 		log.Printf("Get page rule failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -263,7 +263,7 @@ func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, response, err := cisClient.UpdatePageRule(opt)
 		if err != nil {
 			log.Printf("Update page rule failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 	return resourceCISPageRuleRead(d, meta)
@@ -282,7 +282,7 @@ func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	_, response, err := cisClient.DeletePageRule(opt)
 	if err != nil {
 		log.Printf("Delete page rule failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	return nil
 }
@@ -305,7 +305,7 @@ func resourceCISPageRuleExists(d *schema.ResourceData, meta interface{}) (bool,
 			return false, nil
 		}
 		log.Printf("Get page rule failed: %v", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	return true, nil
 }
@@ -407,7 +407,7 @@ func resourceIBMCISRangeAppExists(d *schema.ResourceData, meta interface{}) (boo
 			log.Println("range application is not found")
 			return false, nil
 		}
-		return false, fmt.Errorf("Failed to getting existing range application: %v", err)
+		return false, fmt.Errorf("Failed to getting existing range application: %w", newAPIError(err, resp))
 	}
 	return true, nil
 }
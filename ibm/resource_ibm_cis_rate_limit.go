@@ -372,13 +372,13 @@ func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	action, err := expandRateLimitAction(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting action from expandRateLimitAction %s", err)
+		return fmt.Errorf("Error in getting action from expandRateLimitAction %w", err)
 	}
 	opt.SetAction(action)
 
 	match, err := expandRateLimitMatch(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err)
+		return fmt.Errorf("Error in getting match from expandRateLimitMatch %w", err)
 	}
 	opt.SetMatch(match)
 
@@ -389,7 +389,7 @@ func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	byPass, err := expandRateLimitBypass(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err)
+		return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %w", err)
 	}
 	opt.SetBypass(byPass)
 
@@ -469,13 +469,13 @@ func resourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 
 		action, err := expandRateLimitAction(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting action from expandRateLimitAction %s", err)
+			return fmt.Errorf("Error in getting action from expandRateLimitAction %w", err)
 		}
 		opt.SetAction(action)
 
 		match, err := expandRateLimitMatch(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err)
+			return fmt.Errorf("Error in getting match from expandRateLimitMatch %w", err)
 		}
 		opt.SetMatch(match)
 
@@ -486,7 +486,7 @@ func resourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 
 		byPass, err := expandRateLimitBypass(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err)
+			return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %w", err)
 		}
 		opt.SetBypass(byPass)
 		_, resp, err := cisClient.UpdateRateLimit(opt)
@@ -530,7 +530,7 @@ func resourceIBMCISRateLimitExists(d *schema.ResourceData, meta interface{}) (bo
 			log.Println("ratelimit is not found")
 			return false, nil
 		}
-		return false, fmt.Errorf("Failed to getting existing RateLimit: %v", err)
+		return false, fmt.Errorf("Failed to getting existing RateLimit: %w", newAPIError(err, resp))
 	}
 	return true, nil
 }
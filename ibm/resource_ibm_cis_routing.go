@@ -79,7 +79,7 @@ func resourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := cisClient.UpdateSmartRouting(opt)
 		if err != nil {
 			log.Printf("Update smart route setting failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 
@@ -99,7 +99,7 @@ func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.GetSmartRouting(opt)
 	if err != nil {
 		log.Printf("Get smart route setting failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -107,7 +107,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			_, resp, err := cisClient.ChangeTls13Setting(opt)
 			if err != nil {
 				log.Printf("Update TLS 1.3 setting Failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 
@@ -118,7 +118,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			resp, err := cisClient.ChangeUniversalCertificateSetting(opt)
 			if err != nil {
 				log.Printf("Update universal ssl setting Failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 
@@ -135,7 +135,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			_, resp, err := cisClient.UpdateMinTlsVersion(opt)
 			if err != nil {
 				log.Printf("Update minimum TLS version setting Failed : %v\n", resp)
-				return err
+				return newAPIError(err, resp)
 			}
 		}
 	}
@@ -156,7 +156,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	tls13Result, resp, err := cisClient.GetTls13Setting(cisClient.NewGetTls13SettingOptions())
 	if err != nil {
 		log.Printf("Get TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return newAPIError(err, resp)
 	}
 
 	// Universal SSL setting
@@ -119,7 +119,7 @@ func resourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 		_, response, err := cisClient.UpdateWafRuleGroup(opt)
 		if err != nil {
 			log.Printf("Update waf rule group mode failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 	d.SetId(convertCisToTfFourVar(groupID, packageID, zoneID, crn))
@@ -143,7 +143,7 @@ func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Get waf rule group setting failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
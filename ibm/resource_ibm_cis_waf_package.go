@@ -127,7 +127,7 @@ func resourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) er
 		result, response, err := cisClient.UpdateWafPackage(opt)
 		if err != nil {
 			log.Printf("Update waf package setting failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 		d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
@@ -152,7 +152,7 @@ func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 		log.Printf("Get waf package setting failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
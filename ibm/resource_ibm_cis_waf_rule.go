@@ -139,7 +139,7 @@ func resourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 		getResult, getResponse, err := cisClient.GetWafRule(getOpt)
 		if err != nil {
 			log.Printf("Get WAF rule setting failed: %v", getResponse)
-			return err
+			return newAPIError(err, getResponse)
 		}
 		getMode := *getResult.Result.Mode
 		updateOpt := cisClient.NewUpdateWafRuleOptions(packageID, ruleID)
@@ -159,7 +159,7 @@ func resourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := cisClient.UpdateWafRule(updateOpt)
 		if err != nil {
 			log.Printf("Update WAF rule setting failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 
@@ -184,7 +184,7 @@ func resourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get waf rule setting failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	groups := []interface{}{}
 	group := map[string]interface{}{}
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -62,12 +64,12 @@ const (
 
 func resourceIBMComputeVmInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeVmInstanceCreate,
-		Read:     resourceIBMComputeVmInstanceRead,
-		Update:   resourceIBMComputeVmInstanceUpdate,
-		Delete:   resourceIBMComputeVmInstanceDelete,
-		Exists:   resourceIBMComputeVmInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeVmInstanceCreate,
+		ReadContext:   resourceIBMComputeVmInstanceRead,
+		UpdateContext: resourceIBMComputeVmInstanceUpdate,
+		DeleteContext: resourceIBMComputeVmInstanceDelete,
+		Exists:        resourceIBMComputeVmInstanceExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -927,9 +929,9 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	return vms, nil
 }
 
-func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	sess := meta.(ClientSession).SoftLayerSession()
+	sess := softLayerSessionWithContext(context, meta)
 	service := services.GetVirtualGuestService(sess)
 
 	var id int
@@ -951,11 +953,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if dcName == "" && len(retryOptions) == 0 {
-		return fmt.Errorf("Provide either `datacenter` or `datacenter_choice`")
+		return diagFromErr(context, errorAt("datacenter", fmt.Errorf("Provide either `datacenter` or `datacenter_choice`")))
 	}
 
 	if (d.Get("hostname").(string) == "" || d.Get("domain").(string) == "") && len(d.Get("bulk_vms").(*schema.Set).List()) == 0 {
-		return fmt.Errorf("Provide either `hostname` and `domain` or `bulk_vms`")
+		return diagFromErr(context, errorAt("hostname", fmt.Errorf("Provide either `hostname` and `domain` or `bulk_vms`")))
 	}
 
 	if dcName != "" {
@@ -970,16 +972,16 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 
 		}
 
-		receipt, err1 = placeOrder(d, meta, dcName, publicVlan, privateVlan, quote_id)
+		receipt, err1 = placeOrder(context, d, meta, dcName, publicVlan, privateVlan, quote_id)
 	} else if len(retryOptions) > 0 {
 
 		err := validateDatacenterOption(retryOptions, []string{"datacenter", "public_vlan_id", "private_vlan_id"})
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		for _, option := range retryOptions {
 			if option == nil {
-				return fmt.Errorf("Provide a valid `datacenter_choice`")
+				return diagFromErr(context, errorAt("datacenter_choice", fmt.Errorf("Provide a valid `datacenter_choice`")))
 			}
 			center := option.(map[string]interface{})
 			var publicVlan, privateVlan int
@@ -988,7 +990,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok := center["datacenter"]; ok {
 				name = v.(string)
 			} else {
-				return fmt.Errorf("Missing datacenter in `datacenter_choice`")
+				return diagFromErr(context, errorAt("datacenter_choice", fmt.Errorf("Missing datacenter in `datacenter_choice`")))
 			}
 
 			if v, ok := center["public_vlan_id"]; ok {
@@ -998,7 +1000,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 				privateVlan, _ = strconv.Atoi(v.(string))
 			}
 
-			receipt, err1 = placeOrder(d, meta, name, publicVlan, privateVlan, quote_id)
+			receipt, err1 = placeOrder(context, d, meta, name, publicVlan, privateVlan, quote_id)
 			if err1 == nil {
 				break
 
@@ -1007,7 +1009,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err1 != nil {
-		return fmt.Errorf("Error ordering virtual guest: %s", err1)
+		return diagFromErr(context, fmt.Errorf("Error ordering virtual guest: %s", err1))
 	}
 
 	var idStrings []string
@@ -1030,15 +1032,15 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	for _, str := range idStrings {
 		id, err = strconv.Atoi(str)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		// Set tags
 		tags := getTags(d)
 		if tags != "" {
 			//Try setting only when it is non empty as we are creating virtual guest
-			err = setGuestTags(id, tags, meta)
+			err = setGuestTags(context, id, tags, meta)
 			if err != nil {
-				return err
+				return diagFromErr(context, err)
 			}
 		}
 
@@ -1053,38 +1055,38 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 		if len(storageIds) > 0 {
 			err := addAccessToStorageList(service.Id(id), id, storageIds, meta)
 			if err != nil {
-				return err
+				return diagFromErr(context, err)
 			}
 		}
 
 		// Set notes
-		err = setNotes(id, d, meta)
+		err = setNotes(context, id, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 
 		// wait for machine availability
 
-		_, err = WaitForVirtualGuestAvailable(id, d, meta)
+		_, err = WaitForVirtualGuestAvailable(context, id, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(context, d, meta)
 }
 
-func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
+func resourceIBMComputeVmInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	service := services.GetVirtualGuestService(softLayerSessionWithContext(context, meta))
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(context, fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -1106,7 +1108,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving virtual guest: %s", err))
 	}
 
 	if len(parts) == 1 {
@@ -1117,7 +1119,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		for _, part := range parts {
 			vmId, err := strconv.Atoi(part)
 			if err != nil {
-				return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+				return diagFromErr(context, fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 			}
 			vmResult, err := service.Id(vmId).Mask(
 				"hostname,domain",
@@ -1304,7 +1306,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set(ResourceName, *result.Hostname)
 	d.Set(ResourceStatus, *result.Status.Name)
 	err = readSecondaryIPAddresses(d, meta, result.PrimaryIpAddress)
-	return err
+	return diagFromErr(context, err)
 }
 
 func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryIPAddress *string) error {
@@ -1334,23 +1336,23 @@ func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryI
 	}
 	return nil
 }
-func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	sess := meta.(ClientSession).SoftLayerSession()
+	sess := softLayerSessionWithContext(context, meta)
 	service := services.GetVirtualGuestService(sess)
 
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(context, fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving virtual guest: %s", err))
 	}
 
 	isChanged := false
@@ -1371,22 +1373,22 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if isChanged {
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("Couldn't update virtual guest: %s", err)
+			return diagFromErr(context, fmt.Errorf("Couldn't update virtual guest: %s", err))
 		}
 	}
 
 	// Update tags
 	if d.HasChange("tags") {
 		tags := getTags(d)
-		err := setGuestTags(id, tags, meta)
+		err := setGuestTags(context, id, tags, meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 
 	err = modifyStorageAccess(service.Id(id), id, meta, d)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	// Upgrade "cores", "memory" and "network_speed" if provided and changed
@@ -1414,7 +1416,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 		//Remove is not supported for now.
 		if len(oldDisk) > len(newDisk) {
-			return fmt.Errorf("Removing drives is not supported.")
+			return diagFromErr(context, fmt.Errorf("Removing drives is not supported."))
 		}
 
 		var diskName string
@@ -1450,30 +1452,30 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 			presetKeyName := d.Get("flavor_key_name").(string)
 			_, err = virtual.UpgradeVirtualGuestWithPreset(sess.SetRetries(0), &result, presetKeyName, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
+				return diagFromErr(context, fmt.Errorf("Couldn't upgrade virtual guest: %s", err))
 			}
 
 		} else {
 			_, err = virtual.UpgradeVirtualGuest(sess.SetRetries(0), &result, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
+				return diagFromErr(context, fmt.Errorf("Couldn't upgrade virtual guest: %s", err))
 			}
 		}
 
 		// Wait for softlayer to start upgrading...
-		_, err = WaitForUpgradeTransactionsToAppear(d, meta)
+		_, err = WaitForUpgradeTransactionsToAppear(context, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		// Wait for upgrade transactions to finish
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutUpdate), meta)
+		_, err = WaitForNoActiveTransactions(context, id, d, d.Timeout(schema.TimeoutUpdate), meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(context, d, meta)
 }
 
 func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface{}, d *schema.ResourceData) error {
@@ -1510,44 +1512,44 @@ func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface
 	return nil
 }
 
-func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	sess := meta.(ClientSession).SoftLayerSession()
+func resourceIBMComputeVmInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess := softLayerSessionWithContext(context, meta)
 	service := services.GetVirtualGuestService(sess)
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	for _, part := range parts {
 		id, err := strconv.Atoi(part)
 		if err != nil {
-			return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+			return diagFromErr(context, fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 		}
 
-		_, err = WaitForNoActiveTransactions(id, d, d.Timeout(schema.TimeoutDelete), meta)
+		_, err = WaitForNoActiveTransactions(context, id, d, d.Timeout(schema.TimeoutDelete), meta)
 
 		if err != nil {
-			return fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
+			return diagFromErr(context, fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err))
 		}
-		err = detachSecurityGroupNetworkComponentBindings(d, meta, id)
+		err = detachSecurityGroupNetworkComponentBindings(context, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		ok, err := service.Id(id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("Error deleting virtual guest: %s", err)
+			return diagFromErr(context, fmt.Errorf("Error deleting virtual guest: %s", err))
 		}
 
 		if !ok {
-			return fmt.Errorf(
-				"API reported it was unsuccessful in removing the virtual guest '%d'", id)
+			return diagFromErr(context, fmt.Errorf(
+				"API reported it was unsuccessful in removing the virtual guest '%d'", id))
 		}
 	}
 
 	return nil
 }
 
-func detachSecurityGroupNetworkComponentBindings(d *schema.ResourceData, meta interface{}, id int) error {
-	sess := meta.(ClientSession).SoftLayerSession()
+func detachSecurityGroupNetworkComponentBindings(ctx context.Context, d *schema.ResourceData, meta interface{}, id int) error {
+	sess := softLayerSessionWithContext(ctx, meta)
 	service := services.GetVirtualGuestService(sess)
 	publicSgIDs := d.Get("public_security_group_ids").(*schema.Set).List()
 	privateSgIDS := d.Get("private_security_group_ids").(*schema.Set).List()
@@ -1612,7 +1614,7 @@ func genID() (interface{}, error) {
 }
 
 // WaitForUpgradeTransactionsToAppear Wait for upgrade transactions
-func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForUpgradeTransactionsToAppear(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have upgrade transactions", d.Id())
 
 	parts, err := vmIdParts(d.Id())
@@ -1628,7 +1630,7 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 		Pending: []string{"retry", pendingUpgrade},
 		Target:  []string{inProgressUpgrade},
 		Refresh: func() (interface{}, string, error) {
-			service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
+			service := services.GetVirtualGuestService(softLayerSessionWithContext(ctx, meta))
 			transactions, err := service.Id(id).GetActiveTransactions()
 			if err != nil {
 				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
@@ -1648,17 +1650,17 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForNoActiveTransactions Wait for no active transactions
-func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Duration, meta interface{}) (interface{}, error) {
+func WaitForNoActiveTransactions(ctx context.Context, id int, d *schema.ResourceData, timeout time.Duration, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have zero active transactions", d.Id())
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry", activeTransaction},
 		Target:  []string{idleTransaction},
 		Refresh: func() (interface{}, string, error) {
-			service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
+			service := services.GetVirtualGuestService(softLayerSessionWithContext(ctx, meta))
 			transactions, err := service.Id(id).GetActiveTransactions()
 			if err != nil {
 				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
@@ -1676,13 +1678,13 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForVirtualGuestAvailable(ctx context.Context, id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to be available.", d.Id())
-	sess := meta.(ClientSession).SoftLayerSession()
+	sess := softLayerSessionWithContext(ctx, meta)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", virtualGuestProvisioning},
 		Target:     []string{virtualGuestAvailable},
@@ -1692,7 +1694,7 @@ func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -1782,8 +1784,8 @@ func getTags(d dataRetriever) string {
 	return strings.Join(tags, ",")
 }
 
-func setGuestTags(id int, tags string, meta interface{}) error {
-	service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
+func setGuestTags(ctx context.Context, id int, tags string, meta interface{}) error {
+	service := services.GetVirtualGuestService(softLayerSessionWithContext(ctx, meta))
 	_, err := service.Id(id).SetTags(sl.String(tags))
 	if err != nil {
 		return fmt.Errorf("Could not set tags on virtual guest %d", id)
@@ -1838,8 +1840,8 @@ func removeAccessToStorageList(sam storageAccessModifier, deviceID int, ids stor
 	return nil
 }
 
-func setNotes(id int, d *schema.ResourceData, meta interface{}) error {
-	sess := meta.(ClientSession).SoftLayerSession()
+func setNotes(ctx context.Context, id int, d *schema.ResourceData, meta interface{}) error {
+	sess := softLayerSessionWithContext(ctx, meta)
 	service := services.GetVirtualGuestService(sess)
 
 	if notes := d.Get("notes").(string); notes != "" {
@@ -1859,8 +1861,8 @@ func setNotes(id int, d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func placeOrder(d *schema.ResourceData, meta interface{}, name string, publicVlanID, privateVlanID, quote_id int) (datatypes.Container_Product_Order_Receipt, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
+func placeOrder(ctx context.Context, d *schema.ResourceData, meta interface{}, name string, publicVlanID, privateVlanID, quote_id int) (datatypes.Container_Product_Order_Receipt, error) {
+	sess := softLayerSessionWithContext(ctx, meta)
 	service := services.GetVirtualGuestService(sess)
 
 	options, err := getVirtualGuestTemplateFromResourceData(d, meta, name, publicVlanID, privateVlanID, quote_id)
@@ -2085,3 +2087,10 @@ func placeOrder(d *schema.ResourceData, meta interface{}, name string, publicVla
 	return receipt, err1
 
 }
+
+// softLayerSessionWithContext returns a copy of the SoftLayer session whose requests are cancelled with ctx
+func softLayerSessionWithContext(ctx context.Context, meta interface{}) *session.Session {
+	sess := *meta.(ClientSession).SoftLayerSession()
+	sess.Context = ctx
+	return &sess
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -49,12 +50,12 @@ const PUBLIC_SUBNET_TYPE = "public"
 
 func resourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterCreate,
-		Read:     resourceIBMContainerClusterRead,
-		Update:   resourceIBMContainerClusterUpdate,
-		Delete:   resourceIBMContainerClusterDelete,
-		Exists:   resourceIBMContainerClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterCreate,
+		ReadContext:   resourceIBMContainerClusterRead,
+		UpdateContext: resourceIBMContainerClusterUpdate,
+		DeleteContext: resourceIBMContainerClusterDelete,
+		Exists:        resourceIBMContainerClusterExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -595,11 +596,11 @@ func resourceIBMContainerClusterValidator() *ResourceValidator {
 	return &ibmContainerClusterResourceValidator
 }
 
-func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	name := d.Get("name").(string)
@@ -648,10 +649,10 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 				params.PrivateEndpointEnabled = v.(bool)
 				params.GatewayEnabled = gatewayEnabled
 			} else {
-				return fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters")
+				return diagFromErr(context, fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters"))
 			}
 		} else {
-			return fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters")
+			return diagFromErr(context, fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters"))
 		}
 	}
 	if v, ok := d.GetOk("kube_version"); ok {
@@ -666,34 +667,34 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	d.SetId(cls.ID)
 
-	_, err = waitForClusterMasterAvailable(d, meta)
+	_, err = waitForClusterMasterAvailable(context, d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	if d.Get("wait_till").(string) == oneWorkerNodeReady {
-		_, err = waitForClusterOneWorkerAvailable(d, meta)
+		_, err = waitForClusterOneWorkerAvailable(context, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 	d.Set("force_delete_storage", d.Get("force_delete_storage").(bool))
 
-	return resourceIBMContainerClusterUpdate(d, meta)
+	return resourceIBMContainerClusterUpdate(context, d, meta)
 }
 
-func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	wrkAPI := csClient.Workers()
 	workerPoolsAPI := csClient.WorkerPools()
@@ -701,18 +702,18 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving armada cluster: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving armada cluster: %s", err))
 	}
 
 	workerFields, err := wrkAPI.List(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving workers for cluster: %s", err))
 	}
 	workerCount := 0
 	workers := []map[string]string{}
@@ -732,7 +733,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	var poolName string
 	var poolContains bool
@@ -747,14 +748,14 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	if poolContains {
 		workersByPool, err := wrkAPI.ListByWorkerPool(clusterID, poolName, false, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+			return diagFromErr(context, fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err))
 		}
 
 		// to get the private and public vlan IDs of the gateway enabled cluster.
 		if poolName == computeWorkerPool {
 			gatewayWorkersByPool, err := wrkAPI.ListByWorkerPool(clusterID, gatewayWorkerpool, false, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+				return diagFromErr(context, fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err))
 			}
 			d.Set("public_vlan_id", gatewayWorkersByPool[0].PublicVlan)
 			d.Set("private_vlan_id", gatewayWorkersByPool[0].PrivateVlan)
@@ -787,7 +788,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 		defaultWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterID, poolName, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		d.Set("labels", IgnoreSystemLabels(defaultWorkerPool.Labels))
 		zones := defaultWorkerPool.Zones
@@ -803,7 +804,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	albs, err := albsAPI.ListClusterALBs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") && !strings.Contains(err.Error(), "The specified cluster is a free cluster.") {
 
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -835,7 +836,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("tags", tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(ResourceName, cls.Name)
@@ -845,16 +846,16 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	subnetAPI := csClient.Subnets()
@@ -878,12 +879,12 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diagFromErr(context, errorAt("kube_version", err))
 			}
-			_, err = WaitForClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForClusterVersionUpdate(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 		// "update_all_workers" deafult is false, enable to true when all worker nodes to be updated
@@ -892,7 +893,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diagFromErr(context, fmt.Errorf("Error retrieving workers for cluster: %s", err))
 			}
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
@@ -909,14 +910,14 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 					err = wrkAPI.Update(clusterID, w.ID, params, targetEnv)
 					if err != nil {
 						d.Set("patch_version", nil)
-						return fmt.Errorf("Error updating worker %s: %s", w.ID, err)
+						return diagFromErr(context, fmt.Errorf("Error updating worker %s: %s", w.ID, err))
 					}
 					if waitForWorkerUpdate {
-						_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+						_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 						if err != nil {
 							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+							return diagFromErr(context, fmt.Errorf(
+								"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 						}
 					}
 				}
@@ -955,7 +956,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 
@@ -971,7 +972,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		var poolName string
 		var poolContains bool
@@ -988,18 +989,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			poolSize := d.Get("default_pool_size").(int)
 			err = workerPoolsAPI.ResizeWorkerPool(clusterID, poolName, poolSize, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error updating the default_pool_size %d: %s", poolSize, err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error updating the default_pool_size %d: %s", poolSize, err))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 			}
 		} else {
-			return fmt.Errorf(
-				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes..")
+			return diagFromErr(context, fmt.Errorf(
+				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes.."))
 		}
 	}
 
@@ -1007,7 +1008,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		var poolName string
 		var poolContains bool
@@ -1028,18 +1029,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterID, poolName, labels, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error updating the labels %s", err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error updating the labels %s", err))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 			}
 		} else {
-			return fmt.Errorf(
-				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes..")
+			return diagFromErr(context, fmt.Errorf(
+				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes.."))
 		}
 	}
 
@@ -1071,21 +1072,21 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			count := oldCount - newCount
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diagFromErr(context, fmt.Errorf("Error retrieving workers for cluster: %s", err))
 			}
 			for i := 0; i < count; i++ {
 				err := wrkAPI.Delete(clusterID, workerFields[i].ID, targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error deleting workers of cluster (%s): %s", d.Id(), err)
+					return diagFromErr(context, fmt.Errorf(
+						"Error deleting workers of cluster (%s): %s", d.Id(), err))
 				}
 			}
 		}
 
-		_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+		_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
@@ -1100,23 +1101,23 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 				if strings.Compare(newPack["version"].(string), oldPack["version"].(string)) != 0 {
 					cluster, err := clusterAPI.Find(clusterID, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+						return diagFromErr(context, fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err))
 					}
 					if newPack["version"].(string) != strings.Split(cluster.MasterKubeVersion, "_")[0] {
-						return fmt.Errorf("Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0])
+						return diagFromErr(context, errorAt("workers_info", fmt.Errorf("Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0])))
 					}
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}
 					err = wrkAPI.Update(clusterID, oldPack["id"].(string), params, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error updating worker %s: %s", oldPack["id"].(string), err)
+						return diagFromErr(context, fmt.Errorf("Error updating worker %s: %s", oldPack["id"].(string), err))
 					}
 
-					_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+					_, err = WaitForWorkerAvailable(context, d, meta, targetEnv)
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+						return diagFromErr(context, fmt.Errorf(
+							"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 					}
 				}
 			}
@@ -1162,14 +1163,14 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		newSubnet := newSubnets.(*schema.Set)
 		rem := oldSubnet.Difference(newSubnet).List()
 		if len(rem) > 0 {
-			return fmt.Errorf("Subnet(s) %v cannot be deleted", rem)
+			return diagFromErr(context, errorAt("subnet_id", fmt.Errorf("Subnet(s) %v cannot be deleted", rem)))
 		}
 		metro := d.Get("datacenter").(string)
 		//from datacenter retrive the metro for filtering the subnets
 		metro = metro[0:3]
 		subnets, err := subnetAPI.List(targetEnv, metro)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		for _, nS := range newSubnet.List() {
 			exists := false
@@ -1181,7 +1182,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			if !exists {
 				err := subnetAPI.AddSubnet(clusterID, nS.(string), targetEnv)
 				if err != nil {
-					return err
+					return diagFromErr(context, err)
 				}
 				subnet := getSubnet(subnets, nS.(string))
 				if subnet.Type == PUBLIC_SUBNET_TYPE {
@@ -1191,10 +1192,10 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if publicSubnetAdded && d.Get("wait_till").(string) == ingressReady {
-		_, err = WaitForSubnetAvailable(d, meta, targetEnv)
+		_, err = WaitForSubnetAvailable(context, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for initializing ingress hostname and secret: %s", err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for initializing ingress hostname and secret: %s", err))
 		}
 	}
 
@@ -1203,7 +1204,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+			return diagFromErr(context, fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -1213,7 +1214,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 
 	}
 
-	return resourceIBMContainerClusterRead(d, meta)
+	return resourceIBMContainerClusterRead(context, d, meta)
 }
 
 func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorkers []interface{}, workerInfo []map[string]string) (string, error) {
@@ -1251,30 +1252,30 @@ func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorker
 	return "", fmt.Errorf("Unable to get ID of worker")
 }
 
-func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	clusterID := d.Id()
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("Error deleting cluster: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error deleting cluster: %s", err))
 	}
-	_, err = waitForClusterDelete(d, meta)
+	_, err = waitForClusterDelete(context, d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	return nil
 }
 
-func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1303,11 +1304,11 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1324,7 +1325,7 @@ func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1345,7 +1346,7 @@ func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.Cl
 }
 
 // waitForClusterMasterAvailable Waits for cluster creation
-func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1375,11 +1376,11 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // waitForClusterOneWorkerAvailable Waits for cluster creation
-func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1434,11 +1435,11 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1455,7 +1456,7 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1477,7 +1478,7 @@ func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.Clus
 	}
 }
 
-func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterCreation(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1509,10 +1510,10 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSubnetAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1529,7 +1530,7 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1546,7 +1547,7 @@ func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Res
 }
 
 // WaitForClusterVersionUpdate Waits for cluster creation
-func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1564,7 +1565,7 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
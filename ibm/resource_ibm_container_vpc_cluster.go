@@ -166,6 +166,8 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"update_strategy": workerUpdateStrategySchema(),
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -626,7 +628,21 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		workersInfo := make(map[string]int, 0)
 
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		if strategy := expandWorkerUpdateStrategy(d); strategy != nil && (updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) {
+			rollout, err := newVpcWorkerRollout(meta, clusterID, targetEnv)
+			if err != nil {
+				return err
+			}
+			pools, err := rollout.pools()
+			if err != nil {
+				d.Set("patch_version", nil)
+				return err
+			}
+			if err := strategy.rollWorkers(rollout, pools, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				d.Set("patch_version", nil)
+				return fmt.Errorf("Error updating the workers of the cluster (%s): %s", d.Id(), err)
+			}
+		} else if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 
 			// patchVersion := d.Get("patch_version").(string)
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
@@ -4,8 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,6 +18,8 @@ import (
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
 const (
@@ -32,8 +37,10 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
+		CustomizeDiff: vpcWorkerPoolTaintsCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -86,6 +93,79 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Description: "Labels",
 			},
 
+			"taints": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "WorkerPool Taints",
+				Set:         resourceIBMContainerVpcWorkerPoolTaintHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key for taint",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value for taint.",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_container_vpc_worker_pool", "effect"),
+							Description:  "Effect for taint. Accepted values are NoSchedule, PreferNoSchedule and NoExecute.",
+						},
+					},
+				},
+			},
+
+			// The containerv2 API only sets the host pool and the boot volume encryption of a worker pool when it is
+			// created, so changing them replaces the worker pool
+			"host_pool_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the dedicated host pool to place the workers of the worker pool on",
+			},
+
+			"kms_instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"crk"},
+				Description:  "Instance ID of the KMS instance whose root key encrypts the boot volumes of the workers",
+			},
+
+			"crk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"kms_instance_id"},
+				Description:  "Root key ID of the KMS instance which encrypts the boot volumes of the workers",
+			},
+
+			"kms_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"kms_instance_id"},
+				Description:  "Account ID of the KMS instance, if it is in another account than the cluster",
+			},
+
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the security groups attached to the workers of the worker pool",
+			},
+
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -100,9 +180,15 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				ForceNew:    true,
 			},
 			"worker_count": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The number of workers",
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: autoscaledWorkerCountDiffSuppress,
+				Description:      "The number of workers",
+			},
+			"autoscale_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster autoscaler scales the worker pool, in which case the changes of worker_count are ignored",
 			},
 			"entitlement": {
 				Type:             schema.TypeString,
@@ -110,6 +196,17 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				DiffSuppressFunc: applyOnce,
 				Description:      "Entitlement option reduces additional OCP Licence cost in Openshift Clusters",
 			},
+			"patch_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes patch version. The outdated workers of the pool are replaced when it changes",
+			},
+			"retry_patch_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
+			},
+			"update_strategy": workerUpdateStrategySchema(),
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -144,13 +241,6 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 
 	}
 
-	// for _, e := range d.Get("zones").(*schema.Set).List() {
-	// 	value := e.(map[string]interface{})
-	// 	id := value["id"].(string)
-	// 	subnetid := value["subnet_id"].(string)
-
-	// }
-
 	workerPoolConfig := v2.WorkerPoolConfig{
 		Name:        d.Get("worker_pool_name").(string),
 		VpcID:       d.Get("vpc_id").(string),
@@ -166,23 +256,41 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 		}
 		workerPoolConfig.Labels = labels
 	}
-	params := v2.WorkerPoolRequest{
-		WorkerPoolConfig: workerPoolConfig,
-		Cluster:          clusterNameorID,
-	}
 
 	// Update workerpoolConfig with Entitlement option if provided
 	if v, ok := d.GetOk("entitlement"); ok {
 		workerPoolConfig.Entitlement = v.(string)
 	}
 
-	workerPoolsAPI := wpClient.WorkerPools()
+	params := vpcWorkerPoolRequest{
+		WorkerPoolRequest: v2.WorkerPoolRequest{
+			WorkerPoolConfig: workerPoolConfig,
+			Cluster:          clusterNameorID,
+		},
+		HostPoolID: d.Get("host_pool_id").(string),
+	}
+	if v, ok := d.GetOk("kms_instance_id"); ok {
+		params.WorkerVolumeEncryption = &vpcWorkerVolumeEncryption{
+			KmsInstanceID:     v.(string),
+			WorkerVolumeCRKID: d.Get("crk").(string),
+			KMSAccountID:      d.Get("kms_account_id").(string),
+		}
+	}
+	if v, ok := d.GetOk("security_groups"); ok {
+		params.SecurityGroupIDs = expandStringList(v.(*schema.Set).List())
+	}
+
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return err
 	}
+	requester, ok := wpClient.(containerRequester)
+	if !ok {
+		return fmt.Errorf("The container service client can't create the worker pool")
+	}
 
-	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
+	var res v2.WorkerPoolResponse
+	_, err = requester.Post("/v2/vpc/createWorkerPool", params, &res, targetEnv.ToMap())
 	if err != nil {
 		return err
 	}
@@ -196,6 +304,12 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if _, ok := d.GetOk("taints"); ok {
+		if err := setVpcWorkerPoolTaints(d, meta, clusterNameorID, res.ID, targetEnv); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
 }
 
@@ -229,6 +343,20 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("taints") && !d.IsNewResource() {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return err
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		if err := setVpcWorkerPoolTaints(d, meta, parts[0], parts[1], targetEnv); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("worker_count") {
 		clusterNameOrID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -257,6 +385,10 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return err
 		}
+		csClient, err := meta.(ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
 		oldList, newList := d.GetChange("zones")
 		if oldList == nil {
 			oldList = new(schema.Set)
@@ -264,55 +396,97 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if newList == nil {
 			newList = new(schema.Set)
 		}
-		os := oldList.(*schema.Set)
-		ns := newList.(*schema.Set)
-		remove := os.Difference(ns).List()
-		add := ns.Difference(os).List()
-		if len(add) > 0 {
-			csClient, err := meta.(ClientSession).VpcContainerAPI()
+		add, move, remove := vpcWorkerPoolZoneChanges(oldList.(*schema.Set).List(), newList.(*schema.Set).List())
+		addZone := func(zone v2.Zone) error {
+			zoneParam := v2.WorkerPoolZone{
+				Cluster:      clusterID,
+				Id:           zone.ID,
+				SubnetID:     zone.SubnetID,
+				WorkerPoolID: workerPoolName,
+			}
+			err := csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
+			if err != nil {
+				return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
+			}
+			_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
+			if err != nil {
+				return fmt.Errorf(
+					"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+			}
+			return nil
+		}
+		removeZone := func(zone string) error {
+			err := removeVpcWorkerPoolZone(csClient, clusterID, workerPoolName, zone, targetEnv)
+			if err != nil {
+				return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
+			}
+			_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 			if err != nil {
+				return fmt.Errorf(
+					"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+			}
+			return nil
+		}
+		// The new zones are attached before any zone is detached, so that the worker pool keeps its capacity. A zone
+		// moved to another subnet can only be detached and attached again.
+		for _, zone := range add {
+			if err := addZone(zone); err != nil {
 				return err
 			}
-			for _, zone := range add {
-				newZone := zone.(map[string]interface{})
-				zoneParam := v2.WorkerPoolZone{
-					Cluster:      clusterID,
-					Id:           newZone["name"].(string),
-					SubnetID:     newZone["subnet_id"].(string),
-					WorkerPoolID: workerPoolName,
-				}
-				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
-				if err != nil {
-					return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
-				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
-				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
-				}
-
+		}
+		for _, zone := range move {
+			if err := removeZone(zone.ID); err != nil {
+				return err
+			}
+			if err := addZone(zone); err != nil {
+				return err
 			}
 		}
-		if len(remove) > 0 {
-			for _, zone := range remove {
-				oldZone := zone.(map[string]interface{})
-				ClusterClient, err := meta.(ClientSession).ContainerAPI()
-				if err != nil {
-					return err
-				}
-				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
-				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
-				if err != nil {
-					return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
-				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
-				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
-				}
+		for _, zone := range remove {
+			if err := removeZone(zone); err != nil {
+				return err
 			}
 		}
 	}
+
+	if (d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return err
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		strategy := expandWorkerUpdateStrategy(d)
+		if strategy == nil {
+			strategy = newWorkerUpdateStrategy()
+		}
+		rollout, err := newVpcWorkerRollout(meta, parts[0], targetEnv)
+		if err != nil {
+			return err
+		}
+		if err := strategy.rollWorkers(rollout, []string{parts[1]}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			d.Set("patch_version", nil)
+			return fmt.Errorf("Error updating the workers of the worker pool (%s): %s", d.Id(), err)
+		}
+	}
+
+	// The new workers get the security groups the worker pool was created with, so they are bound again whenever the
+	// workers change
+	if (d.HasChange("security_groups") || d.HasChange("worker_count") || d.HasChange("zones") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return err
+		}
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		if err := setVpcWorkerPoolSecurityGroups(d, meta, parts[0], parts[1], targetEnv); err != nil {
+			return err
+		}
+	}
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -338,6 +512,10 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
+	details, err := getVpcWorkerPoolDetails(wpClient, cluster, workerPoolID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the worker pool (%s): %s", d.Id(), err)
+	}
 
 	var zones = make([]map[string]interface{}, 0)
 	for _, zone := range workerPool.Zones {
@@ -358,6 +536,19 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	d.Set("worker_pool_name", workerPool.PoolName)
 	d.Set("flavor", workerPool.Flavor)
 	d.Set("worker_count", workerPool.WorkerCount)
+	d.Set("autoscale_enabled", details.AutoscaleEnabled)
+	d.Set("taints", flattenVpcWorkerPoolTaints(details.Taints))
+	d.Set("host_pool_id", details.HostPoolID)
+	if details.WorkerVolumeEncryption != nil {
+		d.Set("kms_instance_id", details.WorkerVolumeEncryption.KmsInstanceID)
+		d.Set("crk", details.WorkerVolumeEncryption.WorkerVolumeCRKID)
+		d.Set("kms_account_id", details.WorkerVolumeEncryption.KMSAccountID)
+	}
+	// The worker pool keeps the security groups it was created with, the ones set afterwards are bound to the network
+	// interfaces of its workers
+	if _, ok := d.GetOk("security_groups"); !ok && details.SecurityGroupIDs != nil {
+		d.Set("security_groups", newStringSet(schema.HashString, details.SecurityGroupIDs))
+	}
 	// d.Set("provider", workerPool.Provider)
 	d.Set("labels", IgnoreSystemLabels(workerPool.Labels))
 	d.Set("zones", zones)
@@ -516,3 +707,295 @@ func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPo
 		return workerFields, workerDeleteState, nil
 	}
 }
+
+func resourceIBMContainerVpcWorkerPoolValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "effect",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "NoSchedule, PreferNoSchedule, NoExecute",
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_container_vpc_worker_pool", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMContainerVpcWorkerPoolTaintHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-%s-%s", m["key"].(string), m["value"].(string), m["effect"].(string)))
+}
+
+// vpcWorkerPoolTaintsCustomizeDiff rejects the taints which repeat a key, as the API keeps a single value and
+// effect per key
+func vpcWorkerPoolTaintsCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	keys := map[string]bool{}
+	for _, t := range diff.Get("taints").(*schema.Set).List() {
+		key := t.(map[string]interface{})["key"].(string)
+		if key == "" {
+			continue
+		}
+		if keys[key] {
+			return fmt.Errorf("The taint key %s is set more than once", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+// vpcWorkerPoolRequest is the request to create a VPC worker pool, with the placement, encryption and network
+// options the container service client doesn't model
+type vpcWorkerPoolRequest struct {
+	v2.WorkerPoolRequest
+	HostPoolID             string                     `json:"dedicatedHostPoolId,omitempty"`
+	WorkerVolumeEncryption *vpcWorkerVolumeEncryption `json:"workerVolumeEncryption,omitempty"`
+	SecurityGroupIDs       []string                   `json:"securityGroupIDs,omitempty"`
+}
+
+// vpcWorkerVolumeEncryption is the KMS root key which encrypts the boot volumes of the workers of a worker pool
+type vpcWorkerVolumeEncryption struct {
+	KmsInstanceID     string `json:"kmsInstanceID"`
+	WorkerVolumeCRKID string `json:"workerVolumeCRKID"`
+	KMSAccountID      string `json:"kmsAccountID,omitempty"`
+}
+
+// vpcWorkerPoolDetails is the part of a VPC worker pool the container service client doesn't return
+type vpcWorkerPoolDetails struct {
+	AutoscaleEnabled       bool                       `json:"autoscaleEnabled"`
+	Taints                 map[string]string          `json:"taints"`
+	HostPoolID             string                     `json:"dedicatedHostPoolId"`
+	WorkerVolumeEncryption *vpcWorkerVolumeEncryption `json:"workerVolumeEncryption"`
+	SecurityGroupIDs       []string                   `json:"securityGroupIDs"`
+}
+
+func getVpcWorkerPoolDetails(csClient v2.ContainerServiceAPI, cluster, workerPool string, target v2.ClusterTargetHeader) (vpcWorkerPoolDetails, error) {
+	var details vpcWorkerPoolDetails
+	requester, ok := csClient.(containerRequester)
+	if !ok {
+		return details, fmt.Errorf("The container service client can't retrieve the worker pool")
+	}
+	path := fmt.Sprintf("/v2/vpc/getWorkerPool?cluster=%s&workerpool=%s", url.QueryEscape(cluster), url.QueryEscape(workerPool))
+	_, err := requester.Get(path, &details, target.ToMap())
+	return details, err
+}
+
+// setVpcWorkerPoolTaints replaces the taints of the workers of a worker pool by the taints of the resource
+func setVpcWorkerPoolTaints(d *schema.ResourceData, meta interface{}, cluster, workerPool string, target v2.ClusterTargetHeader) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	requester, ok := csClient.(containerRequester)
+	if !ok {
+		return fmt.Errorf("The container service client can't update the taints of the worker pool")
+	}
+	params := map[string]interface{}{
+		"cluster":    cluster,
+		"workerpool": workerPool,
+		"taints":     expandVpcWorkerPoolTaints(d.Get("taints").(*schema.Set).List()),
+	}
+	_, err = requester.Post("/v2/setWorkerPoolTaints", params, nil, target.ToMap())
+	if err != nil {
+		return fmt.Errorf("Error updating the taints of the worker pool (%s): %s", workerPool, err)
+	}
+	return nil
+}
+
+// expandVpcWorkerPoolTaints converts the taints of the resource to the taints of the API, which map the key of a
+// taint to its value and effect
+func expandVpcWorkerPoolTaints(l []interface{}) map[string]string {
+	taints := make(map[string]string, len(l))
+	for _, t := range l {
+		m := t.(map[string]interface{})
+		taints[m["key"].(string)] = fmt.Sprintf("%s:%s", m["value"].(string), m["effect"].(string))
+	}
+	return taints
+}
+
+func flattenVpcWorkerPoolTaints(taints map[string]string) []map[string]interface{} {
+	keys := make([]string, 0, len(taints))
+	for k := range taints {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	l := make([]map[string]interface{}, 0, len(taints))
+	for _, k := range keys {
+		value, effect := taints[k], ""
+		if i := strings.LastIndex(value, ":"); i >= 0 {
+			value, effect = value[:i], value[i+1:]
+		}
+		l = append(l, map[string]interface{}{
+			"key":    k,
+			"value":  value,
+			"effect": effect,
+		})
+	}
+	return l
+}
+
+// setVpcWorkerPoolSecurityGroups binds the primary network interfaces of the workers of a worker pool to the
+// security groups of the resource instead of the ones the worker pool was created with. The API offers no operation
+// to change the security groups of a worker pool, so they are changed on the interfaces through the VPC API.
+func setVpcWorkerPoolSecurityGroups(d *schema.ResourceData, meta interface{}, cluster, workerPool string, target v2.ClusterTargetHeader) error {
+	v, ok := d.GetOk("security_groups")
+	if !ok {
+		return nil
+	}
+	groups := v.(*schema.Set)
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	details, err := getVpcWorkerPoolDetails(csClient, cluster, workerPool, target)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the worker pool (%s): %s", d.Id(), err)
+	}
+	poolGroups := newStringSet(schema.HashString, details.SecurityGroupIDs)
+	if groups.Equal(poolGroups) {
+		return nil
+	}
+
+	// The workers added by a resize only have their interfaces once they are deployed
+	_, err = WaitForWorkerPoolAvailable(d, meta, cluster, workerPool, d.Timeout(schema.TimeoutUpdate), target)
+	if err != nil {
+		return fmt.Errorf("Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+	}
+	cls, err := csClient.Clusters().GetCluster(cluster, target)
+	if err != nil {
+		return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+	}
+	workers, err := csClient.Workers().ListByWorkerPool(cluster, workerPool, false, target)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the workers of the worker pool (%s): %s", d.Id(), err)
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	interfaces, err := vpcClusterNetworkInterfaces(sess, d.Get("vpc_id").(string), cls.ID)
+	if err != nil {
+		return err
+	}
+	for _, worker := range workers {
+		nicID := ""
+		for _, nic := range worker.NetworkInterfaces {
+			if nic.Primary {
+				nicID = interfaces[nic.IpAddress]
+			}
+		}
+		if nicID == "" {
+			log.Printf("[WARN] The network interface of the worker %s is not found, its security groups are not changed", worker.ID)
+			continue
+		}
+		if err := securityGroupTargetBindingsUpdate(context.Background(), sess, nicID, poolGroups, groups); err != nil {
+			return fmt.Errorf("Error updating the security groups of the worker %s: %s", worker.ID, err)
+		}
+	}
+	return nil
+}
+
+// vpcClusterNetworkInterfaces maps the primary IP addresses of the workers of a VPC cluster to their network
+// interfaces, which are the targets of the kube-<cluster ID> security group the service creates for the cluster
+func vpcClusterNetworkInterfaces(sess *vpcv1.VpcV1, vpcID, clusterID string) (map[string]string, error) {
+	clusterGroup := ""
+	for start := ""; ; {
+		options := &vpcv1.ListSecurityGroupsOptions{VPCID: &vpcID}
+		if start != "" {
+			options.Start = &start
+		}
+		groups, response, err := sess.ListSecurityGroups(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the security groups of the VPC (%s): %w", vpcID, newAPIError(err, response))
+		}
+		for _, group := range groups.SecurityGroups {
+			if group.Name != nil && *group.Name == "kube-"+clusterID {
+				clusterGroup = *group.ID
+			}
+		}
+		start = GetNext(groups.Next)
+		if clusterGroup != "" || start == "" {
+			break
+		}
+	}
+	if clusterGroup == "" {
+		return nil, fmt.Errorf("The security group of the cluster %s is not found in the VPC (%s)", clusterID, vpcID)
+	}
+
+	interfaces := map[string]string{}
+	for start := ""; ; {
+		options := sess.NewListSecurityGroupTargetsOptions(clusterGroup)
+		if start != "" {
+			options.Start = &start
+		}
+		targets, response, err := sess.ListSecurityGroupTargets(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the targets of the security group (%s): %w", clusterGroup, newAPIError(err, response))
+		}
+		for _, t := range targets.Targets {
+			target, ok := t.(*vpcv1.SecurityGroupTargetReference)
+			if !ok || target.ID == nil || target.ResourceType == nil || *target.ResourceType != vpcv1.SecurityGroupTargetReferenceResourceTypeNetworkInterfaceConst {
+				continue
+			}
+			nic, response, err := sess.GetSecurityGroupNetworkInterface(sess.NewGetSecurityGroupNetworkInterfaceOptions(clusterGroup, *target.ID))
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving the network interface (%s): %w", *target.ID, newAPIError(err, response))
+			}
+			if nic.PrimaryIpv4Address != nil {
+				interfaces[*nic.PrimaryIpv4Address] = *target.ID
+			}
+		}
+		start = GetNext(targets.Next)
+		if start == "" {
+			break
+		}
+	}
+	return interfaces, nil
+}
+
+// vpcWorkerPoolZoneChanges compares the zones of a worker pool by name, and returns the zones to attach to it, the
+// zones moved to another subnet, which are detached and attached again, and the names of the zones to detach from
+// it. The other zones are left alone.
+func vpcWorkerPoolZoneChanges(oldZones, newZones []interface{}) (add, move []v2.Zone, remove []string) {
+	subnets := func(zones []interface{}) map[string]string {
+		m := make(map[string]string, len(zones))
+		for _, z := range zones {
+			zone := z.(map[string]interface{})
+			m[zone["name"].(string)] = zone["subnet_id"].(string)
+		}
+		return m
+	}
+	oldSubnets, newSubnets := subnets(oldZones), subnets(newZones)
+	for name := range oldSubnets {
+		if _, ok := newSubnets[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	for name, subnet := range newSubnets {
+		if oldSubnet, ok := oldSubnets[name]; !ok {
+			add = append(add, v2.Zone{ID: name, SubnetID: subnet})
+		} else if oldSubnet != subnet {
+			move = append(move, v2.Zone{ID: name, SubnetID: subnet})
+		}
+	}
+	sort.Strings(remove)
+	sort.Slice(add, func(i, j int) bool { return add[i].ID < add[j].ID })
+	sort.Slice(move, func(i, j int) bool { return move[i].ID < move[j].ID })
+	return add, move, remove
+}
+
+// removeVpcWorkerPoolZone detaches a zone from a worker pool, which deletes the workers of the pool in the zone
+func removeVpcWorkerPoolZone(csClient v2.ContainerServiceAPI, cluster, workerPool, zone string, target v2.ClusterTargetHeader) error {
+	requester, ok := csClient.(containerRequester)
+	if !ok {
+		return fmt.Errorf("The container service client can't remove the zone of the worker pool")
+	}
+	params := map[string]string{
+		"cluster":    cluster,
+		"workerpool": workerPool,
+		"zone":       zone,
+	}
+	_, err := requester.Post("/v2/removeWorkerPoolZone", params, nil, target.ToMap())
+	return err
+}
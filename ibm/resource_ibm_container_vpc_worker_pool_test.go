@@ -4,7 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -13,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
 func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
@@ -32,6 +38,8 @@ func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
 						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "1"),
 					resource.TestCheckResourceAttr(
 						"ibm_container_vpc_worker_pool.test_pool", "labels.%", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "taints.#", "1"),
 				),
 			},
 			{
@@ -43,6 +51,8 @@ func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
 						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_container_vpc_worker_pool.test_pool", "labels.%", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "taints.#", "2"),
 				),
 			},
 			{
@@ -141,6 +151,11 @@ func testAccCheckIBMVpcContainerWorkerPoolBasic(name string) string {
 		"test"  = "test-pool"
 		"test1" = "test-pool1"
 	  }
+	  taints {
+		key    = "dedicated"
+		value  = "edge"
+		effect = "NoSchedule"
+	  }
 	}
 		`, name)
 }
@@ -200,6 +215,128 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
 		"test1" = "test-pool1"
 		"test2" = "test-pool2"
 	  }
+	  taints {
+		key    = "dedicated"
+		value  = "edge"
+		effect = "NoExecute"
+	  }
+	  taints {
+		key    = "gpu"
+		value  = "true"
+		effect = "PreferNoSchedule"
+	  }
 	}
 		`, name)
 }
+
+func TestVpcWorkerPoolZoneChanges(t *testing.T) {
+	zone := func(name, subnet string) interface{} {
+		return map[string]interface{}{"name": name, "subnet_id": subnet}
+	}
+	oldZones := []interface{}{zone("eu-de-1", "subnet-1"), zone("eu-de-2", "subnet-2"), zone("eu-de-3", "subnet-3")}
+	newZones := []interface{}{zone("eu-de-1", "subnet-1"), zone("eu-de-2", "subnet-4"), zone("eu-de-4", "subnet-5")}
+
+	add, move, remove := vpcWorkerPoolZoneChanges(oldZones, newZones)
+	if want := []v2.Zone{{ID: "eu-de-4", SubnetID: "subnet-5"}}; !reflect.DeepEqual(add, want) {
+		t.Errorf("got added zones %v, want %v", add, want)
+	}
+	if want := []v2.Zone{{ID: "eu-de-2", SubnetID: "subnet-4"}}; !reflect.DeepEqual(move, want) {
+		t.Errorf("got moved zones %v, want %v", move, want)
+	}
+	if want := []string{"eu-de-3"}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("got removed zones %v, want %v", remove, want)
+	}
+
+	add, move, remove = vpcWorkerPoolZoneChanges(oldZones, append(oldZones, zone("eu-de-4", "subnet-5")))
+	if len(remove) != 0 || len(move) != 0 || len(add) != 1 || add[0].ID != "eu-de-4" {
+		t.Errorf("adding a zone should only add it, got added zones %v, moved zones %v and removed zones %v", add, move, remove)
+	}
+}
+
+func TestVpcWorkerPoolTaints(t *testing.T) {
+	taints := expandVpcWorkerPoolTaints([]interface{}{
+		map[string]interface{}{"key": "dedicated", "value": "edge", "effect": "NoSchedule"},
+		map[string]interface{}{"key": "example.com/gpu", "value": "a:b", "effect": "NoExecute"},
+	})
+	want := map[string]string{"dedicated": "edge:NoSchedule", "example.com/gpu": "a:b:NoExecute"}
+	if !reflect.DeepEqual(taints, want) {
+		t.Errorf("got taints %v, want %v", taints, want)
+	}
+
+	flattened := flattenVpcWorkerPoolTaints(taints)
+	if len(flattened) != 2 || flattened[1]["value"] != "a:b" || flattened[1]["effect"] != "NoExecute" || flattened[0]["key"] != "dedicated" {
+		t.Errorf("unexpected flattened taints %v", flattened)
+	}
+}
+
+func TestVpcWorkerPoolTaintsCustomizeDiff(t *testing.T) {
+	r := resourceIBMContainerVpcWorkerPool()
+	for _, c := range []struct {
+		taints []interface{}
+		err    string
+	}{
+		{
+			taints: []interface{}{
+				map[string]interface{}{"key": "dedicated", "value": "edge", "effect": "NoSchedule"},
+				map[string]interface{}{"key": "gpu", "value": "true", "effect": "NoSchedule"},
+			},
+		},
+		{
+			taints: []interface{}{
+				map[string]interface{}{"key": "dedicated", "value": "edge", "effect": "NoSchedule"},
+				map[string]interface{}{"key": "dedicated", "value": "edge", "effect": "NoExecute"},
+			},
+			err: "more than once",
+		},
+	} {
+		raw := map[string]interface{}{
+			"cluster":          "mycluster",
+			"flavor":           "bx2.4x16",
+			"worker_pool_name": "default",
+			"vpc_id":           "r006-vpc",
+			"worker_count":     1,
+			"zones":            []interface{}{map[string]interface{}{"name": "eu-de-1", "subnet_id": "subnet-1"}},
+			"taints":           c.taints,
+		}
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		if c.err == "" && err != nil {
+			t.Errorf("unexpected error %s for %v", err, c.taints)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("expected an error with %q for %v, got %v", c.err, c.taints, err)
+		}
+	}
+}
+
+func TestVpcClusterNetworkInterfaces(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/security_groups":
+			if r.URL.Query().Get("vpc.id") != "r006-vpc" {
+				t.Errorf("unexpected security group query %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"limit":50,"total_count":2,"first":{"href":"x"},"security_groups":[{"id":"sg-1","name":"default"},{"id":"sg-2","name":"kube-c1"}]}`)
+		case "/security_groups/sg-2/targets":
+			fmt.Fprint(w, `{"limit":50,"total_count":2,"first":{"href":"x"},"targets":[{"id":"nic-1","name":"a","resource_type":"network_interface"},{"id":"lb-1","name":"b","resource_type":"load_balancer"}]}`)
+		case "/security_groups/sg-2/network_interfaces/nic-1":
+			fmt.Fprint(w, `{"id":"nic-1","name":"a","primary_ipv4_address":"10.243.0.4"}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interfaces, err := vpcClusterNetworkInterfaces(sess, "r006-vpc", "c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"10.243.0.4": "nic-1"}; !reflect.DeepEqual(interfaces, want) {
+		t.Errorf("got interfaces %v, want %v", interfaces, want)
+	}
+}
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -51,10 +52,17 @@ func resourceIBMContainerWorkerPool() *schema.Resource {
 			},
 
 			"size_per_zone": {
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validateSizePerZone,
-				Description:  "Number of nodes per zone",
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateFunc:     validateSizePerZone,
+				DiffSuppressFunc: autoscaledWorkerCountDiffSuppress,
+				Description:      "Number of nodes per zone",
+			},
+
+			"autoscale_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster autoscaler scales the worker pool, in which case the changes of size_per_zone are ignored",
 			},
 
 			"entitlement": {
@@ -233,6 +241,12 @@ func resourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}
 	d.Set("worker_pool_name", workerPool.Name)
 	d.Set("machine_type", strings.Split(machineType, ".encrypted")[0])
 	d.Set("size_per_zone", workerPool.Size)
+	// The autoscaling is only a hint for size_per_zone, so failing to retrieve it doesn't fail the read
+	autoscaleEnabled, err := workerPoolAutoscaleEnabled(meta, cluster, workerPoolID, targetEnv.ResourceGroup)
+	if err != nil {
+		log.Printf("[WARN] %s, assuming the worker pool isn't autoscaled", err)
+	}
+	d.Set("autoscale_enabled", autoscaleEnabled)
 	hardware := workerPool.Isolation
 	switch strings.ToLower(hardware) {
 	case "":
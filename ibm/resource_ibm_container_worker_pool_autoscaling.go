@@ -0,0 +1,421 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
+)
+
+const (
+	autoscalerNamespace         = "kube-system"
+	autoscalerConfigMap         = "iks-ca-configmap"
+	autoscalerWorkerPoolsConfig = "workerPoolsConfig.json"
+)
+
+// autoscalerOptions maps the global options of the resource to the keys of the ConfigMap of the cluster autoscaler
+var autoscalerOptions = map[string]string{
+	"expander":                      "expander",
+	"scale_down_delay_after_add":    "scaleDownDelayAfterAdd",
+	"scale_down_delay_after_delete": "scaleDownDelayAfterDelete",
+	"scale_down_unneeded_time":      "scaleDownUnneededTime",
+}
+
+// autoscalerWorkerPool is an entry of the worker pools config of the cluster autoscaler. The sizes are per zone.
+type autoscalerWorkerPool struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func resourceIBMContainerWorkerPoolAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMContainerWorkerPoolAutoscalingCreate,
+		Read:          resourceIBMContainerWorkerPoolAutoscalingRead,
+		Update:        resourceIBMContainerWorkerPoolAutoscalingUpdate,
+		Delete:        resourceIBMContainerWorkerPoolAutoscalingDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: workerPoolAutoscalingCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"worker_pool": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceIBMContainerWorkerPoolAutoscalingHash,
+				Description: "The worker pools scaled by the cluster autoscaler",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the worker pool",
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The minimum number of workers per zone of the worker pool",
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of workers per zone of the worker pool",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the cluster autoscaler scales the worker pool",
+						},
+					},
+				},
+			},
+			"expander": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_container_worker_pool_autoscaling", "expander"),
+				Description:  "How the cluster autoscaler chooses the worker pool to scale up: random, most-pods, least-waste or priority",
+			},
+			"scale_down_delay_after_add": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
+				Description:  "How long after a scale up the cluster autoscaler waits before it evaluates a scale down, such as 10m",
+			},
+			"scale_down_delay_after_delete": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
+				Description:  "How long after a worker is removed the cluster autoscaler waits before it evaluates a scale down, such as 10m",
+			},
+			"scale_down_unneeded_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDuration,
+				Description:  "How long a worker must be unneeded before the cluster autoscaler removes it, such as 10m",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "expander",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "random, most-pods, least-waste, priority",
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_container_worker_pool_autoscaling", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-%d-%d-%t", m["name"].(string), m["min_size"].(int), m["max_size"].(int), m["enabled"].(bool)))
+}
+
+func workerPoolAutoscalingCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	names := map[string]bool{}
+	for _, p := range diff.Get("worker_pool").(*schema.Set).List() {
+		pool := p.(map[string]interface{})
+		name := pool["name"].(string)
+		if name == "" {
+			continue
+		}
+		if names[name] {
+			return fmt.Errorf("The worker pool %s is set more than once", name)
+		}
+		names[name] = true
+		if pool["min_size"].(int) > pool["max_size"].(int) {
+			return fmt.Errorf("The min_size of the worker pool %s is greater than its max_size", name)
+		}
+	}
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	pools := expandAutoscalerWorkerPools(d.Get("worker_pool").(*schema.Set).List())
+	err := updateAutoscalerConfigMap(d, meta, cluster, d.Timeout(schema.TimeoutCreate), true, func(configMap *kubeConfigMap) error {
+		setAutoscalerOptions(configMap, d)
+		return setAutoscalerWorkerPools(configMap, pools, nil)
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(cluster)
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingRead(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	client, err := autoscalerKubeClient(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	configMap, err := client.getConfigMap(autoscalerNamespace, autoscalerConfigMap)
+	if err != nil {
+		if isKubeNotFound(err) {
+			log.Printf("[WARN] The cluster autoscaler ConfigMap of the cluster %s is not found, removing the autoscaling from the state", cluster)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving the cluster autoscaler ConfigMap of the cluster %s: %s", cluster, err)
+	}
+	pools, err := getAutoscalerWorkerPools(configMap)
+	if err != nil {
+		return err
+	}
+
+	// Only the worker pools managed by the resource are read, or all the enabled ones on import
+	managed := map[string]bool{}
+	for _, p := range d.Get("worker_pool").(*schema.Set).List() {
+		managed[p.(map[string]interface{})["name"].(string)] = true
+	}
+	workerPools := make([]interface{}, 0, len(pools))
+	for _, pool := range pools {
+		if managed[pool.Name] || (len(managed) == 0 && pool.Enabled) {
+			workerPools = append(workerPools, map[string]interface{}{
+				"name":     pool.Name,
+				"min_size": pool.MinSize,
+				"max_size": pool.MaxSize,
+				"enabled":  pool.Enabled,
+			})
+		}
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("worker_pool", schema.NewSet(resourceIBMContainerWorkerPoolAutoscalingHash, workerPools))
+	for option, key := range autoscalerOptions {
+		d.Set(option, configMap.Data[key])
+	}
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingUpdate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	o, n := d.GetChange("worker_pool")
+	pools := expandAutoscalerWorkerPools(n.(*schema.Set).List())
+	removed := removedAutoscalerWorkerPools(expandAutoscalerWorkerPools(o.(*schema.Set).List()), pools)
+	err := updateAutoscalerConfigMap(d, meta, cluster, d.Timeout(schema.TimeoutUpdate), false, func(configMap *kubeConfigMap) error {
+		setAutoscalerOptions(configMap, d)
+		return setAutoscalerWorkerPools(configMap, pools, removed)
+	})
+	if err != nil {
+		return err
+	}
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingDelete(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	pools := expandAutoscalerWorkerPools(d.Get("worker_pool").(*schema.Set).List())
+	err := updateAutoscalerConfigMap(d, meta, cluster, d.Timeout(schema.TimeoutDelete), false, func(configMap *kubeConfigMap) error {
+		return setAutoscalerWorkerPools(configMap, nil, removedAutoscalerWorkerPools(pools, nil))
+	})
+	if err != nil && !isKubeNotFound(err) {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// autoscalerKubeClient returns a client of the Kubernetes API of the cluster, with the admin config of the cluster
+func autoscalerKubeClient(d *schema.ResourceData, meta interface{}, cluster string) (*kubeClient, error) {
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	return clusterKubeClient(meta, cluster, targetEnv)
+}
+
+// updateAutoscalerConfigMap applies update to the cluster autoscaler ConfigMap of the cluster. It retries when the
+// ConfigMap changed in between and, with waitForConfigMap, waits for the cluster-autoscaler add-on to create the
+// ConfigMap.
+func updateAutoscalerConfigMap(d *schema.ResourceData, meta interface{}, cluster string, timeout time.Duration, waitForConfigMap bool, update func(*kubeConfigMap) error) error {
+	client, err := autoscalerKubeClient(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		configMap, err := client.getConfigMap(autoscalerNamespace, autoscalerConfigMap)
+		if err != nil {
+			if isKubeNotFound(err) && waitForConfigMap {
+				log.Printf("[DEBUG] Waiting for the cluster-autoscaler add-on to create its ConfigMap in the cluster %s", cluster)
+				return resource.RetryableError(fmt.Errorf("The cluster autoscaler ConfigMap is not found in the cluster %s, install the cluster-autoscaler add-on first: %s", cluster, err))
+			}
+			return resource.NonRetryableError(err)
+		}
+		if err := update(configMap); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, err := client.updateConfigMap(configMap); err != nil {
+			if apiErr, ok := err.(*kubeAPIError); ok && apiErr.StatusCode == http.StatusConflict {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isKubeNotFound(err) {
+			return err
+		}
+		return fmt.Errorf("Error updating the cluster autoscaler ConfigMap of the cluster %s: %s", cluster, err)
+	}
+	return nil
+}
+
+// workerPoolAutoscaleEnabled tells whether the cluster autoscaler scales a worker pool
+func workerPoolAutoscaleEnabled(meta interface{}, cluster, workerPool, resourceGroup string) (bool, error) {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return false, err
+	}
+	getWorkerPoolOptions := &kubernetesserviceapiv1.GetWorkerPoolOptions{
+		Cluster:    &cluster,
+		Workerpool: &workerPool,
+	}
+	if resourceGroup != "" {
+		getWorkerPoolOptions.XAuthResourceGroup = &resourceGroup
+	}
+	result, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving the autoscaling of the worker pool %s: %s\n%s", workerPool, err, response)
+	}
+	return result.AutoscaleEnabled != nil && *result.AutoscaleEnabled, nil
+}
+
+// autoscaledWorkerCountDiffSuppress ignores the changes of the size of a worker pool while the cluster autoscaler
+// scales it
+func autoscaledWorkerCountDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("autoscale_enabled").(bool)
+}
+
+func expandAutoscalerWorkerPools(l []interface{}) []autoscalerWorkerPool {
+	pools := make([]autoscalerWorkerPool, 0, len(l))
+	for _, p := range l {
+		m := p.(map[string]interface{})
+		pools = append(pools, autoscalerWorkerPool{
+			Name:    m["name"].(string),
+			MinSize: m["min_size"].(int),
+			MaxSize: m["max_size"].(int),
+			Enabled: m["enabled"].(bool),
+		})
+	}
+	return pools
+}
+
+// removedAutoscalerWorkerPools returns the names of the worker pools of old which are not in new
+func removedAutoscalerWorkerPools(old, new []autoscalerWorkerPool) []string {
+	kept := map[string]bool{}
+	for _, pool := range new {
+		kept[pool.Name] = true
+	}
+	removed := []string{}
+	for _, pool := range old {
+		if !kept[pool.Name] {
+			removed = append(removed, pool.Name)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+func getAutoscalerWorkerPools(configMap *kubeConfigMap) ([]autoscalerWorkerPool, error) {
+	pools := []autoscalerWorkerPool{}
+	if config := configMap.Data[autoscalerWorkerPoolsConfig]; config != "" {
+		if err := json.Unmarshal([]byte(config), &pools); err != nil {
+			return nil, fmt.Errorf("Error parsing the %s of the cluster autoscaler ConfigMap: %s", autoscalerWorkerPoolsConfig, err)
+		}
+	}
+	return pools, nil
+}
+
+// setAutoscalerWorkerPools sets the pools in the worker pools config of the cluster autoscaler, and disables the
+// removed ones. The other worker pools of the config are left untouched.
+func setAutoscalerWorkerPools(configMap *kubeConfigMap, pools []autoscalerWorkerPool, removed []string) error {
+	current, err := getAutoscalerWorkerPools(configMap)
+	if err != nil {
+		return err
+	}
+	index := map[string]int{}
+	for i, pool := range current {
+		index[pool.Name] = i
+	}
+	for _, pool := range pools {
+		if i, ok := index[pool.Name]; ok {
+			current[i] = pool
+		} else {
+			index[pool.Name] = len(current)
+			current = append(current, pool)
+		}
+	}
+	for _, name := range removed {
+		if i, ok := index[name]; ok {
+			current[i].Enabled = false
+		}
+	}
+	config, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[autoscalerWorkerPoolsConfig] = string(config)
+	return nil
+}
+
+// setAutoscalerOptions sets the global options that are configured in the ConfigMap of the cluster autoscaler
+func setAutoscalerOptions(configMap *kubeConfigMap, d *schema.ResourceData) {
+	for option, key := range autoscalerOptions {
+		if v, ok := d.GetOk(option); ok {
+			if configMap.Data == nil {
+				configMap.Data = map[string]string{}
+			}
+			configMap.Data[key] = v.(string)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerWorkerPoolAutoscaling_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-cluster-autoscaling-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 1, 2, "random"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "worker_pool.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "expander", "random"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 1, 3, "least-waste"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "expander", "least-waste"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "scale_down_delay_after_add", "15m"),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_worker_pool_autoscaling.autoscaling",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name string, minSize, maxSize int, expander string) string {
+	return fmt.Sprintf(`
+	provider "ibm"{
+		region = "eu-de"
+	}
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%[1]s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "eu-de-1"
+		total_ipv4_address_count = 256
+	}
+
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 1
+		wait_till         = "OneWorkerNodeReady"
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+
+	resource "ibm_container_addons" "addons" {
+		cluster = ibm_container_vpc_cluster.cluster.id
+		addons {
+			name    = "cluster-autoscaler"
+		}
+	}
+
+	resource "ibm_container_worker_pool_autoscaling" "autoscaling" {
+		cluster                    = ibm_container_addons.addons.cluster
+		expander                   = "%[4]s"
+		scale_down_delay_after_add = "15m"
+		worker_pool {
+			name     = "default"
+			min_size = %[2]d
+			max_size = %[3]d
+		}
+	}`, name, minSize, maxSize, expander)
+}
+
+func TestSetAutoscalerWorkerPools(t *testing.T) {
+	configMap := &kubeConfigMap{Data: map[string]string{
+		autoscalerWorkerPoolsConfig: `[{"name":"default","minSize":1,"maxSize":2,"enabled":false},{"name":"gpu","minSize":1,"maxSize":4,"enabled":true},{"name":"edge","minSize":2,"maxSize":2,"enabled":true}]`,
+	}}
+	err := setAutoscalerWorkerPools(configMap, []autoscalerWorkerPool{
+		{Name: "default", MinSize: 2, MaxSize: 5, Enabled: true},
+		{Name: "batch", MinSize: 1, MaxSize: 10, Enabled: true},
+	}, []string{"gpu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pools, err := getAutoscalerWorkerPools(configMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []autoscalerWorkerPool{
+		{Name: "default", MinSize: 2, MaxSize: 5, Enabled: true},
+		{Name: "gpu", MinSize: 1, MaxSize: 4, Enabled: false},
+		{Name: "edge", MinSize: 2, MaxSize: 2, Enabled: true},
+		{Name: "batch", MinSize: 1, MaxSize: 10, Enabled: true},
+	}
+	if !reflect.DeepEqual(pools, want) {
+		t.Errorf("got worker pools %+v, want %+v", pools, want)
+	}
+
+	removed := removedAutoscalerWorkerPools(want, want[2:])
+	if !reflect.DeepEqual(removed, []string{"default", "gpu"}) {
+		t.Errorf("got removed worker pools %v", removed)
+	}
+}
+
+func TestWorkerPoolAutoscalingCustomizeDiff(t *testing.T) {
+	r := resourceIBMContainerWorkerPoolAutoscaling()
+	for _, c := range []struct {
+		pools []interface{}
+		err   string
+	}{
+		{
+			pools: []interface{}{
+				map[string]interface{}{"name": "default", "min_size": 1, "max_size": 3},
+			},
+		},
+		{
+			pools: []interface{}{
+				map[string]interface{}{"name": "default", "min_size": 4, "max_size": 3},
+			},
+			err: "greater than its max_size",
+		},
+		{
+			pools: []interface{}{
+				map[string]interface{}{"name": "default", "min_size": 1, "max_size": 3},
+				map[string]interface{}{"name": "default", "min_size": 1, "max_size": 4},
+			},
+			err: "more than once",
+		},
+	} {
+		raw := map[string]interface{}{"cluster": "mycluster", "worker_pool": c.pools}
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		if c.err == "" && err != nil {
+			t.Errorf("unexpected error %s for %v", err, c.pools)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("expected an error with %q for %v, got %v", c.err, c.pools, err)
+		}
+	}
+}
+
+func TestKubeClientConfigMap(t *testing.T) {
+	var updated kubeConfigMap
+	var unavailable int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request fails, the retry policy of the provider sends it again
+		if atomic.AddInt32(&unavailable, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path != "/api/v1/namespaces/kube-system/configmaps/iks-ca-configmap" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"kind":"Status","message":"configmaps not found"}`)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"iks-ca-configmap","namespace":"kube-system","resourceVersion":"7"},"data":{"expander":"random"}}`)
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &updated); err != nil {
+				t.Error(err)
+			}
+			w.Write(body)
+		}
+	}))
+	defer server.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	policy := newRetryPolicy(1)
+	policy.MinBackoff, policy.MaxBackoff = time.Millisecond, time.Millisecond
+	meta := &clientSession{config: &Config{RetryPolicy: policy}}
+	client, err := newKubeClient(meta, clusterConfig{host: server.URL, token: "token", caCertificate: string(ca)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	configMap, err := client.getConfigMap(autoscalerNamespace, autoscalerConfigMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configMap.Data["expander"] = "least-waste"
+	if _, err = client.updateConfigMap(configMap); err != nil {
+		t.Fatal(err)
+	}
+	if updated.Data["expander"] != "least-waste" || updated.Metadata["resourceVersion"] != "7" {
+		t.Errorf("unexpected update %+v", updated)
+	}
+	if _, err = client.getConfigMap(autoscalerNamespace, "missing"); !isKubeNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
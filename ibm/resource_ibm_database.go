@@ -8,12 +8,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"os"
 	"strings"
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -59,10 +61,10 @@ type CsEntry struct {
 
 func resourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceIBMDatabaseInstanceCreate,
-		Read:          resourceIBMDatabaseInstanceRead,
-		Update:        resourceIBMDatabaseInstanceUpdate,
-		Delete:        resourceIBMDatabaseInstanceDelete,
+		CreateContext: resourceIBMDatabaseInstanceCreate,
+		ReadContext:   resourceIBMDatabaseInstanceRead,
+		UpdateContext: resourceIBMDatabaseInstanceUpdate,
+		DeleteContext: resourceIBMDatabaseInstanceDelete,
 		Exists:        resourceIBMDatabaseInstanceExists,
 		CustomizeDiff: resourceIBMDatabaseInstanceDiff,
 		Importer:      &schema.ResourceImporter{},
@@ -915,10 +917,10 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
-func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	serviceName := d.Get("service").(string)
@@ -932,27 +934,27 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving database service offering: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving database service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diagFromErr(context, errorAt("plan", fmt.Errorf("No deployment found for service plan : %s", plan)))
 	}
 	deployments, supportedLocations := filterDatabaseDeployments(deployments, location)
 
@@ -961,7 +963,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList)
+		return diagFromErr(context, errorAt("location", fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList)))
 	}
 	catalogCRN := deployments[0].CatalogCRN
 	rsInst.Target = &catalogCRN
@@ -972,14 +974,14 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 		rsInst.ResourceGroup = &defaultRg
 	}
 
 	initialNodeCount, err := getInitialNodeCount(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	params := Params{}
@@ -1033,18 +1035,18 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	//paramString := string(parameters[:])
 	rsInst.Parameters = raw
 
-	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
+	instance, response, err := rsConClient.CreateResourceInstanceWithContext(context, &rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating database instance: %s %s", err, response)
+		return diagFromErr(context, fmt.Errorf("Error creating database instance: %s %s", err, response))
 	}
 
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForDatabaseInstanceCreate
 
-	_, err = waitForDatabaseInstanceCreate(d, meta, *instance.ID)
+	_, err = waitForDatabaseInstanceCreate(context, d, meta, *instance.ID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create database instance (%s) to complete: %s", *instance.ID, err)
+		return diagFromErr(context, fmt.Errorf(
+			"Error waiting for create database instance (%s) to complete: %s", *instance.ID, err))
 	}
 
 	d.SetId(*instance.ID)
@@ -1053,12 +1055,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		if initialNodeCount != node_count {
 			icdClient, err := meta.(ClientSession).ICDAPI()
 			if err != nil {
-				return fmt.Errorf("Error getting database client settings: %s", err)
+				return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
 			}
 
-			err = horizontalScale(d, meta, icdClient)
+			err = horizontalScale(context, d, meta, icdClient)
 			if err != nil {
-				return err
+				return diagFromErr(context, err)
 			}
 		}
 	}
@@ -1075,7 +1077,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	icdId := EscapeUrlParm(*instance.ID)
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	if pw, ok := d.GetOk("adminpassword"); ok {
@@ -1083,9 +1085,9 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		cdb, err := icdClient.Cdbs().GetCdb(icdId)
 		if err != nil {
 			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-				return fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err)
+				return diagFromErr(context, fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err))
 			}
-			return fmt.Errorf("Error getting database config while updating adminpassword for: %s with error %s\n", icdId, err)
+			return diagFromErr(context, fmt.Errorf("Error getting database config while updating adminpassword for: %s with error %s\n", icdId, err))
 		}
 
 		userParams := icdv4.UserReq{
@@ -1095,12 +1097,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Users().UpdateUser(icdId, cdb.AdminUser, userParams)
 		if err != nil {
-			return fmt.Errorf("Error updating database admin password: %s", err)
+			return diagFromErr(context, errorAt("adminpassword", fmt.Errorf("Error updating database admin password: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for update of database (%s) admin password task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for update of database (%s) admin password task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1115,12 +1117,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 			}
 			task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 			if err != nil {
-				return fmt.Errorf("Error updating database whitelist entry: %s", err)
+				return diagFromErr(context, errorAt("whitelist", fmt.Errorf("Error updating database whitelist entry: %s", err)))
 			}
-			_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+			_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err))
 			}
 		}
 	}
@@ -1128,17 +1130,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		cpuBody, err := expandICDAutoScalingGroup(d, cpuRecord, "cpu")
 		if err != nil {
-			return fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.cpu", fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)))
 		}
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database cpu auto_scaling group: %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.cpu", fmt.Errorf("Error updating database cpu auto_scaling group: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1146,17 +1148,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		diskBody, err := expandICDAutoScalingGroup(d, diskRecord, "disk")
 		if err != nil {
-			return fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.disk", fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)))
 		}
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database disk auto_scaling group: %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.disk", fmt.Errorf("Error updating database disk auto_scaling group: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1164,17 +1166,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		memoryBody, err := expandICDAutoScalingGroup(d, memoryRecord, "memory")
 		if err != nil {
-			return fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.memory", fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)))
 		}
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database memory auto_scaling group: %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.memory", fmt.Errorf("Error updating database memory auto_scaling group: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1190,23 +1192,23 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 			}
 			task, err := icdClient.Users().CreateUser(icdId, userReq)
 			if err != nil {
-				return fmt.Errorf("Error updating database user (%s) entry: %s", user.UserName, err)
+				return diagFromErr(context, errorAt("users", fmt.Errorf("Error updating database user (%s) entry: %s", user.UserName, err)))
 			}
-			_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+			_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for update of database (%s) user (%s) create task to complete: %s", icdId, user.UserName, err)
+				return diagFromErr(context, fmt.Errorf(
+					"Error waiting for update of database (%s) user (%s) create task to complete: %s", icdId, user.UserName, err))
 			}
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(d, meta)
+	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
-func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	instanceID := d.Id()
@@ -1214,7 +1216,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 	rsInst := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
-	instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rsInst)
 	if err != nil {
 		if strings.Contains(err.Error(), "Object not found") ||
 			strings.Contains(err.Error(), "status code: 404") {
@@ -1222,7 +1224,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource instance: %s %s", err, response)
+		return diagFromErr(context, fmt.Errorf("Error retrieving resource instance: %s %s", err, response))
 	}
 	if strings.Contains(*instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -1264,48 +1266,48 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/"+url.QueryEscape(*instance.CRN))
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.GetServiceName(*instance.ResourceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	icdId := EscapeUrlParm(instanceID)
 	cdb, err := icdClient.Cdbs().GetCdb(icdId)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-			return fmt.Errorf("The database instance was not found in the region set for the Provider. Specify the correct region in the provider definition. %v", err)
+			return diagFromErr(context, fmt.Errorf("The database instance was not found in the region set for the Provider. Specify the correct region in the provider definition. %v", err))
 		}
-		return fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err)
+		return diagFromErr(context, fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err))
 	}
 	d.Set("adminuser", cdb.AdminUser)
 	d.Set("version", cdb.Version)
 
 	groupList, err := icdClient.Groups().GetGroups(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("groups", flattenIcdGroups(groupList))
 	d.Set("node_count", groupList.Groups[0].Members.AllocationCount)
@@ -1321,20 +1323,22 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	autoSclaingGroup, err := icdClient.AutoScaling().GetAutoScaling(icdId, "member")
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("auto_scaling", flattenICDAutoScalingGroup(autoSclaingGroup))
 
 	whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database whitelist: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error getting database whitelist: %s", err))
 	}
 	d.Set("whitelist", flattenWhitelist(whitelist))
 
 	var connectionStrings []CsEntry
 	//ICD does not implement a GetUsers API. Users populated from tf configuration.
-	tfusers := d.Get("users").(*schema.Set)
-	users := expandUsers(tfusers)
+	var users []icdv4.User
+	if tfusers, ok := d.Get("users").(*schema.Set); ok {
+		users = expandUsers(tfusers)
+	}
 	user := icdv4.User{
 		UserName: cdb.AdminUser,
 	}
@@ -1343,7 +1347,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		userName := user.UserName
 		csEntry, err := getConnectionString(d, userName, connectionEndpoint, meta)
 		if err != nil {
-			return fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err)
+			return diagFromErr(context, fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err))
 		}
 		connectionStrings = append(connectionStrings, csEntry)
 	}
@@ -1352,10 +1356,10 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	instanceID := d.Id()
@@ -1379,15 +1383,15 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if update {
-		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
+		_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, &updateReq)
 		if err != nil {
-			return fmt.Errorf("Error updating resource instance: %s %s", err, response)
+			return diagFromErr(context, fmt.Errorf("Error updating resource instance: %s %s", err, response))
 		}
 
-		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		_, err = waitForDatabaseInstanceUpdate(context, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err))
 		}
 
 	}
@@ -1404,14 +1408,14 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
 	}
 	icdId := EscapeUrlParm(instanceID)
 
 	if d.HasChange("node_count") {
-		err = horizontalScale(d, meta, icdClient)
+		err = horizontalScale(context, d, meta, icdClient)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 
@@ -1452,12 +1456,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Groups().UpdateGroup(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(context, fmt.Errorf("Error updating database scaling group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1466,17 +1470,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		cpuBody, err := expandICDAutoScalingGroup(d, cpuRecord, "cpu")
 		if err != nil {
-			return fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.cpu", fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)))
 		}
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database cpu auto_scaling group: %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.cpu", fmt.Errorf("Error updating database cpu auto_scaling group: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) cpu auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1485,17 +1489,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		diskBody, err := expandICDAutoScalingGroup(d, diskRecord, "disk")
 		if err != nil {
-			return fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.disk", fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)))
 		}
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database disk auto_scaling  group: %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.disk", fmt.Errorf("Error updating database disk auto_scaling  group: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) disk auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1504,17 +1508,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		memoryBody, err := expandICDAutoScalingGroup(d, memoryRecord, "memory")
 		if err != nil {
-			return fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.memory", fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)))
 		}
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database memory auto_scaling  group: %s", err)
+			return diagFromErr(context, errorAt("auto_scaling.0.memory", fmt.Errorf("Error updating database memory auto_scaling  group: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) memory auto_scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1529,12 +1533,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Users().UpdateUser(icdId, adminUser, userParams)
 		if err != nil {
-			return fmt.Errorf("Error updating database admin password: %s", err)
+			return diagFromErr(context, errorAt("adminpassword", fmt.Errorf("Error updating database admin password: %s", err)))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) admin password update task to complete: %s", icdId, err)
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) admin password update task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1563,12 +1567,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				}
 				task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 				if err != nil {
-					return fmt.Errorf("Error updating database whitelist entry %v : %s", wlEntry.Address, err)
+					return diagFromErr(context, errorAt("whitelist", fmt.Errorf("Error updating database whitelist entry %v : %s", wlEntry.Address, err)))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err)
+					return diagFromErr(context, fmt.Errorf(
+						"Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err))
 				}
 
 			}
@@ -1585,12 +1589,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				ipAddress := wlEntry.Address
 				task, err := icdClient.Whitelists().DeleteWhitelist(icdId, ipAddress)
 				if err != nil {
-					return fmt.Errorf("Error deleting database whitelist entry: %s", err)
+					return diagFromErr(context, errorAt("whitelist", fmt.Errorf("Error deleting database whitelist entry: %s", err)))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err)
+					return diagFromErr(context, fmt.Errorf(
+						"Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err))
 				}
 
 			}
@@ -1631,18 +1635,18 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 					}
 					task, err := icdClient.Users().UpdateUser(icdId, newEntry["name"].(string), userParams)
 					if err != nil {
-						return fmt.Errorf("Error updating database user (%s) password: %s", newEntry["name"].(string), err)
+						return diagFromErr(context, errorAt("users", fmt.Errorf("Error updating database user (%s) password: %s", newEntry["name"].(string), err)))
 					}
-					_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+					_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for database (%s) user (%s) password update task to complete: %s", icdId, newEntry["name"].(string), err)
+						return diagFromErr(context, fmt.Errorf(
+							"Error waiting for database (%s) user (%s) password update task to complete: %s", icdId, newEntry["name"].(string), err))
 					}
 				} else {
-					_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+					_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for database (%s) user (%s) create task to complete: %s", icdId, newEntry["name"].(string), err)
+						return diagFromErr(context, fmt.Errorf(
+							"Error waiting for database (%s) user (%s) create task to complete: %s", icdId, newEntry["name"].(string), err))
 					}
 				}
 			}
@@ -1659,21 +1663,21 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				user := userEntry.UserName
 				task, err := icdClient.Users().DeleteUser(icdId, user)
 				if err != nil {
-					return fmt.Errorf("Error deleting database user (%s) entry: %s", user, err)
+					return diagFromErr(context, errorAt("users", fmt.Errorf("Error deleting database user (%s) entry: %s", user, err)))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) user (%s) delete task to complete: %s", icdId, user, err)
+					return diagFromErr(context, fmt.Errorf(
+						"Error waiting for database (%s) user (%s) delete task to complete: %s", icdId, user, err))
 				}
 			}
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(d, meta)
+	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
-func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
+func horizontalScale(ctx context.Context, d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
 	params := icdv4.GroupReq{}
 
 	icdId := EscapeUrlParm(d.Id())
@@ -1695,7 +1699,7 @@ func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.I
 	//		"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
 	//}
 
-	_, err = waitForDatabaseInstanceUpdate(d, meta)
+	_, err = waitForDatabaseInstanceUpdate(ctx, d, meta)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for database (%s) horizontal scale to complete: %s", d.Id(), err)
@@ -1766,10 +1770,10 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 	return csEntry, nil
 }
 
-func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	id := d.Id()
 	recursive := true
@@ -1777,7 +1781,7 @@ func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 		Recursive: &recursive,
 		ID:        &id,
 	}
-	response, err := rsConClient.DeleteResourceInstance(&deleteReq)
+	response, err := rsConClient.DeleteResourceInstanceWithContext(context, &deleteReq)
 	if err != nil {
 		// If prior delete occurs, instance is not immediately deleted, but remains in "removed" state"
 		// RC 410 with "Gone" returned as error
@@ -1786,14 +1790,14 @@ func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return fmt.Errorf("Error deleting resource instance: %s %s ", err, response)
+			return diagFromErr(context, fmt.Errorf("Error deleting resource instance: %s %s ", err, response))
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(context, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diagFromErr(context, fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
@@ -1827,7 +1831,7 @@ func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{})
 	return *instance.ID == instanceID, nil
 }
 
-func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1840,7 +1844,7 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 			if err != nil || instance == nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
@@ -1857,10 +1861,10 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1874,7 +1878,7 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v %s", d.Id(), err, response)
@@ -1891,10 +1895,10 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
+func waitForDatabaseTaskComplete(ctx context.Context, taskId string, d *schema.ResourceData, meta interface{}, t time.Duration) (bool, error) {
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
 		return false, fmt.Errorf("Error getting database client settings: %s", err)
@@ -1909,6 +1913,8 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 		select {
 		case <-timeout:
 			return false, fmt.Errorf("[Error] Time out waiting for database task to complete")
+		case <-ctx.Done():
+			return false, ctx.Err()
 		case <-delay:
 			innerTask, err = icdClient.Tasks().GetTask(EscapeUrlParm(taskId))
 			if err != nil {
@@ -1926,7 +1932,7 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -1939,7 +1945,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 			rsInst := rc.GetResourceInstanceOptions{
 				ID: &instanceID,
 			}
-			instance, response, err := rsConClient.GetResourceInstance(&rsInst)
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(ctx, &rsInst)
 			if err != nil {
 				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
 					return instance, databaseInstanceSuccessStatus, nil
@@ -1956,7 +1962,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -2095,3 +2101,45 @@ func flattenICDAutoScalingGroup(autoScalingGroup icdv4.AutoscalingGetGroup) []ma
 	result = append(result, as)
 	return result
 }
+
+// icdRequester is the request methods of the database service client, used for the requests the client doesn't
+// model, such as the users of a given type and the promotion of read replicas
+type icdRequester interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	Patch(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	DeleteWithResp(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+func getICDRequester(meta interface{}) (icdRequester, error) {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting database client settings: %s", err)
+	}
+	requester, ok := icdClient.(icdRequester)
+	if !ok {
+		return nil, fmt.Errorf("The database client can't send the request")
+	}
+	return requester, nil
+}
+
+// databaseChildID builds the ID of an object of a database deployment, such as a user or an allowlist entry. As the
+// ID of the deployment is a CRN, which contains slashes, the kind of the object separates it from the object.
+func databaseChildID(deploymentID, kind, name string) string {
+	return fmt.Sprintf("%s/%s/%s", deploymentID, kind, name)
+}
+
+func parseDatabaseChildID(id, kind string) (string, string, error) {
+	i := strings.Index(id, "/"+kind+"/")
+	if i <= 0 || i+len(kind)+2 >= len(id) {
+		return "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of deploymentID/%s/name", id, kind)
+	}
+	return id[:i], id[i+len(kind)+2:], nil
+}
+
+// lockDatabaseTasks serializes the tasks of a database deployment, which runs one task at a time
+func lockDatabaseTasks(deploymentID string) func() {
+	key := "database_tasks_" + deploymentID
+	ibmMutexKV.Lock(key)
+	return func() { ibmMutexKV.Unlock(key) }
+}
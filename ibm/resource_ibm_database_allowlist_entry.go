@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+func resourceIBMDatabaseAllowlistEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistEntryCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistEntryRead,
+		DeleteContext: resourceIBMDatabaseAllowlistEntryDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The ID of the database instance",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"address": {
+				Description:  "Allowlist IP address in CIDR notation",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
+			},
+			"description": {
+				Description:  "Unique allowlist description",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseAllowlistEntryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
+	}
+	deploymentID := d.Get("deployment_id").(string)
+	address := d.Get("address").(string)
+
+	whitelistReq := icdv4.WhitelistReq{
+		WhitelistEntry: icdv4.WhitelistEntry{
+			Address:     address,
+			Description: d.Get("description").(string),
+		},
+	}
+	unlock := lockDatabaseTasks(deploymentID)
+	defer unlock()
+
+	task, err := icdClient.Whitelists().CreateWhitelist(EscapeUrlParm(deploymentID), whitelistReq)
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf("Error creating database allowlist entry %s: %s", address, err))
+	}
+	_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf(
+			"Error waiting for database (%s) allowlist create task to complete for entry %s : %s", deploymentID, address, err))
+	}
+
+	d.SetId(databaseChildID(deploymentID, "allowlist", address))
+
+	return resourceIBMDatabaseAllowlistEntryRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistEntryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
+	}
+	deploymentID, address, err := parseDatabaseChildID(d.Id(), "allowlist")
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	whitelist, err := icdClient.Whitelists().GetWhitelist(EscapeUrlParm(deploymentID))
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Removing database allowlist entry (%s) from state because the database is not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(context, fmt.Errorf("Error getting database allowlist: %s", err))
+	}
+	entry, ok := findDatabaseAllowlistEntry(whitelist, address)
+	if !ok {
+		log.Printf("[WARN] Removing database allowlist entry (%s) from state because it's not found via the API", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("address", entry.Address)
+	d.Set("description", entry.Description)
+
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
+	}
+	deploymentID, address, err := parseDatabaseChildID(d.Id(), "allowlist")
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	unlock := lockDatabaseTasks(deploymentID)
+	defer unlock()
+
+	task, err := icdClient.Whitelists().DeleteWhitelist(EscapeUrlParm(deploymentID), address)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(context, fmt.Errorf("Error deleting database allowlist entry %s: %s", address, err))
+	}
+	_, err = waitForDatabaseTaskComplete(context, task.Id, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf(
+			"Error waiting for database (%s) allowlist delete task to complete for entry %s : %s", deploymentID, address, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func findDatabaseAllowlistEntry(whitelist icdv4.Whitelist, address string) (icdv4.WhitelistEntry, bool) {
+	for _, entry := range whitelist.WhitelistEntrys {
+		if entry.Address == address {
+			return entry, true
+		}
+	}
+	return icdv4.WhitelistEntry{}, false
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseAllowlistEntry_Basic(t *testing.T) {
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseAllowlistEntryBasic(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists("ibm_database."+testName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr("ibm_database_allowlist_entry.office", "address", "172.168.1.2/32"),
+					resource.TestCheckResourceAttr("ibm_database_allowlist_entry.office", "description", "office"),
+					resource.TestCheckResourceAttr("ibm_database_allowlist_entry.vpn", "address", "172.168.2.0/24"),
+				),
+			},
+			{
+				ResourceName:      "ibm_database_allowlist_entry.vpn",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseAllowlistEntryBasic(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-postgresql"
+		plan                         = "standard"
+		location                     = "us-south"
+		members_memory_allocation_mb = 2048
+		members_disk_allocation_mb   = 10240
+
+		lifecycle {
+		  ignore_changes = [whitelist]
+		}
+	}
+
+	resource "ibm_database_allowlist_entry" "office" {
+		deployment_id = ibm_database.%[2]s.id
+		address       = "172.168.1.2/32"
+		description   = "office"
+	}
+
+	resource "ibm_database_allowlist_entry" "vpn" {
+		deployment_id = ibm_database.%[2]s.id
+		address       = "172.168.2.0/24"
+		description   = "vpn"
+	}
+				`, databaseResourceGroup, name)
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+)
+
+// resourceIBMDatabaseReadReplica is a database instance provisioned as the read-only replica of a leader instance.
+// It is managed like an ibm_database instance, without the arguments that only apply to a leader, and can be
+// promoted to a leader.
+func resourceIBMDatabaseReadReplica() *schema.Resource {
+	r := resourceIBMDatabaseInstance()
+	r.CreateContext = resourceIBMDatabaseReadReplicaCreate
+	r.ReadContext = resourceIBMDatabaseReadReplicaRead
+	r.UpdateContext = resourceIBMDatabaseReadReplicaUpdate
+
+	for _, k := range []string{"adminpassword", "users", "backup_id", "point_in_time_recovery_deployment_id", "point_in_time_recovery_time"} {
+		delete(r.Schema, k)
+	}
+	r.Schema["remote_leader_id"] = &schema.Schema{
+		Description: "The CRN of leader database",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+	r.Schema["promote_to_leader"] = &schema.Schema{
+		Description: "Set to true to promote the read replica to a leader. A promoted read replica can't be demoted",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	r.Schema["skip_initial_backup"] = &schema.Schema{
+		Description: "Whether the backup of the read replica taken when it is promoted is skipped, which makes the promotion faster",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	return r
+}
+
+// databaseRemotes is the replication of a database instance
+type databaseRemotes struct {
+	Remotes struct {
+		Leader   string   `json:"leader"`
+		Replicas []string `json:"replicas"`
+	} `json:"remotes"`
+}
+
+type databasePromotionReq struct {
+	Promotion struct {
+		SkipInitialBackup bool `json:"skip_initial_backup,omitempty"`
+	} `json:"promotion"`
+}
+
+func resourceIBMDatabaseReadReplicaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourceIBMDatabaseInstanceCreate(context, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	if d.Get("promote_to_leader").(bool) {
+		if err := promoteDatabaseReadReplica(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+	return resourceIBMDatabaseReadReplicaRead(context, d, meta)
+}
+
+func resourceIBMDatabaseReadReplicaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourceIBMDatabaseInstanceRead(context, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	requester, err := getICDRequester(meta)
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	remotes := databaseRemotes{}
+	_, err = requester.Get(fmt.Sprintf("/v4/ibm/deployments/%s/remotes", EscapeUrlParm(d.Id())), &remotes)
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf("Error getting database remotes: %s", err))
+	}
+	// A promoted read replica has no leader anymore, while the remote_leader_id it was provisioned with is kept
+	if remotes.Remotes.Leader != "" {
+		d.Set("remote_leader_id", remotes.Remotes.Leader)
+	}
+	d.Set("promote_to_leader", remotes.Remotes.Leader == "")
+	return nil
+}
+
+func resourceIBMDatabaseReadReplicaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("promote_to_leader") {
+		if !d.Get("promote_to_leader").(bool) {
+			return diagFromErr(context, errorAt("promote_to_leader", fmt.Errorf("The database (%s) is already promoted to a leader and can't be demoted to a read replica", d.Id())))
+		}
+		if err := promoteDatabaseReadReplica(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+	if diags := resourceIBMDatabaseInstanceUpdate(context, d, meta); diags.HasError() {
+		return diags
+	}
+	return resourceIBMDatabaseReadReplicaRead(context, d, meta)
+}
+
+// promoteDatabaseReadReplica promotes a read replica to an independent leader, which stops the replication from its
+// leader
+func promoteDatabaseReadReplica(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	requester, err := getICDRequester(meta)
+	if err != nil {
+		return err
+	}
+	promotionReq := databasePromotionReq{}
+	promotionReq.Promotion.SkipInitialBackup = d.Get("skip_initial_backup").(bool)
+
+	unlock := lockDatabaseTasks(d.Id())
+	defer unlock()
+
+	taskResult := icdv4.TaskResult{}
+	_, err = requester.Post(fmt.Sprintf("/v4/ibm/deployments/%s/remotes/promotion", EscapeUrlParm(d.Id())), &promotionReq, &taskResult)
+	if err != nil {
+		return fmt.Errorf("Error promoting database read replica (%s): %s", d.Id(), err)
+	}
+	_, err = waitForDatabaseTaskComplete(context, taskResult.Task.Id, d, meta, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for database (%s) promotion task to complete: %s", d.Id(), err)
+	}
+	return nil
+}
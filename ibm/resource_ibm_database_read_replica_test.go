@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseReadReplica_Basic(t *testing.T) {
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_read_replica.replica"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseReadReplicaBasic(databaseResourceGroup, testName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "name", testName+"-replica"),
+					resource.TestCheckResourceAttr(name, "location", "us-east"),
+					resource.TestCheckResourceAttr(name, "promote_to_leader", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseReadReplicaBasic(databaseResourceGroup, testName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "promote_to_leader", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReadReplicaBasic(databaseResourceGroup string, name string, promote bool) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-postgresql"
+		plan                         = "standard"
+		location                     = "us-south"
+		members_memory_allocation_mb = 2048
+		members_disk_allocation_mb   = 10240
+	}
+
+	resource "ibm_database_read_replica" "replica" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s-replica"
+		service                      = "databases-for-postgresql"
+		plan                         = "standard"
+		location                     = "us-east"
+		remote_leader_id             = ibm_database.%[2]s.id
+		members_memory_allocation_mb = 2048
+		members_disk_allocation_mb   = 10240
+		promote_to_leader            = %[3]t
+		skip_initial_backup          = true
+	}
+				`, databaseResourceGroup, name, promote)
+}
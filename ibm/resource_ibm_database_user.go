@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+const (
+	databaseUserTypeDatabase = "database"
+)
+
+func resourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The ID of the database instance",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "User name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 32),
+			},
+			"password": {
+				Description:  "User password",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(10, 32),
+			},
+			"type": {
+				Description:  "User type, database for the users of the database or ops_manager for the users of the MongoDB Ops Manager",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      databaseUserTypeDatabase,
+				ValidateFunc: validateAllowedStringValue([]string{"database", "ops_manager", "read_only_replica"}),
+			},
+			"role": {
+				Description:      "User role, such as ibm-cloud-base-user for PostgreSQL or group_read_only_admin for the MongoDB Ops Manager",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: databaseUserRoleDiffSuppress,
+			},
+		},
+	}
+}
+
+// databaseUserRoleDiffSuppress ignores the role of an imported user, which the API doesn't return, instead of
+// replacing the user
+func databaseUserRoleDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+// databaseUserReq is the request to create or update a user of a given type, which icdv4.UserReq doesn't model
+type databaseUserReq struct {
+	User databaseUser `json:"user"`
+}
+
+type databaseUser struct {
+	UserName string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+func databaseUserPath(deploymentID, userType, name string) string {
+	path := fmt.Sprintf("/v4/ibm/deployments/%s/users/%s", EscapeUrlParm(deploymentID), userType)
+	if name != "" {
+		path += "/" + name
+	}
+	return path
+}
+
+func parseDatabaseUserID(id string) (deploymentID, userType, name string, err error) {
+	deploymentID, user, err := parseDatabaseChildID(id, "users")
+	if err != nil {
+		return "", "", "", err
+	}
+	parts, err := idParts(user)
+	if err != nil || len(parts) != 2 {
+		return "", "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of deploymentID/users/type/name", id)
+	}
+	return deploymentID, parts[0], parts[1], nil
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	requester, err := getICDRequester(meta)
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	deploymentID := d.Get("deployment_id").(string)
+	userType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	userReq := databaseUserReq{
+		User: databaseUser{
+			UserName: name,
+			Password: d.Get("password").(string),
+			Role:     d.Get("role").(string),
+		},
+	}
+	unlock := lockDatabaseTasks(deploymentID)
+	defer unlock()
+
+	taskResult := icdv4.TaskResult{}
+	_, err = requester.Post(databaseUserPath(deploymentID, userType, ""), &userReq, &taskResult)
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf("Error creating database user (%s): %s", name, err))
+	}
+	_, err = waitForDatabaseTaskComplete(context, taskResult.Task.Id, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf(
+			"Error waiting for database (%s) user (%s) create task to complete: %s", deploymentID, name, err))
+	}
+
+	d.SetId(databaseChildID(deploymentID, "users", userType+"/"+name))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, userType, name, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	//ICD does not implement a GetUsers API. The database users are checked through their connection strings.
+	if userType == databaseUserTypeDatabase {
+		icdClient, err := meta.(ClientSession).ICDAPI()
+		if err != nil {
+			return diagFromErr(context, fmt.Errorf("Error getting database client settings: %s", err))
+		}
+		_, err = icdClient.Connections().GetConnection(EscapeUrlParm(deploymentID), name)
+		if err != nil {
+			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				log.Printf("[WARN] Removing database user (%s) from state because it's not found via the API", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return diagFromErr(context, fmt.Errorf("Error getting database user (%s): %s", name, err))
+		}
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("password") {
+		requester, err := getICDRequester(meta)
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+		deploymentID, userType, name, err := parseDatabaseUserID(d.Id())
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+
+		userReq := databaseUserReq{
+			User: databaseUser{
+				Password: d.Get("password").(string),
+			},
+		}
+		unlock := lockDatabaseTasks(deploymentID)
+		defer unlock()
+
+		taskResult := icdv4.TaskResult{}
+		_, err = requester.Patch(databaseUserPath(deploymentID, userType, name), &userReq, &taskResult)
+		if err != nil {
+			return diagFromErr(context, fmt.Errorf("Error updating database user (%s) password: %s", name, err))
+		}
+		_, err = waitForDatabaseTaskComplete(context, taskResult.Task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(context, fmt.Errorf(
+				"Error waiting for database (%s) user (%s) password update task to complete: %s", deploymentID, name, err))
+		}
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	requester, err := getICDRequester(meta)
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	deploymentID, userType, name, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	unlock := lockDatabaseTasks(deploymentID)
+	defer unlock()
+
+	taskResult := icdv4.TaskResult{}
+	_, err = requester.DeleteWithResp(databaseUserPath(deploymentID, userType, name), &taskResult)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(context, fmt.Errorf("Error deleting database user (%s): %s", name, err))
+	}
+	_, err = waitForDatabaseTaskComplete(context, taskResult.Task.Id, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, fmt.Errorf(
+			"Error waiting for database (%s) user (%s) delete task to complete: %s", deploymentID, name, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
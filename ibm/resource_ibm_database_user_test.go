@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseUser_Basic(t *testing.T) {
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseUserBasic(databaseResourceGroup, testName, "password12"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists("ibm_database."+testName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "name", "user123"),
+					resource.TestCheckResourceAttr(name, "type", "database"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserBasic(databaseResourceGroup, testName, "password13"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "password", "password13"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "role"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUserBasic(databaseResourceGroup string, name string, password string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-postgresql"
+		plan                         = "standard"
+		location                     = "us-south"
+		members_memory_allocation_mb = 2048
+		members_disk_allocation_mb   = 10240
+	}
+
+	resource "ibm_database_user" "user" {
+		deployment_id = ibm_database.%[2]s.id
+		name          = "user123"
+		password      = "%[3]s"
+	}
+				`, databaseResourceGroup, name, password)
+}
+
+func TestParseDatabaseUserID(t *testing.T) {
+	deployment := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/4ea1882a2d3401ed1e459979941966ea:367b0a2f-1f8e-4b8f-8e5c-f1e1e8a3ad53::"
+	id := databaseChildID(deployment, "users", "database/user123")
+
+	deploymentID, userType, name, err := parseDatabaseUserID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deploymentID != deployment || userType != "database" || name != "user123" {
+		t.Errorf("parseDatabaseUserID(%q) = %q, %q, %q", id, deploymentID, userType, name)
+	}
+
+	for _, id := range []string{deployment, deployment + "/users/user123", deployment + "/allowlist/10.0.0.0/24"} {
+		if _, _, _, err := parseDatabaseUserID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+
+	deploymentID, address, err := parseDatabaseChildID(databaseChildID(deployment, "allowlist", "10.0.0.0/24"), "allowlist")
+	if err != nil || deploymentID != deployment || address != "10.0.0.0/24" {
+		t.Errorf("got %q, %q, %v for an allowlist entry", deploymentID, address, err)
+	}
+}
+
+func TestDatabaseUserRoleDiffSuppress(t *testing.T) {
+	d := resourceIBMDatabaseUser().TestResourceData()
+	if databaseUserRoleDiffSuppress("role", "", "ibm-cloud-base-user", d) {
+		t.Error("expected the role of a new user not to be suppressed")
+	}
+	d.SetId("crn:v1:bluemix:public:databases-for-postgresql:us-south:a/1234::/users/database/user123")
+	if !databaseUserRoleDiffSuppress("role", "", "ibm-cloud-base-user", d) {
+		t.Error("expected the role of an imported user to be suppressed")
+	}
+	if databaseUserRoleDiffSuppress("role", "ibm-cloud-base-user", "group_read_only_admin", d) {
+		t.Error("expected a change of role to be kept")
+	}
+}
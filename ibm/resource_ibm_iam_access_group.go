@@ -73,7 +73,7 @@ func resourceIBMIAMAccessGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	agrp, err := iamuumClient.AccessGroup().Create(request, userDetails.userAccount)
 	if err != nil {
-		return fmt.Errorf("Error creating access group: %s", err)
+		return fmt.Errorf("Error creating access group: %w", err)
 	}
 
 	d.SetId(agrp.ID)
@@ -90,7 +90,7 @@ func resourceIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) err
 
 	agrp, version, err := iamuumClient.AccessGroup().Get(agrpID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group: %s", err)
+		return fmt.Errorf("Error retrieving access group: %w", err)
 	}
 
 	d.Set("name", agrp.Name)
@@ -124,7 +124,7 @@ func resourceIBMIAMAccessGroupUpdate(d *schema.ResourceData, meta interface{}) e
 	if hasChange {
 		_, err = iamuumClient.AccessGroup().Update(agrpID, updateReq, d.Get("version").(string))
 		if err != nil {
-			return fmt.Errorf("Error updating access group: %s", err)
+			return fmt.Errorf("Error updating access group: %w", err)
 		}
 	}
 
@@ -142,7 +142,7 @@ func resourceIBMIAMAccessGroupDelete(d *schema.ResourceData, meta interface{}) e
 
 	err = iamuumClient.AccessGroup().Delete(agID, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting access group: %s", err)
+		return fmt.Errorf("Error deleting access group: %w", err)
 	}
 
 	d.SetId("")
@@ -164,7 +164,7 @@ func resourceIBMIAMAccessGroupExists(d *schema.ResourceData, meta interface{}) (
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, fmt.Errorf("Error communicating with the API: %w", err)
 	}
 
 	return agrp.ID == agID, nil
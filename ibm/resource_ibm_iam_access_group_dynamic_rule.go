@@ -111,7 +111,7 @@ func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := iamuumClient.DynamicRule().Create(grpID, createRuleReq)
 	if err != nil {
-		return err
+		return newAPIError(err, response)
 	}
 	ruleID := response.RuleID
 	d.SetId(fmt.Sprintf("%s/%s", grpID, ruleID))
@@ -167,7 +167,7 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	ruleID := parts[1]
 	_, etag, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group Rules: %s", err)
+		return fmt.Errorf("Error retrieving access group Rules: %w", err)
 	}
 
 	name := d.Get("name").(string)
@@ -251,7 +251,7 @@ func resourceIBMIAMDynamicRuleExists(d *schema.ResourceData, meta interface{}) (
 				return false, nil
 			}
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
+		return false, fmt.Errorf("Error communicating with the API: %w", err)
 	}
 
 	return rules.AccessGroupID == grpID, nil
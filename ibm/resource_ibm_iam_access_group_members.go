@@ -123,7 +123,7 @@ func resourceIBMIAMAccessGroupMembersRead(d *schema.ResourceData, meta interface
 
 	members, err := iamuumClient.AccessGroupMember().List(grpID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group members: %s", err)
+		return fmt.Errorf("Error retrieving access group members: %w", err)
 	}
 
 	d.Set("access_group_id", grpID)
@@ -142,7 +142,7 @@ func resourceIBMIAMAccessGroupMembersRead(d *schema.ResourceData, meta interface
 	client := userManagement.UserInvite()
 	res, err := client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return newAPIError(err, res)
 	}
 
 	iamClient, err := meta.(ClientSession).IAMAPI()
@@ -24,7 +24,7 @@ func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importAccessGroupPolicy(d, meta)
 				if err != nil {
-					return nil, fmt.Errorf("Error reading resource ID: %s", err)
+					return nil, fmt.Errorf("Error reading resource ID: %w", err)
 				}
 				d.Set("resources", resources)
 				d.Set("resource_attributes", resourceAttributes)
@@ -350,7 +350,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating access group policy: %s\n%s", err, res)
+			return fmt.Errorf("Error updating access group policy: %w", newAPIError(err, res))
 		}
 	}
 
@@ -376,7 +376,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 
 	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting access group policy: %s\n%s", err, res)
+		return fmt.Errorf("Error deleting access group policy: %w", newAPIError(err, res))
 	}
 
 	d.SetId("")
@@ -438,7 +438,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 
 	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error retrieving access group policy: %s\n%s", err, res)
+		return nil, nil, fmt.Errorf("Error retrieving access group policy: %w", newAPIError(err, res))
 	}
 
 	resources := flattenPolicyResource(accessGroupPolicy.Resources)
@@ -16,6 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_iam_access_group", &resource.Sweeper{
+		Name: "ibm_iam_access_group",
+		F:    testSweepIAMAccessGroups,
+	})
+}
+
 func TestAccIBMIAMAccessGroup_Basic(t *testing.T) {
 	var conf models.AccessGroupV2
 	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -173,3 +180,32 @@ func testAccCheckIBMIAMAccessGroupTag(name string) string {
 		}
 	`, name)
 }
+
+func testSweepIAMAccessGroups(region string) error {
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	iamuumClient, err := meta.IAMUUMAPIV2()
+	if err != nil {
+		return err
+	}
+	userDetails, err := meta.BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	groups, err := iamuumClient.AccessGroup().List(userDetails.userAccount)
+	if err != nil {
+		return fmt.Errorf("Error Fetching access groups: %s", err)
+	}
+	var errs []error
+	for _, group := range groups {
+		if !isSweepable(group.Name) {
+			continue
+		}
+		if err := sweepResource(resourceIBMIAMAccessGroup(), "ibm_iam_access_group", group.ID, group.Name, nil, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return sweepErrors("ibm_iam_access_group", errs)
+}
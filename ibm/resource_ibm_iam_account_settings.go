@@ -189,7 +189,7 @@ func resourceIbmIamAccountSettingsCreate(context context.Context, d *schema.Reso
 	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
 	if err != nil {
 		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s", *accountSettingsResponse.AccountID))
@@ -215,7 +215,7 @@ func resourceIbmIamAccountSettingsRead(context context.Context, d *schema.Resour
 			return nil
 		}
 		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	if err = d.Set("restrict_create_service_id", accountSettingsResponse.RestrictCreateServiceID); err != nil {
@@ -328,7 +328,7 @@ func resourceIbmIamAccountSettingsUpdate(context context.Context, d *schema.Reso
 		_, response, err := iamIdentityClient.UpdateAccountSettings(updateAccountSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettings failed %s\n%s", err, response)
-			return diag.FromErr(err)
+			return diagFromErr(context, newAPIError(err, response))
 		}
 	}
 
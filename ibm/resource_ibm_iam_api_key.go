@@ -141,7 +141,7 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	d.SetId(*apiKey.ID)
@@ -230,7 +230,7 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 	_, response, err := iamIdentityClient.UpdateAPIKey(updateApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] UpdateApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	return resourceIbmIamApiKeyRead(context, d, meta)
@@ -249,7 +249,7 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 	response, err := iamIdentityClient.DeleteAPIKey(deleteApiKeyOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	d.SetId("")
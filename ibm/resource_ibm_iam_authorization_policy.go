@@ -214,7 +214,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 	authPolicy, _, err := iampapClient.CreatePolicy(createPolicyOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error creating authorization policy: %s", err)
+		return fmt.Errorf("Error creating authorization policy: %w", err)
 	}
 
 	d.SetId(*authPolicy.ID)
@@ -235,7 +235,7 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 
 	authorizationPolicy, _, err := iampapClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error retrieving authorizationPolicy: %s", err)
+		return fmt.Errorf("Error retrieving authorizationPolicy: %w", err)
 	}
 	roles := make([]string, len(authorizationPolicy.Roles))
 	for i, role := range authorizationPolicy.Roles {
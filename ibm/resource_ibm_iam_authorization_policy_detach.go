@@ -40,7 +40,7 @@ func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta
 	)
 	_, err = iampapClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error detaching authorization policy: %s", err)
+		return fmt.Errorf("Error detaching authorization policy: %w", err)
 	}
 
 	d.SetId(time.Now().UTC().String())
@@ -232,7 +232,7 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, response, err = iamPolicyManagementClient.UpdateRole(roleUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Custom Roles: %s\n%s", err, response)
+			return fmt.Errorf("Error updating Custom Roles: %w", newAPIError(err, response))
 		}
 	}
 
@@ -276,7 +276,7 @@ func resourceIBMIAMCustomRoleExists(d *schema.ResourceData, meta interface{}) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error retrieving Custom Roles: %s\n%s", err, response)
+		return false, fmt.Errorf("Error retrieving Custom Roles: %w", newAPIError(err, response))
 	}
 
 	return *role.ID == roleID, nil
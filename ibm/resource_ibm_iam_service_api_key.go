@@ -273,7 +273,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if hasChange {
 		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return fmt.Errorf("[DEBUG] Error updating Service API Key: %w", newAPIError(err, response))
 		}
 	}
 
@@ -298,7 +298,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %w", newAPIError(err, response))
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
@@ -307,7 +307,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %w", newAPIError(err, resp))
 	}
 	d.SetId("")
 
@@ -330,7 +330,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error retrieving Service API Key: %s\n%s", err, response)
+		return false, fmt.Errorf("Error retrieving Service API Key: %w", newAPIError(err, response))
 	}
 	return *apiKey.ID == apiKeyID, nil
 }
@@ -338,7 +338,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 	outputFilePath, err := homedir.Expand(filePath)
 	if err != nil {
-		return fmt.Errorf("Error generating API Key file path: %s", err)
+		return fmt.Errorf("Error generating API Key file path: %w", err)
 	}
 
 	key := &APIKey{
@@ -168,7 +168,7 @@ func resourceIBMIAMServiceIDUpdate(context context.Context, d *schema.ResourceDa
 		_, resp, err := iamIdentityClient.UpdateServiceID(&updateServiceIDOptions)
 		if err != nil {
 			log.Printf("Error updating serviceID: %s, %s", err, resp)
-			return diag.FromErr(err)
+			return diagFromErr(context, newAPIError(err, resp))
 		}
 	}
 
@@ -189,7 +189,7 @@ func resourceIBMIAMServiceIDDelete(context context.Context, d *schema.ResourceDa
 	resp, err := iamIdentityClient.DeleteServiceID(&deleteServiceIDOptions)
 	if err != nil {
 		log.Printf("Error deleting serviceID: %s %s", err, resp)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, resp))
 	}
 
 	d.SetId("")
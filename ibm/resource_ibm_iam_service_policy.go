@@ -25,7 +25,7 @@ func resourceIBMIAMServicePolicy() *schema.Resource {
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
 				if err != nil {
-					return nil, fmt.Errorf("Error reading resource ID: %s", err)
+					return nil, fmt.Errorf("Error reading resource ID: %w", err)
 				}
 				d.Set("resources", resources)
 				d.Set("resource_attributes", resourceAttributes)
@@ -215,7 +215,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating servicePolicy: %s %s", err, res)
+		return fmt.Errorf("Error creating servicePolicy: %w", newAPIError(err, res))
 	}
 
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
@@ -410,7 +410,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating service policy: %s", err)
+			return fmt.Errorf("Error updating service policy: %w", err)
 		}
 
 	}
@@ -437,7 +437,7 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting service policy: %s", err)
+		return fmt.Errorf("Error deleting service policy: %w", err)
 	}
 
 	d.SetId("")
@@ -497,7 +497,7 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 	)
 	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error retrieving servicePolicy: %s", err)
+		return nil, nil, fmt.Errorf("Error retrieving servicePolicy: %w", err)
 	}
 	resources := flattenPolicyResource(servicePolicy.Resources)
 	resource_attributes := flattenPolicyResourceAttributes(servicePolicy.Resources)
@@ -554,7 +554,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	}
 	res, err := Client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return newAPIError(err, res)
 	}
 	users := make([]string, 0)
 	invitedUsers := make([]map[string]interface{}, 0, len(res))
@@ -580,7 +580,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		policies := policyList.Policies
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving user policies: %s", err)
+			return fmt.Errorf("Error retrieving user policies: %w", err)
 		}
 		userPolicies := make([]map[string]interface{}, 0, len(policies))
 		for _, policy := range policies {
@@ -602,7 +602,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		// Get AccessGroups associated with user
 		retreivedGroups, err := iamuumClient.AccessGroup().List(accountID, user.IamID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving access groups: %s", err)
+			return fmt.Errorf("Error retrieving access groups: %w", err)
 		}
 
 		accGroupList := make([]map[string]interface{}, 0, len(retreivedGroups))
@@ -614,7 +614,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 			})
 			accgrpPolicy := policyList.Policies
 			if err != nil {
-				return fmt.Errorf("Error retrieving access group policy: %s", err)
+				return fmt.Errorf("Error retrieving access group policy: %w", err)
 			}
 
 			//Fetch access group policies
@@ -793,7 +793,7 @@ func resourceIBMIAMGetUserProfileExists(d *schema.ResourceData, meta interface{}
 
 	res, err := Client.ListUsers(accountID)
 	if err != nil {
-		return false, err
+		return false, newAPIError(err, res)
 	}
 	var isFound bool
 	for _, user := range usersList {
@@ -834,7 +834,7 @@ func getUserIAMID(d *schema.ResourceData, meta interface{}, user string) (string
 
 	res, err := Client.ListUsers(accountID)
 	if err != nil {
-		return "", err
+		return "", newAPIError(err, res)
 	}
 
 	for _, userInfo := range res {
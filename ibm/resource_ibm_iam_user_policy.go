@@ -24,7 +24,7 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
 				if err != nil {
-					return nil, fmt.Errorf("Error reading resource ID: %s", err)
+					return nil, fmt.Errorf("Error reading resource ID: %w", err)
 				}
 				d.Set("resources", resources)
 				d.Set("resource_attributes", resourceAttributes)
@@ -368,7 +368,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 		policy, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating user policy: %s", err)
+			return fmt.Errorf("Error updating user policy: %w", err)
 		}
 	}
 	return resourceIBMIAMUserPolicyRead(d, meta)
@@ -452,7 +452,7 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	)
 	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error retrieving User Policy: %s", err)
+		return nil, nil, fmt.Errorf("Error retrieving User Policy: %w", err)
 	}
 	resources := flattenPolicyResource(userPolicy.Resources)
 	resource_attributes := flattenPolicyResourceAttributes(userPolicy.Resources)
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBackupPolicyName               = "name"
+	isBackupPolicyMatchUserTags      = "match_user_tags"
+	isBackupPolicyMatchResourceType  = "match_resource_type"
+	isBackupPolicyResourceGroup      = "resource_group"
+	isBackupPolicyPlans              = "plans"
+	isBackupPolicyHealthState        = "health_state"
+	isBackupPolicyLifecycleState     = "lifecycle_state"
+	isBackupPolicyLastJobCompletedAt = "last_job_completed_at"
+
+	isBackupPolicyLifecycleStatePending  = "pending"
+	isBackupPolicyLifecycleStateUpdating = "updating"
+	isBackupPolicyLifecycleStateStable   = "stable"
+	isBackupPolicyLifecycleStateDeleting = "deleting"
+	isBackupPolicyLifecycleStateFailed   = "failed"
+	isBackupPolicyDeleteDone             = "done"
+)
+
+func resourceIBMISBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISBackupPolicyCreate,
+		ReadContext:   resourceIBMISBackupPolicyRead,
+		UpdateContext: resourceIBMISBackupPolicyUpdate,
+		DeleteContext: resourceIBMISBackupPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isBackupPolicyMatchUserTags: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_is_backup_policy", "tag")},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags the backup policy applies to. A resource with any of these tags is backed up by the plans of the backup policy.",
+			},
+			isBackupPolicyMatchResourceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      vpcext.BackupPolicyMatchResourceTypeVolume,
+				ValidateFunc: InvokeValidator("ibm_is_backup_policy", isBackupPolicyMatchResourceType),
+				Description:  "The type of the resources the backup policy applies to: volume or instance.",
+			},
+			isBackupPolicyName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_backup_policy", isBackupPolicyName),
+				Description:  "The unique user-defined name for this backup policy.",
+			},
+			isBackupPolicyResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			isBackupPolicyPlans: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plans of the backup policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the plan.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the plan.",
+						},
+					},
+				},
+			},
+			isBackupPolicyHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health state of the backup policy.",
+			},
+			isBackupPolicyLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the backup policy.",
+			},
+			isBackupPolicyLastJobCompletedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the most recent job of the backup policy completed.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the backup policy was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this backup policy.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this backup policy.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISBackupPolicyValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBackupPolicyMatchResourceType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", vpcext.BackupPolicyMatchResourceTypeVolume, vpcext.BackupPolicyMatchResourceTypeInstance),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBackupPolicyName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_backup_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMISBackupPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matchResourceType := d.Get(isBackupPolicyMatchResourceType).(string)
+	prototype := &vpcext.BackupPolicyPrototype{
+		MatchResourceType: &matchResourceType,
+		MatchUserTags:     expandStringList(d.Get(isBackupPolicyMatchUserTags).(*schema.Set).List()),
+	}
+	if name, ok := d.GetOk(isBackupPolicyName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if resourceGroup, ok := d.GetOk(isBackupPolicyResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		prototype.ResourceGroup = &vpcext.Reference{ID: &resourceGroupStr}
+	}
+
+	backupPolicy, response, err := client.CreateBackupPolicy(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBackupPolicy failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(*backupPolicy.ID)
+	log.Printf("[INFO] Backup policy : %s", d.Id())
+
+	_, err = isWaitForBackupPolicyStable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISBackupPolicyRead(context, d, meta)
+}
+
+func resourceIBMISBackupPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicy, response, err := client.GetBackupPolicy(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBackupPolicy failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	for k, v := range dataSourceIBMISBackupPolicyToMap(*backupPolicy) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMISBackupPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	if d.HasChange(isBackupPolicyName) {
+		patch[isBackupPolicyName] = d.Get(isBackupPolicyName).(string)
+	}
+	if d.HasChange(isBackupPolicyMatchUserTags) {
+		patch[isBackupPolicyMatchUserTags] = expandStringList(d.Get(isBackupPolicyMatchUserTags).(*schema.Set).List())
+	}
+	if len(patch) > 0 {
+		_, response, err := client.UpdateBackupPolicy(context, d.Id(), patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBackupPolicy failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	return resourceIBMISBackupPolicyRead(context, d, meta)
+}
+
+func resourceIBMISBackupPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.DeleteBackupPolicy(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBackupPolicy failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForBackupPolicyDeleted(context, client, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBackupPolicyStable(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyLifecycleStatePending, isBackupPolicyLifecycleStateUpdating},
+		Target:  []string{isBackupPolicyLifecycleStateStable, isBackupPolicyLifecycleStateFailed},
+		Refresh: func() (interface{}, string, error) {
+			backupPolicy, response, err := client.GetBackupPolicy(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Backup Policy: %w", newAPIError(err, response))
+			}
+			if *backupPolicy.LifecycleState == isBackupPolicyLifecycleStateFailed {
+				return backupPolicy, *backupPolicy.LifecycleState, fmt.Errorf("Backup policy (%s) went into failed state during the operation", id)
+			}
+			return backupPolicy, *backupPolicy.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForBackupPolicyDeleted(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyLifecycleStateDeleting, isBackupPolicyLifecycleStateStable},
+		Target:  []string{isBackupPolicyDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			backupPolicy, response, err := client.GetBackupPolicy(ctx, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return backupPolicy, isBackupPolicyDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Backup Policy: %w", newAPIError(err, response))
+			}
+			if *backupPolicy.LifecycleState == isBackupPolicyLifecycleStateFailed {
+				return backupPolicy, *backupPolicy.LifecycleState, fmt.Errorf("Backup policy (%s) went into failed state during the deletion", id)
+			}
+			return backupPolicy, isBackupPolicyLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
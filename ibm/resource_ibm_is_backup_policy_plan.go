@@ -0,0 +1,468 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBackupPolicyPlanBackupPolicy          = "backup_policy"
+	isBackupPolicyPlanID                    = "backup_policy_plan"
+	isBackupPolicyPlanCronSpec              = "cron_spec"
+	isBackupPolicyPlanActive                = "active"
+	isBackupPolicyPlanAttachUserTags        = "attach_user_tags"
+	isBackupPolicyPlanCopyUserTags          = "copy_user_tags"
+	isBackupPolicyPlanName                  = "name"
+	isBackupPolicyPlanDeleteAfter           = "deletion_trigger_delete_after"
+	isBackupPolicyPlanDeleteOverCount       = "deletion_trigger_delete_over_count"
+	isBackupPolicyPlanRemoteRegionPolicy    = "remote_region_policy"
+	isBackupPolicyPlanRemoteRegion          = "region"
+	isBackupPolicyPlanRemoteDeleteOverCount = "delete_over_count"
+	isBackupPolicyPlanRemoteEncryptionKey   = "encryption_key"
+	isBackupPolicyPlanLifecycleState        = "lifecycle_state"
+)
+
+func resourceIBMISBackupPolicyPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISBackupPolicyPlanCreate,
+		ReadContext:   resourceIBMISBackupPolicyPlanRead,
+		UpdateContext: resourceIBMISBackupPolicyPlanUpdate,
+		DeleteContext: resourceIBMISBackupPolicyPlanDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isBackupPolicyPlanBackupPolicy: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the backup policy.",
+			},
+			isBackupPolicyPlanCronSpec: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanCronSpec),
+				Description:  "The cron specification of the backup schedule, in UTC: minute, hour, day of month, month and day of week. The backups can be at most hourly.",
+			},
+			isBackupPolicyPlanActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the plan is active. An inactive plan doesn't create nor delete backups.",
+			},
+			isBackupPolicyPlanAttachUserTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_is_backup_policy_plan", "tag")},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags to attach to the backups created by the plan.",
+			},
+			isBackupPolicyPlanCopyUserTags: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the user tags of the source resource are copied to its backups.",
+			},
+			isBackupPolicyPlanName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanName),
+				Description:  "The user-defined name of the plan, unique within the backup policy.",
+			},
+			isBackupPolicyPlanDeleteAfter: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanDeleteAfter),
+				Description:  "The maximum number of days to keep a backup.",
+			},
+			isBackupPolicyPlanDeleteOverCount: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanDeleteOverCount),
+				Description:  "The maximum number of recent backups to keep of a resource. If unspecified, the backups are only deleted by age.",
+			},
+			isBackupPolicyPlanRemoteRegionPolicy: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The regions the backups are copied to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isBackupPolicyPlanRemoteRegion: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the region to copy the backups to.",
+						},
+						isBackupPolicyPlanRemoteDeleteOverCount: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: InvokeValidator("ibm_is_backup_policy_plan", isBackupPolicyPlanDeleteOverCount),
+							Description:  "The maximum number of recent copies to keep in the region.",
+						},
+						isBackupPolicyPlanRemoteEncryptionKey: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the root key of a key management service of the region to encrypt the copies with. If unspecified, the copies are encrypted with IBM-managed keys.",
+						},
+					},
+				},
+			},
+			isBackupPolicyPlanID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the plan.",
+			},
+			isBackupPolicyPlanLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the plan.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the plan was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this plan.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISBackupPolicyPlanValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	// The minute field is a single value so that backups run at most hourly.
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBackupPolicyPlanCronSpec,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[0-5]?\d( (\*(\/\d+)?|\d+(-\d+)?(\/\d+)?(,\d+(-\d+)?(\/\d+)?)*)){4}$`,
+			MinValueLength:             9,
+			MaxValueLength:             63,
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBackupPolicyPlanDeleteAfter,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "9999",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBackupPolicyPlanDeleteOverCount,
+			ValidateFunctionIdentifier: IntAtLeast,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBackupPolicyPlanName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_backup_policy_plan", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMISBackupPolicyPlanCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get(isBackupPolicyPlanBackupPolicy).(string)
+	cronSpec := d.Get(isBackupPolicyPlanCronSpec).(string)
+	active := d.Get(isBackupPolicyPlanActive).(bool)
+	copyUserTags := d.Get(isBackupPolicyPlanCopyUserTags).(bool)
+	prototype := &vpcext.BackupPolicyPlanPrototype{
+		Active:               &active,
+		CopyUserTags:         &copyUserTags,
+		CronSpec:             &cronSpec,
+		DeletionTrigger:      expandBackupPolicyPlanDeletionTrigger(d),
+		RemoteRegionPolicies: expandBackupPolicyPlanRemoteRegionPolicies(d.Get(isBackupPolicyPlanRemoteRegionPolicy).([]interface{})),
+	}
+	if attachUserTags, ok := d.GetOk(isBackupPolicyPlanAttachUserTags); ok {
+		prototype.AttachUserTags = expandStringList(attachUserTags.(*schema.Set).List())
+	}
+	if name, ok := d.GetOk(isBackupPolicyPlanName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+
+	plan, response, err := client.CreateBackupPolicyPlan(context, backupPolicyID, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBackupPolicyPlan failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", backupPolicyID, *plan.ID))
+	log.Printf("[INFO] Backup policy plan : %s", d.Id())
+
+	_, err = isWaitForBackupPolicyPlanStable(context, client, backupPolicyID, *plan.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISBackupPolicyPlanRead(context, d, meta)
+}
+
+func resourceIBMISBackupPolicyPlanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of backupPolicyID/planID", d.Id()))
+	}
+	backupPolicyID, planID := parts[0], parts[1]
+
+	plan, response, err := client.GetBackupPolicyPlan(context, backupPolicyID, planID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBackupPolicyPlan failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	d.Set(isBackupPolicyPlanBackupPolicy, backupPolicyID)
+	d.Set(isBackupPolicyPlanID, plan.ID)
+	d.Set(isBackupPolicyPlanCronSpec, plan.CronSpec)
+	d.Set(isBackupPolicyPlanActive, plan.Active)
+	d.Set(isBackupPolicyPlanAttachUserTags, plan.AttachUserTags)
+	d.Set(isBackupPolicyPlanCopyUserTags, plan.CopyUserTags)
+	d.Set(isBackupPolicyPlanName, plan.Name)
+	if plan.DeletionTrigger != nil {
+		d.Set(isBackupPolicyPlanDeleteAfter, plan.DeletionTrigger.DeleteAfter)
+		d.Set(isBackupPolicyPlanDeleteOverCount, plan.DeletionTrigger.DeleteOverCount)
+	}
+	if err = d.Set(isBackupPolicyPlanRemoteRegionPolicy, flattenBackupPolicyPlanRemoteRegionPolicies(plan.RemoteRegionPolicies)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting %s: %s", isBackupPolicyPlanRemoteRegionPolicy, err))
+	}
+	d.Set(isBackupPolicyPlanLifecycleState, plan.LifecycleState)
+	d.Set("created_at", plan.CreatedAt)
+	d.Set("href", plan.Href)
+	d.Set("resource_type", plan.ResourceType)
+
+	return nil
+}
+
+func resourceIBMISBackupPolicyPlanUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	for _, key := range []string{isBackupPolicyPlanCronSpec, isBackupPolicyPlanActive, isBackupPolicyPlanCopyUserTags, isBackupPolicyPlanName} {
+		if d.HasChange(key) {
+			patch[key] = d.Get(key)
+		}
+	}
+	if d.HasChange(isBackupPolicyPlanAttachUserTags) {
+		patch[isBackupPolicyPlanAttachUserTags] = expandStringList(d.Get(isBackupPolicyPlanAttachUserTags).(*schema.Set).List())
+	}
+	if d.HasChanges(isBackupPolicyPlanDeleteAfter, isBackupPolicyPlanDeleteOverCount) {
+		deletionTrigger := map[string]interface{}{
+			"delete_after": d.Get(isBackupPolicyPlanDeleteAfter).(int),
+		}
+		// a null count removes the retention by count
+		if deleteOverCount, ok := d.GetOk(isBackupPolicyPlanDeleteOverCount); ok {
+			deletionTrigger["delete_over_count"] = deleteOverCount.(int)
+		} else {
+			deletionTrigger["delete_over_count"] = nil
+		}
+		patch["deletion_trigger"] = deletionTrigger
+	}
+	if d.HasChange(isBackupPolicyPlanRemoteRegionPolicy) {
+		// an empty list, rather than a null one, stops all the copies
+		patch["remote_region_policies"] = append([]vpcext.BackupPolicyPlanRemoteRegionPolicy{}, expandBackupPolicyPlanRemoteRegionPolicies(d.Get(isBackupPolicyPlanRemoteRegionPolicy).([]interface{}))...)
+	}
+
+	if len(patch) > 0 {
+		backupPolicyID := d.Get(isBackupPolicyPlanBackupPolicy).(string)
+		planID := d.Get(isBackupPolicyPlanID).(string)
+		_, response, err := client.UpdateBackupPolicyPlan(context, backupPolicyID, planID, patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBackupPolicyPlan failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		_, err = isWaitForBackupPolicyPlanStable(context, client, backupPolicyID, planID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	return resourceIBMISBackupPolicyPlanRead(context, d, meta)
+}
+
+func resourceIBMISBackupPolicyPlanDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicyID := d.Get(isBackupPolicyPlanBackupPolicy).(string)
+	planID := d.Get(isBackupPolicyPlanID).(string)
+	response, err := client.DeleteBackupPolicyPlan(context, backupPolicyID, planID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteBackupPolicyPlan failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForBackupPolicyPlanDeleted(context, client, backupPolicyID, planID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandBackupPolicyPlanDeletionTrigger(d *schema.ResourceData) *vpcext.BackupPolicyPlanDeletionTrigger {
+	deleteAfter := int64(d.Get(isBackupPolicyPlanDeleteAfter).(int))
+	deletionTrigger := &vpcext.BackupPolicyPlanDeletionTrigger{DeleteAfter: &deleteAfter}
+	if deleteOverCount, ok := d.GetOk(isBackupPolicyPlanDeleteOverCount); ok {
+		deleteOverCountInt := int64(deleteOverCount.(int))
+		deletionTrigger.DeleteOverCount = &deleteOverCountInt
+	}
+	return deletionTrigger
+}
+
+func expandBackupPolicyPlanRemoteRegionPolicies(list []interface{}) []vpcext.BackupPolicyPlanRemoteRegionPolicy {
+	var policies []vpcext.BackupPolicyPlanRemoteRegionPolicy
+	for _, policy := range list {
+		policyMap := policy.(map[string]interface{})
+		region := policyMap[isBackupPolicyPlanRemoteRegion].(string)
+		deleteOverCount := int64(policyMap[isBackupPolicyPlanRemoteDeleteOverCount].(int))
+		remoteRegionPolicy := vpcext.BackupPolicyPlanRemoteRegionPolicy{
+			DeleteOverCount: &deleteOverCount,
+			Region:          &vpcext.Reference{Name: &region},
+		}
+		if encryptionKey := policyMap[isBackupPolicyPlanRemoteEncryptionKey].(string); encryptionKey != "" {
+			remoteRegionPolicy.EncryptionKey = &vpcext.Reference{CRN: &encryptionKey}
+		}
+		policies = append(policies, remoteRegionPolicy)
+	}
+	return policies
+}
+
+func flattenBackupPolicyPlanRemoteRegionPolicies(policies []vpcext.BackupPolicyPlanRemoteRegionPolicy) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		policyMap := map[string]interface{}{
+			isBackupPolicyPlanRemoteDeleteOverCount: policy.DeleteOverCount,
+		}
+		if policy.Region != nil {
+			policyMap[isBackupPolicyPlanRemoteRegion] = policy.Region.Name
+		}
+		if policy.EncryptionKey != nil {
+			policyMap[isBackupPolicyPlanRemoteEncryptionKey] = policy.EncryptionKey.CRN
+		}
+		list = append(list, policyMap)
+	}
+	return list
+}
+
+func isWaitForBackupPolicyPlanStable(ctx context.Context, client *vpcext.VpcExtV1, backupPolicyID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy plan (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyLifecycleStatePending, isBackupPolicyLifecycleStateUpdating},
+		Target:  []string{isBackupPolicyLifecycleStateStable, isBackupPolicyLifecycleStateFailed},
+		Refresh: func() (interface{}, string, error) {
+			plan, response, err := client.GetBackupPolicyPlan(ctx, backupPolicyID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Backup Policy Plan: %w", newAPIError(err, response))
+			}
+			if *plan.LifecycleState == isBackupPolicyLifecycleStateFailed {
+				return plan, *plan.LifecycleState, fmt.Errorf("Backup policy plan (%s) went into failed state during the operation", id)
+			}
+			return plan, *plan.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForBackupPolicyPlanDeleted(ctx context.Context, client *vpcext.VpcExtV1, backupPolicyID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for backup policy plan (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBackupPolicyLifecycleStateDeleting, isBackupPolicyLifecycleStateStable},
+		Target:  []string{isBackupPolicyDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			plan, response, err := client.GetBackupPolicyPlan(ctx, backupPolicyID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return plan, isBackupPolicyDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Backup Policy Plan: %w", newAPIError(err, response))
+			}
+			if *plan.LifecycleState == isBackupPolicyLifecycleStateFailed {
+				return plan, *plan.LifecycleState, fmt.Errorf("Backup policy plan (%s) went into failed state during the deletion", id)
+			}
+			return plan, isBackupPolicyLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
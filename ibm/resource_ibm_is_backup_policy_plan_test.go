@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISBackupPolicyPlanBasic(t *testing.T) {
+	policyName := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISBackupPolicyPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanConfig(policyName, name, "30 */2 * * *", 20, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISBackupPolicyPlanExists("ibm_is_backup_policy_plan.testacc_backup_plan"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "cron_spec", "30 */2 * * *"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "deletion_trigger_delete_after", "20"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "active", "true"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "lifecycle_state", "stable"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanConfig(policyName, name, "0 6 * * *", 10, `deletion_trigger_delete_over_count = 4`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "cron_spec", "0 6 * * *"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "deletion_trigger_delete_after", "10"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "deletion_trigger_delete_over_count", "4"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_backup_policy_plan.testacc_backup_plan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISBackupPolicyPlanRemoteRegion(t *testing.T) {
+	policyName := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-backup-plan-%d", acctest.RandIntRange(10, 100))
+	remoteRegionPolicy := `
+		remote_region_policy {
+		  region            = "us-east"
+		  delete_over_count = 2
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISBackupPolicyPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanConfig(policyName, name, "0 6 * * *", 30, remoteRegionPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "remote_region_policy.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "remote_region_policy.0.region", "us-east"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "remote_region_policy.0.delete_over_count", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBackupPolicyPlanConfig(policyName, name, "0 6 * * *", 30, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy_plan.testacc_backup_plan", "remote_region_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestIBMISBackupPolicyPlanCronSpec(t *testing.T) {
+	cases := []struct {
+		cronSpec string
+		valid    bool
+	}{
+		{"30 */2 * * *", true},
+		{"0 6 * * 1-5", true},
+		{"0 0,12 1 * *", true},
+		{"0 * * * *", true},
+		{"0 6 * *", false},
+		{"0 6 * * * *", false},
+		{"* * * * *", false},
+		{"*/15 * * * *", false},
+		{"0,30 * * * *", false},
+		{"0-59 * * * *", false},
+		{"60 * * * *", false},
+		{"every day", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		raw := map[string]interface{}{
+			"backup_policy": "r006-backup-policy",
+			"cron_spec":     c.cronSpec,
+		}
+		diags := resourceIBMISBackupPolicyPlan().Validate(terraform.NewResourceConfigRaw(raw))
+		if diags.HasError() == c.valid {
+			t.Errorf("cron_spec %q: expected valid %t, got %v", c.cronSpec, c.valid, diags)
+		}
+	}
+}
+
+func testAccCheckIBMISBackupPolicyPlanDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_backup_policy_plan" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBackupPolicyPlan(context.Background(), parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("Backup policy plan still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISBackupPolicyPlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBackupPolicyPlan(context.Background(), parts[0], parts[1])
+		return err
+	}
+}
+
+func testAccCheckIBMISBackupPolicyPlanConfig(policyName, name, cronSpec string, deleteAfter int, extra string) string {
+	return testAccCheckIBMISBackupPolicyConfig(policyName, "tf-backup") + fmt.Sprintf(`
+	resource "ibm_is_backup_policy_plan" "testacc_backup_plan" {
+		backup_policy                 = ibm_is_backup_policy.testacc_backup_policy.id
+		name                          = "%s"
+		cron_spec                     = "%s"
+		deletion_trigger_delete_after = %d
+		%s
+	  }`, name, cronSpec, deleteAfter, extra)
+}
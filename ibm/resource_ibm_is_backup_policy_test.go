@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISBackupPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-backup-policy-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-backup-policy-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISBackupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBackupPolicyConfig(name, "tf-backup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISBackupPolicyExists("ibm_is_backup_policy.testacc_backup_policy"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.testacc_backup_policy", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.testacc_backup_policy", "match_resource_type", "volume"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.testacc_backup_policy", "match_user_tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.testacc_backup_policy", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_backup_policy.testacc_backup_policy", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBackupPolicyConfig(nameUpdate, "tf-backup-upd"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_backup_policy.testacc_backup_policy", "name", nameUpdate),
+					resource.TestCheckTypeSetElemAttr("ibm_is_backup_policy.testacc_backup_policy", "match_user_tags.*", "tf-backup-upd"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_backup_policy.testacc_backup_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBackupPolicyDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_backup_policy" {
+			continue
+		}
+		_, _, err := client.GetBackupPolicy(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Backup policy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISBackupPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBackupPolicy(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIBMISBackupPolicyConfig(name, tag string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_backup_policy" "testacc_backup_policy" {
+		name            = "%s"
+		match_user_tags = ["%s"]
+	  }`, name, tag)
+}
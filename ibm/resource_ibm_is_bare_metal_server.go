@@ -0,0 +1,1132 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerName                    = "name"
+	isBareMetalServerProfile                 = "profile"
+	isBareMetalServerZone                    = "zone"
+	isBareMetalServerVPC                     = "vpc"
+	isBareMetalServerResourceGroup           = "resource_group"
+	isBareMetalServerImage                   = "image"
+	isBareMetalServerKeys                    = "keys"
+	isBareMetalServerUserData                = "user_data"
+	isBareMetalServerTags                    = "tags"
+	isBareMetalServerPrimaryNetworkInterface = "primary_network_interface"
+	isBareMetalServerNetworkInterfaces       = "network_interfaces"
+	isBareMetalServerAction                  = "action"
+	isBareMetalServerForceAction             = "force_action"
+	isBareMetalServerStatus                  = "status"
+
+	isBareMetalServerNicID                      = "id"
+	isBareMetalServerNicName                    = "name"
+	isBareMetalServerNicSubnet                  = "subnet"
+	isBareMetalServerNicInterfaceType           = "interface_type"
+	isBareMetalServerNicAllowIPSpoofing         = "allow_ip_spoofing"
+	isBareMetalServerNicEnableInfrastructureNat = "enable_infrastructure_nat"
+	isBareMetalServerNicAllowedVlans            = "allowed_vlans"
+	isBareMetalServerNicVlan                    = "vlan"
+	isBareMetalServerNicAllowInterfaceToFloat   = "allow_interface_to_float"
+	isBareMetalServerNicPrimaryIpv4Address      = "primary_ipv4_address"
+	isBareMetalServerNicSecurityGroups          = "security_groups"
+	isBareMetalServerNicPortSpeed               = "port_speed"
+	isBareMetalServerNicMacAddress              = "mac_address"
+
+	isBareMetalServerActionStart   = "start"
+	isBareMetalServerActionStop    = "stop"
+	isBareMetalServerActionRestart = "restart"
+
+	isBareMetalServerStatusPending    = "pending"
+	isBareMetalServerStatusStarting   = "starting"
+	isBareMetalServerStatusRunning    = "running"
+	isBareMetalServerStatusRestarting = "restarting"
+	isBareMetalServerStatusStopping   = "stopping"
+	isBareMetalServerStatusStopped    = "stopped"
+	isBareMetalServerStatusDeleting   = "deleting"
+	isBareMetalServerStatusFailed     = "failed"
+	isBareMetalServerDeleteDone       = "done"
+)
+
+func resourceIBMISBareMetalServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISBareMetalServerCreate,
+		ReadContext:   resourceIBMISBareMetalServerRead,
+		UpdateContext: resourceIBMISBareMetalServerUpdate,
+		DeleteContext: resourceIBMISBareMetalServerDelete,
+		// Only the primary network interface is imported, the secondary ones may belong to
+		// ibm_is_bare_metal_server_network_interface resources
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceTagsCustomizeDiff(diff)
+			},
+			resourceIBMISBareMetalServerNetworkInterfacesCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerName),
+				Description:  "The unique user-defined name for this bare metal server. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+
+			isBareMetalServerProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the profile to use for this bare metal server.",
+			},
+
+			isBareMetalServerZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone this bare metal server will reside in.",
+			},
+
+			isBareMetalServerVPC: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The VPC this bare metal server resides in. If unspecified, the VPC of the subnet of the primary network interface is used.",
+			},
+
+			isBareMetalServerResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+
+			isBareMetalServerImage: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The image to use when provisioning the bare metal server.",
+			},
+
+			isBareMetalServerKeys: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The SSH keys to use when provisioning the bare metal server.",
+			},
+
+			isBareMetalServerUserData: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "User data to be made available when initializing the bare metal server.",
+			},
+
+			isBareMetalServerTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_is_bare_metal_server", "tag")},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags of the bare metal server",
+			},
+
+			isBareMetalServerPrimaryNetworkInterface: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The primary network interface of the bare metal server. It is a PCI interface.",
+				Elem: &schema.Resource{
+					Schema: resourceIBMISBareMetalServerNetworkInterfaceSchema(true),
+				},
+			},
+
+			isBareMetalServerNetworkInterfaces: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The secondary network interfaces of the bare metal server. Adding or removing one replaces the bare metal server, the ibm_is_bare_metal_server_network_interface resource adds and removes them in place. An interface deleted outside of Terraform is created again.",
+				Elem: &schema.Resource{
+					Schema: resourceIBMISBareMetalServerNetworkInterfaceSchema(false),
+				},
+			},
+
+			isBareMetalServerAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerAction),
+				Description:  "The action to perform on the bare metal server: start, stop or restart. A stopped or started server is brought back to the requested state.",
+			},
+
+			isBareMetalServerForceAction: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stop the bare metal server immediately instead of shutting its operating system down, for the stop and restart actions and before its deletion.",
+			},
+
+			isBareMetalServerStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the bare metal server.",
+			},
+
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total bandwidth (in megabits per second) shared across the network interfaces of the bare metal server.",
+			},
+
+			"boot_target": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource from which this bare metal server is booted.",
+			},
+
+			"cpu": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bare metal server CPU configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architecture": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CPU architecture.",
+						},
+						"core_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of cores.",
+						},
+						"socket_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of CPU sockets.",
+						},
+						"threads_per_core": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total number of hardware threads per core.",
+						},
+					},
+				},
+			},
+
+			"disks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The disks of the bare metal server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this disk.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this disk.",
+						},
+						"interface_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The disk interface used for attaching the disk.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the disk in GB (gigabytes).",
+						},
+					},
+				},
+			},
+
+			"memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of memory, truncated to whole gibibytes.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the bare metal server was created.",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this bare metal server.",
+			},
+
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this bare metal server.",
+			},
+
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+// resourceIBMISBareMetalServerNetworkInterfaceSchema returns the schema of the primary network interface, which is
+// always a PCI interface, or of the secondary network interfaces of a bare metal server
+func resourceIBMISBareMetalServerNetworkInterfaceSchema(primary bool) map[string]*schema.Schema {
+	nicSchema := map[string]*schema.Schema{
+		isBareMetalServerNicID: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier of the network interface.",
+		},
+		isBareMetalServerNicName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The user-defined name for the network interface.",
+		},
+		// A secondary network interface deleted outside of Terraform is created again with its new subnet, so the
+		// CustomizeDiff only replaces the server for the interfaces which still exist
+		isBareMetalServerNicSubnet: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    primary,
+			Description: "The unique identifier of the subnet of the network interface.",
+		},
+		isBareMetalServerNicAllowIPSpoofing: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Indicates whether source IP spoofing is allowed on the network interface.",
+		},
+		isBareMetalServerNicEnableInfrastructureNat: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Indicates whether the VPC infrastructure performs any NAT with the floating IPs of the network interface.",
+		},
+		isBareMetalServerNicAllowedVlans: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Set:         schema.HashInt,
+			Description: "The VLAN IDs allowed for the VLAN interfaces using this PCI interface.",
+		},
+		isBareMetalServerNicPrimaryIpv4Address: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    primary,
+			Description: "The primary IPv4 address of the network interface. If unspecified, an available address of the subnet is selected.",
+		},
+		isBareMetalServerNicSecurityGroups: {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The security groups of the network interface. If unspecified, the default security group of the VPC is used.",
+		},
+		isBareMetalServerNicInterfaceType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The network interface type: pci or vlan.",
+		},
+		isBareMetalServerNicPortSpeed: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The network interface port speed in Mbps.",
+		},
+		isBareMetalServerNicMacAddress: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The MAC address of the network interface.",
+		},
+	}
+	if !primary {
+		nicSchema[isBareMetalServerNicInterfaceType] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      vpcext.BareMetalServerNetworkInterfaceTypePci,
+			ValidateFunc: InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerNicInterfaceType),
+			Description:  "The network interface type: pci, or vlan for an interface using one of the VLANs allowed by a PCI interface.",
+		}
+		nicSchema[isBareMetalServerNicVlan] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The VLAN ID of a vlan interface. It must be allowed by the allowed_vlans of a PCI interface.",
+		}
+		nicSchema[isBareMetalServerNicAllowInterfaceToFloat] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Indicates whether a vlan interface can float to any other server in the same resource group.",
+		}
+	}
+	return nicSchema
+}
+
+func resourceIBMISBareMetalServerValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "start, stop, restart"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerNicInterfaceType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "pci, vlan"})
+
+	ibmISBareMetalServerValidator := ResourceValidator{ResourceName: "ibm_is_bare_metal_server", Schema: validateSchema}
+	return &ibmISBareMetalServerValidator
+}
+
+// resourceIBMISBareMetalServerNetworkInterfacesCustomizeDiff checks the VLAN settings of the secondary network interfaces,
+// and replaces the server when secondary network interfaces are added or removed, which the API only does at creation,
+// or when the subnet, type, VLAN or address of an existing interface changes. The interfaces deleted outside of
+// Terraform have no ID and are created again.
+func resourceIBMISBareMetalServerNetworkInterfacesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange(isBareMetalServerNetworkInterfaces) {
+		oldNics, newNics := diff.GetChange(isBareMetalServerNetworkInterfaces)
+		if len(oldNics.([]interface{})) != len(newNics.([]interface{})) {
+			if err := diff.ForceNew(isBareMetalServerNetworkInterfaces); err != nil {
+				return err
+			}
+			return nil
+		}
+		for i, v := range oldNics.([]interface{}) {
+			oldNic, _ := v.(map[string]interface{})
+			if id, _ := oldNic[isBareMetalServerNicID].(string); id == "" {
+				continue
+			}
+			for _, k := range []string{isBareMetalServerNicSubnet, isBareMetalServerNicInterfaceType, isBareMetalServerNicVlan, isBareMetalServerNicPrimaryIpv4Address} {
+				key := fmt.Sprintf("%s.%d.%s", isBareMetalServerNetworkInterfaces, i, k)
+				if diff.HasChange(key) {
+					if err := diff.ForceNew(key); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	if v, ok := diff.GetOk(isBareMetalServerPrimaryNetworkInterface); ok {
+		nic := v.([]interface{})[0].(map[string]interface{})
+		if err := validateBareMetalServerNetworkInterface(vpcext.BareMetalServerNetworkInterfaceTypePci, 0, nic[isBareMetalServerNicAllowedVlans].(*schema.Set).Len()); err != nil {
+			return fmt.Errorf("Invalid %s: %s", isBareMetalServerPrimaryNetworkInterface, err)
+		}
+	}
+	for i, v := range diff.Get(isBareMetalServerNetworkInterfaces).([]interface{}) {
+		nic := v.(map[string]interface{})
+		if err := validateBareMetalServerNetworkInterface(nic[isBareMetalServerNicInterfaceType].(string), nic[isBareMetalServerNicVlan].(int), nic[isBareMetalServerNicAllowedVlans].(*schema.Set).Len()); err != nil {
+			return fmt.Errorf("Invalid %s.%d: %s", isBareMetalServerNetworkInterfaces, i, err)
+		}
+	}
+	return nil
+}
+
+// validateBareMetalServerNetworkInterface checks that only PCI interfaces allow VLANs and only VLAN interfaces have one
+func validateBareMetalServerNetworkInterface(interfaceType string, vlan, allowedVlans int) error {
+	switch interfaceType {
+	case vpcext.BareMetalServerNetworkInterfaceTypePci:
+		if vlan != 0 {
+			return fmt.Errorf("%s can only be set on a vlan interface", isBareMetalServerNicVlan)
+		}
+	case vpcext.BareMetalServerNetworkInterfaceTypeVlan:
+		if vlan < 1 || vlan > 4094 {
+			return fmt.Errorf("a vlan interface requires a %s between 1 and 4094", isBareMetalServerNicVlan)
+		}
+		if allowedVlans != 0 {
+			return fmt.Errorf("%s can only be set on a pci interface", isBareMetalServerNicAllowedVlans)
+		}
+	}
+	return nil
+}
+
+func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image := d.Get(isBareMetalServerImage).(string)
+	profile := d.Get(isBareMetalServerProfile).(string)
+	zone := d.Get(isBareMetalServerZone).(string)
+	prototype := &vpcext.BareMetalServerPrototype{
+		Initialization: &vpcext.BareMetalServerInitializationPrototype{
+			Image: &vpcext.Reference{ID: &image},
+			Keys:  []vpcext.Reference{},
+		},
+		Profile: &vpcext.Reference{Name: &profile},
+		Zone:    &vpcext.Reference{Name: &zone},
+	}
+	for _, key := range expandStringList(d.Get(isBareMetalServerKeys).(*schema.Set).List()) {
+		keyID := key
+		prototype.Initialization.Keys = append(prototype.Initialization.Keys, vpcext.Reference{ID: &keyID})
+	}
+	if userData, ok := d.GetOk(isBareMetalServerUserData); ok {
+		userDataStr := userData.(string)
+		prototype.Initialization.UserData = &userDataStr
+	}
+	if name, ok := d.GetOk(isBareMetalServerName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if vpcID, ok := d.GetOk(isBareMetalServerVPC); ok {
+		vpcIDStr := vpcID.(string)
+		prototype.VPC = &vpcext.Reference{ID: &vpcIDStr}
+	}
+	if resourceGroup, ok := d.GetOk(isBareMetalServerResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		prototype.ResourceGroup = &vpcext.Reference{ID: &resourceGroupStr}
+	}
+	primaryNic := d.Get(isBareMetalServerPrimaryNetworkInterface).([]interface{})[0].(map[string]interface{})
+	prototype.PrimaryNetworkInterface = expandBareMetalServerNetworkInterface(primaryNic)
+	prototype.PrimaryNetworkInterface.InterfaceType = core.StringPtr(vpcext.BareMetalServerNetworkInterfaceTypePci)
+	for _, v := range d.Get(isBareMetalServerNetworkInterfaces).([]interface{}) {
+		prototype.NetworkInterfaces = append(prototype.NetworkInterfaces, *expandBareMetalServerNetworkInterface(v.(map[string]interface{})))
+	}
+
+	server, response, err := client.CreateBareMetalServer(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateBareMetalServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(*server.ID)
+	log.Printf("[INFO] Bare metal server : %s", d.Id())
+
+	if err = bareMetalServerClaimNetworkInterfaces(context, client, d, server); err != nil {
+		return diagFromErr(context, err)
+	}
+
+	_, err = isWaitForBareMetalServerAvailable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	if d.Get(isBareMetalServerAction).(string) == isBareMetalServerActionStop {
+		if err = bareMetalServerAction(context, client, d.Id(), isBareMetalServerActionStop, d.Get(isBareMetalServerForceAction).(bool), d.Timeout(schema.TimeoutCreate), d); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	if _, ok := d.GetOk(isBareMetalServerTags); ok {
+		oldList, newList := d.GetChange(isBareMetalServerTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, *server.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMISBareMetalServerRead(context, d, meta)
+}
+
+// bareMetalServerClaimNetworkInterfaces records the IDs of the secondary network interfaces created with the server
+// in network_interfaces, by name when it is configured and else by subnet, type and VLAN, in the order of the API
+func bareMetalServerClaimNetworkInterfaces(ctx context.Context, client *vpcext.VpcExtV1, d *schema.ResourceData, server *vpcext.BareMetalServer) error {
+	declared := d.Get(isBareMetalServerNetworkInterfaces).([]interface{})
+	if len(declared) == 0 {
+		return nil
+	}
+	nics, response, err := client.ListBareMetalServerNetworkInterfaces(ctx, *server.ID)
+	if err != nil {
+		return fmt.Errorf("Error listing the network interfaces of bare metal server (%s): %w", *server.ID, newAPIError(err, response))
+	}
+	claimed := map[string]bool{*server.PrimaryNetworkInterface.ID: true}
+	for i, v := range declared {
+		nic := v.(map[string]interface{})
+		for _, live := range nics {
+			if claimed[*live.ID] {
+				continue
+			}
+			if name := nic[isBareMetalServerNicName].(string); name != "" {
+				if live.Name == nil || *live.Name != name {
+					continue
+				}
+			} else if live.Subnet == nil || *live.Subnet.ID != nic[isBareMetalServerNicSubnet].(string) ||
+				*live.InterfaceType != nic[isBareMetalServerNicInterfaceType].(string) ||
+				(live.Vlan != nil && int(*live.Vlan) != nic[isBareMetalServerNicVlan].(int)) {
+				continue
+			}
+			claimed[*live.ID] = true
+			nic[isBareMetalServerNicID] = *live.ID
+			declared[i] = nic
+			break
+		}
+	}
+	return d.Set(isBareMetalServerNetworkInterfaces, declared)
+}
+
+func expandBareMetalServerNetworkInterface(nic map[string]interface{}) *vpcext.BareMetalServerNetworkInterfacePrototype {
+	subnet := nic[isBareMetalServerNicSubnet].(string)
+	allowIPSpoofing := nic[isBareMetalServerNicAllowIPSpoofing].(bool)
+	enableInfrastructureNat := nic[isBareMetalServerNicEnableInfrastructureNat].(bool)
+	prototype := &vpcext.BareMetalServerNetworkInterfacePrototype{
+		AllowIPSpoofing:         &allowIPSpoofing,
+		EnableInfrastructureNat: &enableInfrastructureNat,
+		Subnet:                  &vpcext.Reference{ID: &subnet},
+	}
+	if interfaceType, ok := nic[isBareMetalServerNicInterfaceType].(string); ok && interfaceType != "" {
+		prototype.InterfaceType = &interfaceType
+	}
+	if name := nic[isBareMetalServerNicName].(string); name != "" {
+		prototype.Name = &name
+	}
+	if address := nic[isBareMetalServerNicPrimaryIpv4Address].(string); address != "" {
+		prototype.PrimaryIP = &vpcext.NetworkInterfaceIPPrototype{Address: &address}
+	}
+	for _, vlan := range nic[isBareMetalServerNicAllowedVlans].(*schema.Set).List() {
+		prototype.AllowedVlans = append(prototype.AllowedVlans, int64(vlan.(int)))
+	}
+	for _, sg := range expandStringList(nic[isBareMetalServerNicSecurityGroups].(*schema.Set).List()) {
+		sgID := sg
+		prototype.SecurityGroups = append(prototype.SecurityGroups, vpcext.Reference{ID: &sgID})
+	}
+	if vlan, ok := nic[isBareMetalServerNicVlan].(int); ok && vlan != 0 {
+		vlanID := int64(vlan)
+		prototype.Vlan = &vlanID
+	}
+	if allowFloat, ok := nic[isBareMetalServerNicAllowInterfaceToFloat].(bool); ok && allowFloat {
+		prototype.AllowInterfaceToFloat = &allowFloat
+	}
+	return prototype
+}
+
+func flattenBareMetalServerNetworkInterface(nic vpcext.BareMetalServerNetworkInterface, primary bool) map[string]interface{} {
+	nicMap := map[string]interface{}{
+		isBareMetalServerNicID:                      *nic.ID,
+		isBareMetalServerNicAllowIPSpoofing:         *nic.AllowIPSpoofing,
+		isBareMetalServerNicEnableInfrastructureNat: *nic.EnableInfrastructureNat,
+		isBareMetalServerNicInterfaceType:           *nic.InterfaceType,
+	}
+	if nic.Name != nil {
+		nicMap[isBareMetalServerNicName] = *nic.Name
+	}
+	if nic.Subnet != nil {
+		nicMap[isBareMetalServerNicSubnet] = *nic.Subnet.ID
+	}
+	if nic.PrimaryIP != nil && nic.PrimaryIP.Address != nil {
+		nicMap[isBareMetalServerNicPrimaryIpv4Address] = *nic.PrimaryIP.Address
+	}
+	if nic.PortSpeed != nil {
+		nicMap[isBareMetalServerNicPortSpeed] = *nic.PortSpeed
+	}
+	if nic.MacAddress != nil {
+		nicMap[isBareMetalServerNicMacAddress] = *nic.MacAddress
+	}
+	allowedVlans := make([]interface{}, 0, len(nic.AllowedVlans))
+	for _, vlan := range nic.AllowedVlans {
+		allowedVlans = append(allowedVlans, int(vlan))
+	}
+	nicMap[isBareMetalServerNicAllowedVlans] = schema.NewSet(schema.HashInt, allowedVlans)
+	securityGroups := make([]string, 0, len(nic.SecurityGroups))
+	for _, sg := range nic.SecurityGroups {
+		securityGroups = append(securityGroups, *sg.ID)
+	}
+	nicMap[isBareMetalServerNicSecurityGroups] = newStringSet(schema.HashString, securityGroups)
+	if !primary {
+		if nic.Vlan != nil {
+			nicMap[isBareMetalServerNicVlan] = int(*nic.Vlan)
+		}
+		if nic.AllowInterfaceToFloat != nil {
+			nicMap[isBareMetalServerNicAllowInterfaceToFloat] = *nic.AllowInterfaceToFloat
+		}
+	}
+	return nicMap
+}
+
+func resourceIBMISBareMetalServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server, response, err := client.GetBareMetalServer(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	if err = d.Set(isBareMetalServerName, server.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if server.Profile != nil {
+		if err = d.Set(isBareMetalServerProfile, server.Profile.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting profile: %s", err))
+		}
+	}
+	if server.Zone != nil {
+		if err = d.Set(isBareMetalServerZone, server.Zone.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting zone: %s", err))
+		}
+	}
+	if server.VPC != nil {
+		if err = d.Set(isBareMetalServerVPC, server.VPC.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting vpc: %s", err))
+		}
+	}
+	if server.ResourceGroup != nil {
+		if err = d.Set(isBareMetalServerResourceGroup, server.ResourceGroup.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting resource_group: %s", err))
+		}
+	}
+	if err = d.Set(isBareMetalServerStatus, server.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	// A server stopped or started out of band is brought back to the state requested by the action
+	action := d.Get(isBareMetalServerAction).(string)
+	if (action == isBareMetalServerActionStop && *server.Status == isBareMetalServerStatusRunning) ||
+		(action == isBareMetalServerActionStart && *server.Status == isBareMetalServerStatusStopped) {
+		d.Set(isBareMetalServerAction, "")
+	}
+	if err = d.Set("bandwidth", server.Bandwidth); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting bandwidth: %s", err))
+	}
+	if server.BootTarget != nil {
+		if err = d.Set("boot_target", server.BootTarget.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting boot_target: %s", err))
+		}
+	}
+	if server.CPU != nil {
+		if err = d.Set("cpu", []map[string]interface{}{resourceIBMISBareMetalServerCPUToMap(*server.CPU)}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting cpu: %s", err))
+		}
+	}
+	disks := []map[string]interface{}{}
+	for _, disksItem := range server.Disks {
+		disks = append(disks, resourceIBMISBareMetalServerDiskToMap(disksItem))
+	}
+	if err = d.Set("disks", disks); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting disks: %s", err))
+	}
+	if err = d.Set("memory", server.Memory); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting memory: %s", err))
+	}
+	if err = d.Set("created_at", server.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("crn", server.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("href", server.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("resource_type", server.ResourceType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
+	}
+
+	initialization, response, err := client.GetBareMetalServerInitialization(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] GetBareMetalServerInitialization failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	if initialization.Image != nil {
+		if err = d.Set(isBareMetalServerImage, initialization.Image.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting image: %s", err))
+		}
+	}
+	keys := make([]string, 0, len(initialization.Keys))
+	for _, key := range initialization.Keys {
+		keys = append(keys, *key.ID)
+	}
+	if err = d.Set(isBareMetalServerKeys, newStringSet(schema.HashString, keys)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting keys: %s", err))
+	}
+
+	nics, response, err := client.ListBareMetalServerNetworkInterfaces(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] ListBareMetalServerNetworkInterfaces failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	nicsByID := map[string]vpcext.BareMetalServerNetworkInterface{}
+	for _, nic := range nics {
+		nicsByID[*nic.ID] = nic
+	}
+	if server.PrimaryNetworkInterface != nil {
+		if nic, ok := nicsByID[*server.PrimaryNetworkInterface.ID]; ok {
+			if err = d.Set(isBareMetalServerPrimaryNetworkInterface, []map[string]interface{}{flattenBareMetalServerNetworkInterface(nic, true)}); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting primary_network_interface: %s", err))
+			}
+		}
+	}
+	// Only the secondary network interfaces managed by this resource are read, the other ones belong to
+	// ibm_is_bare_metal_server_network_interface resources. An interface deleted outside of Terraform keeps
+	// its position without an ID, so that the next apply creates it again.
+	secondaryNics := []map[string]interface{}{}
+	for _, v := range d.Get(isBareMetalServerNetworkInterfaces).([]interface{}) {
+		id, _ := v.(map[string]interface{})[isBareMetalServerNicID].(string)
+		if nic, ok := nicsByID[id]; ok {
+			secondaryNics = append(secondaryNics, flattenBareMetalServerNetworkInterface(nic, false))
+		} else {
+			log.Printf("[WARN] Network interface (%s) of bare metal server (%s) not found, it will be created again", id, d.Id())
+			secondaryNics = append(secondaryNics, map[string]interface{}{})
+		}
+	}
+	if err = d.Set(isBareMetalServerNetworkInterfaces, secondaryNics); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting network_interfaces: %s", err))
+	}
+
+	tags, err := GetTagsUsingCRN(meta, *server.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of resource bare metal server (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isBareMetalServerTags, tags)
+
+	return nil
+}
+
+func resourceIBMISBareMetalServerCPUToMap(cpu vpcext.BareMetalServerCPU) map[string]interface{} {
+	cpuMap := map[string]interface{}{}
+
+	cpuMap["architecture"] = cpu.Architecture
+	cpuMap["core_count"] = cpu.CoreCount
+	cpuMap["socket_count"] = cpu.SocketCount
+	cpuMap["threads_per_core"] = cpu.ThreadsPerCore
+
+	return cpuMap
+}
+
+func resourceIBMISBareMetalServerDiskToMap(disk vpcext.BareMetalServerDisk) map[string]interface{} {
+	diskMap := map[string]interface{}{}
+
+	diskMap["id"] = disk.ID
+	diskMap["name"] = disk.Name
+	diskMap["interface_type"] = disk.InterfaceType
+	diskMap["size"] = disk.Size
+
+	return diskMap
+}
+
+func resourceIBMISBareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isBareMetalServerName) {
+		patch := map[string]interface{}{"name": d.Get(isBareMetalServerName).(string)}
+		_, response, err := client.UpdateBareMetalServer(context, d.Id(), patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateBareMetalServer failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	if d.HasChange(isBareMetalServerPrimaryNetworkInterface) {
+		oldNic, newNic := d.GetChange(isBareMetalServerPrimaryNetworkInterface)
+		if err = bareMetalServerNetworkInterfaceUpdate(context, client, meta, d.Id(), oldNic.([]interface{})[0].(map[string]interface{}), newNic.([]interface{})[0].(map[string]interface{})); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	if d.HasChange(isBareMetalServerNetworkInterfaces) {
+		oldNics, newNics := d.GetChange(isBareMetalServerNetworkInterfaces)
+		// The network interfaces are only added or removed with a replacement of the server, see the CustomizeDiff
+		for i, v := range newNics.([]interface{}) {
+			oldNic, _ := oldNics.([]interface{})[i].(map[string]interface{})
+			newNic := v.(map[string]interface{})
+			if id, _ := oldNic[isBareMetalServerNicID].(string); id == "" {
+				nic, response, err := client.CreateBareMetalServerNetworkInterface(context, d.Id(), expandBareMetalServerNetworkInterface(newNic))
+				if err != nil {
+					log.Printf("[DEBUG] CreateBareMetalServerNetworkInterface failed %s\n%s", err, response)
+					return diagFromErr(context, newAPIError(err, response))
+				}
+				newNic[isBareMetalServerNicID] = *nic.ID
+				if err = d.Set(isBareMetalServerNetworkInterfaces, newNics); err != nil {
+					return diag.FromErr(fmt.Errorf("Error setting network_interfaces: %s", err))
+				}
+				continue
+			}
+			if err = bareMetalServerNetworkInterfaceUpdate(context, client, meta, d.Id(), oldNic, newNic); err != nil {
+				return diagFromErr(context, err)
+			}
+		}
+	}
+
+	if d.HasChange(isBareMetalServerAction) {
+		if action := d.Get(isBareMetalServerAction).(string); action != "" {
+			if err = bareMetalServerAction(context, client, d.Id(), action, d.Get(isBareMetalServerForceAction).(bool), d.Timeout(schema.TimeoutUpdate), d); err != nil {
+				return diagFromErr(context, err)
+			}
+		}
+	}
+
+	if d.HasChange(isBareMetalServerTags) {
+		oldList, newList := d.GetChange(isBareMetalServerTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
+		if err != nil {
+			log.Printf(
+				"Error on update of resource bare metal server (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMISBareMetalServerRead(context, d, meta)
+}
+
+// bareMetalServerNetworkInterfaceUpdate patches the mutable properties of a network interface of a bare metal server
+// and replaces its security groups
+func bareMetalServerNetworkInterfaceUpdate(ctx context.Context, client *vpcext.VpcExtV1, meta interface{}, serverID string, oldNic, newNic map[string]interface{}) error {
+	nicID := oldNic[isBareMetalServerNicID].(string)
+	patch := map[string]interface{}{}
+	for _, k := range []string{isBareMetalServerNicName, isBareMetalServerNicAllowIPSpoofing, isBareMetalServerNicEnableInfrastructureNat, isBareMetalServerNicAllowInterfaceToFloat} {
+		if v, ok := newNic[k]; ok && v != oldNic[k] {
+			if k == isBareMetalServerNicName && v.(string) == "" {
+				continue
+			}
+			patch[k] = v
+		}
+	}
+	oldVlans, newVlans := oldNic[isBareMetalServerNicAllowedVlans].(*schema.Set), newNic[isBareMetalServerNicAllowedVlans].(*schema.Set)
+	if !oldVlans.Equal(newVlans) {
+		allowedVlans := make([]int64, 0, newVlans.Len())
+		for _, vlan := range newVlans.List() {
+			allowedVlans = append(allowedVlans, int64(vlan.(int)))
+		}
+		patch[isBareMetalServerNicAllowedVlans] = allowedVlans
+	}
+	if len(patch) != 0 {
+		_, response, err := client.UpdateBareMetalServerNetworkInterface(ctx, serverID, nicID, patch)
+		if err != nil {
+			return fmt.Errorf("Error updating network interface (%s) of bare metal server (%s): %w", nicID, serverID, newAPIError(err, response))
+		}
+	}
+	oldSgs, newSgs := oldNic[isBareMetalServerNicSecurityGroups].(*schema.Set), newNic[isBareMetalServerNicSecurityGroups].(*schema.Set)
+	if newSgs.Len() != 0 && !oldSgs.Equal(newSgs) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		return securityGroupTargetBindingsUpdate(ctx, sess, nicID, oldSgs, newSgs)
+	}
+	return nil
+}
+
+// securityGroupTargetBindingsUpdate binds a security group target, such as a network interface, to the added security
+// groups before unbinding it from the removed ones, so that it keeps a security group
+func securityGroupTargetBindingsUpdate(ctx context.Context, sess *vpcv1.VpcV1, targetID string, oldSgs, newSgs *schema.Set) error {
+	for _, sg := range expandStringList(newSgs.Difference(oldSgs).List()) {
+		options := &vpcv1.CreateSecurityGroupTargetBindingOptions{
+			SecurityGroupID: &sg,
+			ID:              &targetID,
+		}
+		_, response, err := sess.CreateSecurityGroupTargetBindingWithContext(ctx, options)
+		if err != nil {
+			return fmt.Errorf("Error adding target (%s) to security group (%s): %w", targetID, sg, newAPIError(err, response))
+		}
+	}
+	for _, sg := range expandStringList(oldSgs.Difference(newSgs).List()) {
+		options := &vpcv1.DeleteSecurityGroupTargetBindingOptions{
+			SecurityGroupID: &sg,
+			ID:              &targetID,
+		}
+		response, err := sess.DeleteSecurityGroupTargetBindingWithContext(ctx, options)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("Error removing target (%s) from security group (%s): %w", targetID, sg, newAPIError(err, response))
+		}
+	}
+	return nil
+}
+
+func resourceIBMISBareMetalServerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	server, response, err := client.GetBareMetalServer(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	// A bare metal server must be stopped to be deleted
+	if *server.Status != isBareMetalServerStatusStopped && *server.Status != isBareMetalServerStatusFailed {
+		if err = bareMetalServerAction(context, client, d.Id(), isBareMetalServerActionStop, d.Get(isBareMetalServerForceAction).(bool), d.Timeout(schema.TimeoutDelete), d); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	response, err = client.DeleteBareMetalServer(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] DeleteBareMetalServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForBareMetalServerDeleted(context, client, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// bareMetalServerStopType returns the stop type of the force_action setting
+func bareMetalServerStopType(force bool) string {
+	if force {
+		return vpcext.BareMetalServerStopTypeHard
+	}
+	return vpcext.BareMetalServerStopTypeSoft
+}
+
+// bareMetalServerAction starts, stops or restarts a bare metal server and waits for the result. A forced restart is
+// a hard stop followed by a start.
+func bareMetalServerAction(ctx context.Context, client *vpcext.VpcExtV1, id, action string, force bool, timeout time.Duration, d *schema.ResourceData) error {
+	var response *core.DetailedResponse
+	var err error
+	switch action {
+	case isBareMetalServerActionStart:
+		response, err = client.StartBareMetalServer(ctx, id)
+	case isBareMetalServerActionStop:
+		response, err = client.StopBareMetalServer(ctx, id, bareMetalServerStopType(force))
+	case isBareMetalServerActionRestart:
+		if force {
+			if err = bareMetalServerAction(ctx, client, id, isBareMetalServerActionStop, true, timeout, d); err != nil {
+				return err
+			}
+			return bareMetalServerAction(ctx, client, id, isBareMetalServerActionStart, true, timeout, d)
+		}
+		response, err = client.RestartBareMetalServer(ctx, id)
+	}
+	if err != nil {
+		return fmt.Errorf("Error performing action %s on bare metal server (%s): %w", action, id, newAPIError(err, response))
+	}
+	if action == isBareMetalServerActionStop {
+		_, err = isWaitForBareMetalServerStopped(ctx, client, id, timeout, d)
+	} else {
+		_, err = isWaitForBareMetalServerAvailable(ctx, client, id, timeout, d)
+	}
+	return err
+}
+
+func isWaitForBareMetalServerAvailable(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be running.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerStatusStarting, isBareMetalServerStatusRestarting, isBareMetalServerStatusStopped},
+		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(ctx, client, id, d),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForBareMetalServerStopped(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be stopped.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerStatusStarting, isBareMetalServerStatusRestarting, isBareMetalServerStatusRunning, isBareMetalServerStatusStopping},
+		Target:     []string{isBareMetalServerStatusStopped, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(ctx, client, id, d),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isBareMetalServerRefreshFunc(ctx context.Context, client *vpcext.VpcExtV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		server, response, err := client.GetBareMetalServer(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Getting Bare Metal Server: %w", newAPIError(err, response))
+		}
+		if d != nil {
+			d.Set(isBareMetalServerStatus, *server.Status)
+		}
+		if *server.Status == isBareMetalServerStatusFailed {
+			return server, *server.Status, fmt.Errorf("Bare metal server (%s) went into failed state during the operation \n [WARNING] Running terraform apply again will remove the tainted bare metal server and attempt to create the bare metal server again replacing the previous configuration", id)
+		}
+		return server, *server.Status, nil
+	}
+}
+
+func isWaitForBareMetalServerDeleted(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerStatusDeleting, isBareMetalServerStatusStopped, isBareMetalServerStatusFailed},
+		Target:  []string{isBareMetalServerDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			server, response, err := client.GetBareMetalServer(ctx, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return server, isBareMetalServerDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Bare Metal Server: %w", newAPIError(err, response))
+			}
+			return server, isBareMetalServerStatusDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
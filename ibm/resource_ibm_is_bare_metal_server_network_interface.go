@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerNicBareMetalServer  = "bare_metal_server"
+	isBareMetalServerNicNetworkInterface = "network_interface"
+	isBareMetalServerNicFloatingIP       = "floating_ip"
+	isBareMetalServerNicStatus           = "status"
+
+	isBareMetalServerNicStatusPending   = "pending"
+	isBareMetalServerNicStatusAvailable = "available"
+	isBareMetalServerNicStatusDeleting  = "deleting"
+	isBareMetalServerNicStatusFailed    = "failed"
+	isBareMetalServerNicDeleteDone      = "done"
+)
+
+func resourceIBMISBareMetalServerNetworkInterface() *schema.Resource {
+	nicSchema := resourceIBMISBareMetalServerNetworkInterfaceSchema(false)
+	delete(nicSchema, isBareMetalServerNicID)
+	nicSchema[isBareMetalServerNicInterfaceType].Default = nil
+	nicSchema[isBareMetalServerNicInterfaceType].Required = true
+	nicSchema[isBareMetalServerNicInterfaceType].Optional = false
+	nicSchema[isBareMetalServerNicInterfaceType].ValidateFunc = InvokeValidator("ibm_is_bare_metal_server_network_interface", isBareMetalServerNicInterfaceType)
+	nicSchema[isBareMetalServerNicBareMetalServer] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The unique identifier of the bare metal server.",
+	}
+	nicSchema[isBareMetalServerNicFloatingIP] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The unique identifier of a floating IP to associate with the network interface.",
+	}
+	nicSchema[isBareMetalServerForceAction] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Stop the bare metal server immediately instead of shutting its operating system down, when it must be stopped to add or remove a PCI interface.",
+	}
+	nicSchema[isBareMetalServerNicNetworkInterface] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier of the network interface.",
+	}
+	nicSchema[isBareMetalServerNicStatus] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The status of the network interface.",
+	}
+	nicSchema["href"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL for this network interface.",
+	}
+	nicSchema["type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of this network interface as it relates to the bare metal server: primary or secondary.",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIBMISBareMetalServerNetworkInterfaceCreate,
+		ReadContext:   resourceIBMISBareMetalServerNetworkInterfaceRead,
+		UpdateContext: resourceIBMISBareMetalServerNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMISBareMetalServerNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			return validateBareMetalServerNetworkInterface(diff.Get(isBareMetalServerNicInterfaceType).(string), diff.Get(isBareMetalServerNicVlan).(int), diff.Get(isBareMetalServerNicAllowedVlans).(*schema.Set).Len())
+		},
+
+		Schema: nicSchema,
+	}
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerNicInterfaceType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "pci, vlan"})
+
+	ibmISBareMetalServerNetworkInterfaceValidator := ResourceValidator{ResourceName: "ibm_is_bare_metal_server_network_interface", Schema: validateSchema}
+	return &ibmISBareMetalServerNetworkInterfaceValidator
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serverID := d.Get(isBareMetalServerNicBareMetalServer).(string)
+	ibmMutexKV.Lock(serverID)
+	defer ibmMutexKV.Unlock(serverID)
+
+	prototype := expandBareMetalServerNetworkInterface(map[string]interface{}{
+		isBareMetalServerNicName:                    d.Get(isBareMetalServerNicName),
+		isBareMetalServerNicSubnet:                  d.Get(isBareMetalServerNicSubnet),
+		isBareMetalServerNicInterfaceType:           d.Get(isBareMetalServerNicInterfaceType),
+		isBareMetalServerNicAllowIPSpoofing:         d.Get(isBareMetalServerNicAllowIPSpoofing),
+		isBareMetalServerNicEnableInfrastructureNat: d.Get(isBareMetalServerNicEnableInfrastructureNat),
+		isBareMetalServerNicAllowedVlans:            d.Get(isBareMetalServerNicAllowedVlans),
+		isBareMetalServerNicVlan:                    d.Get(isBareMetalServerNicVlan),
+		isBareMetalServerNicAllowInterfaceToFloat:   d.Get(isBareMetalServerNicAllowInterfaceToFloat),
+		isBareMetalServerNicPrimaryIpv4Address:      d.Get(isBareMetalServerNicPrimaryIpv4Address),
+		isBareMetalServerNicSecurityGroups:          d.Get(isBareMetalServerNicSecurityGroups),
+	})
+
+	var nic *vpcext.BareMetalServerNetworkInterface
+	err = bareMetalServerNetworkInterfaceHotplug(context, client, d, serverID, *prototype.InterfaceType, func() error {
+		var response *core.DetailedResponse
+		nic, response, err = client.CreateBareMetalServerNetworkInterface(context, serverID, prototype)
+		if err != nil {
+			log.Printf("[DEBUG] CreateBareMetalServerNetworkInterface failed %s\n%s", err, response)
+			return newAPIError(err, response)
+		}
+		d.SetId(fmt.Sprintf("%s/%s", serverID, *nic.ID))
+		_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(context, client, serverID, *nic.ID, d.Timeout(schema.TimeoutCreate))
+		return err
+	})
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	if floatingIP, ok := d.GetOk(isBareMetalServerNicFloatingIP); ok {
+		_, response, err := client.AddBareMetalServerNetworkInterfaceFloatingIP(context, serverID, *nic.ID, floatingIP.(string))
+		if err != nil {
+			log.Printf("[DEBUG] AddBareMetalServerNetworkInterfaceFloatingIP failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	return resourceIBMISBareMetalServerNetworkInterfaceRead(context, d, meta)
+}
+
+// bareMetalServerNetworkInterfaceHotplug adds or removes a network interface with change. A running bare metal server
+// is stopped to add or remove a PCI interface, and started again afterwards.
+func bareMetalServerNetworkInterfaceHotplug(ctx context.Context, client *vpcext.VpcExtV1, d *schema.ResourceData, serverID, interfaceType string, change func() error) error {
+	if interfaceType != vpcext.BareMetalServerNetworkInterfaceTypePci {
+		return change()
+	}
+	server, response, err := client.GetBareMetalServer(ctx, serverID)
+	if err != nil {
+		return fmt.Errorf("Error Getting Bare Metal Server: %w", newAPIError(err, response))
+	}
+	running := *server.Status != isBareMetalServerStatusStopped
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if d.Id() != "" {
+		timeout = d.Timeout(schema.TimeoutDelete)
+	}
+	if running {
+		if err = bareMetalServerAction(ctx, client, serverID, isBareMetalServerActionStop, d.Get(isBareMetalServerForceAction).(bool), timeout, nil); err != nil {
+			return err
+		}
+	}
+	err = change()
+	if running {
+		if startErr := bareMetalServerAction(ctx, client, serverID, isBareMetalServerActionStart, false, timeout, nil); err == nil {
+			err = startErr
+		}
+	}
+	return err
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serverID, nicID := parts[0], parts[1]
+
+	nic, response, err := client.GetBareMetalServerNetworkInterface(context, serverID, nicID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetBareMetalServerNetworkInterface failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	if err = d.Set(isBareMetalServerNicBareMetalServer, serverID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting bare_metal_server: %s", err))
+	}
+	if err = d.Set(isBareMetalServerNicNetworkInterface, nic.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting network_interface: %s", err))
+	}
+	for k, v := range flattenBareMetalServerNetworkInterface(*nic, false) {
+		if k == isBareMetalServerNicID {
+			continue
+		}
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+	floatingIP := ""
+	if len(nic.FloatingIPs) != 0 {
+		floatingIP = *nic.FloatingIPs[0].ID
+	}
+	if err = d.Set(isBareMetalServerNicFloatingIP, floatingIP); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting floating_ip: %s", err))
+	}
+	if err = d.Set(isBareMetalServerNicStatus, nic.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("href", nic.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("type", nic.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serverID, nicID := parts[0], parts[1]
+
+	oldNic, newNic := map[string]interface{}{isBareMetalServerNicID: nicID}, map[string]interface{}{}
+	for _, k := range []string{isBareMetalServerNicName, isBareMetalServerNicAllowIPSpoofing, isBareMetalServerNicEnableInfrastructureNat, isBareMetalServerNicAllowInterfaceToFloat, isBareMetalServerNicAllowedVlans, isBareMetalServerNicSecurityGroups} {
+		oldNic[k], newNic[k] = d.GetChange(k)
+	}
+	if err = bareMetalServerNetworkInterfaceUpdate(context, client, meta, serverID, oldNic, newNic); err != nil {
+		return diagFromErr(context, err)
+	}
+
+	if d.HasChange(isBareMetalServerNicFloatingIP) {
+		oldFloatingIP, newFloatingIP := d.GetChange(isBareMetalServerNicFloatingIP)
+		if oldFloatingIP.(string) != "" {
+			response, err := client.RemoveBareMetalServerNetworkInterfaceFloatingIP(context, serverID, nicID, oldFloatingIP.(string))
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				log.Printf("[DEBUG] RemoveBareMetalServerNetworkInterfaceFloatingIP failed %s\n%s", err, response)
+				return diagFromErr(context, newAPIError(err, response))
+			}
+		}
+		if newFloatingIP.(string) != "" {
+			_, response, err := client.AddBareMetalServerNetworkInterfaceFloatingIP(context, serverID, nicID, newFloatingIP.(string))
+			if err != nil {
+				log.Printf("[DEBUG] AddBareMetalServerNetworkInterfaceFloatingIP failed %s\n%s", err, response)
+				return diagFromErr(context, newAPIError(err, response))
+			}
+		}
+	}
+
+	return resourceIBMISBareMetalServerNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	serverID, nicID := parts[0], parts[1]
+	ibmMutexKV.Lock(serverID)
+	defer ibmMutexKV.Unlock(serverID)
+
+	err = bareMetalServerNetworkInterfaceHotplug(context, client, d, serverID, d.Get(isBareMetalServerNicInterfaceType).(string), func() error {
+		response, err := client.DeleteBareMetalServerNetworkInterface(context, serverID, nicID)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil
+			}
+			log.Printf("[DEBUG] DeleteBareMetalServerNetworkInterface failed %s\n%s", err, response)
+			return newAPIError(err, response)
+		}
+		_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(context, client, serverID, nicID, d.Timeout(schema.TimeoutDelete))
+		return err
+	})
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBareMetalServerNetworkInterfaceAvailable(ctx context.Context, client *vpcext.VpcExtV1, serverID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of bare metal server (%s) to be available.", id, serverID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerNicStatusPending},
+		Target:  []string{isBareMetalServerNicStatusAvailable, isBareMetalServerNicStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			nic, response, err := client.GetBareMetalServerNetworkInterface(ctx, serverID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Bare Metal Server Network Interface: %w", newAPIError(err, response))
+			}
+			if *nic.Status == isBareMetalServerNicStatusFailed {
+				return nic, *nic.Status, fmt.Errorf("Network interface (%s) of bare metal server (%s) went into failed state", id, serverID)
+			}
+			return nic, *nic.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForBareMetalServerNetworkInterfaceDeleted(ctx context.Context, client *vpcext.VpcExtV1, serverID, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerNicStatusDeleting, isBareMetalServerNicStatusAvailable, isBareMetalServerNicStatusFailed},
+		Target:  []string{isBareMetalServerNicDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			nic, response, err := client.GetBareMetalServerNetworkInterface(ctx, serverID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nic, isBareMetalServerNicDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Bare Metal Server Network Interface: %w", newAPIError(err, response))
+			}
+			return nic, isBareMetalServerNicStatusDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISBareMetalServerNetworkInterface_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-bms-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISBareMetalServerNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, "eth-vlan102", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "name", "eth-vlan102"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "interface_type", "vlan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "vlan", "102"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_pci", "interface_type", "pci"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, "eth-vlan102-upd", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "name", "eth-vlan102-upd"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "allow_ip_spoofing", "true"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "floating_ip"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "network_interfaces.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_bare_metal_server_network_interface.testacc_nic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_action"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_bare_metal_server_network_interface" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBareMetalServerNetworkInterface(context.Background(), parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("Bare metal server network interface still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicName string, floatingIP bool) string {
+	floatingIPConfig := ""
+	if floatingIP {
+		floatingIPConfig = `
+		allow_ip_spoofing = true
+		floating_ip       = ibm_is_floating_ip.testacc_fip.id`
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	  resource "ibm_is_bare_metal_server" "testacc_bms" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		zone    = "%s"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		primary_network_interface {
+		  subnet        = ibm_is_subnet.testacc_subnet.id
+		  allowed_vlans = [101, 102]
+		}
+	  }
+
+	  resource "ibm_is_floating_ip" "testacc_fip" {
+		name = "%s-fip"
+		zone = "%s"
+	  }
+
+	  resource "ibm_is_bare_metal_server_network_interface" "testacc_nic" {
+		bare_metal_server = ibm_is_bare_metal_server.testacc_bms.id
+		name              = "%s"
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		interface_type    = "vlan"
+		vlan              = 102
+		%s
+	  }
+
+	  resource "ibm_is_bare_metal_server_network_interface" "testacc_pci" {
+		bare_metal_server = ibm_is_bare_metal_server.testacc_bms.id
+		name              = "eth-pci1"
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		interface_type    = "pci"
+		force_action      = true
+	  }`, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, name, isBareMetalServerImageID, isBareMetalServerProfileName, ISZoneName, name, ISZoneName, nicName, floatingIPConfig)
+}
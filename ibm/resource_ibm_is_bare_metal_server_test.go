@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISBareMetalServer_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-bms-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-bms-upd-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISBareMetalServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerConfig(vpcname, subnetname, sshname, publicKey, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerExists("ibm_is_bare_metal_server.testacc_bms"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "zone", ISZoneName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "status", "running"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "primary_network_interface.0.interface_type", "pci"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "network_interfaces.0.interface_type", "vlan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "network_interfaces.0.vlan", "101"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server.testacc_bms", "network_interfaces.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerConfig(vpcname, subnetname, sshname, publicKey, nameUpdate, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "name", nameUpdate),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "status", "stopped"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerConfig(vpcname, subnetname, sshname, publicKey, nameUpdate, "start"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server.testacc_bms", "status", "running"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_bare_metal_server.testacc_bms",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"action", "force_action", "user_data"},
+			},
+		},
+	})
+}
+
+func TestValidateBareMetalServerNetworkInterface(t *testing.T) {
+	cases := []struct {
+		interfaceType string
+		vlan          int
+		allowedVlans  int
+		valid         bool
+	}{
+		{"pci", 0, 2, true},
+		{"pci", 101, 0, false},
+		{"vlan", 101, 0, true},
+		{"vlan", 0, 0, false},
+		{"vlan", 5000, 0, false},
+		{"vlan", 101, 1, false},
+	}
+	for _, c := range cases {
+		err := validateBareMetalServerNetworkInterface(c.interfaceType, c.vlan, c.allowedVlans)
+		if (err == nil) != c.valid {
+			t.Errorf("validateBareMetalServerNetworkInterface(%s, %d, %d) returned %v", c.interfaceType, c.vlan, c.allowedVlans, err)
+		}
+	}
+}
+
+func TestBareMetalServerNetworkInterfacesForceNew(t *testing.T) {
+	r := resourceIBMISBareMetalServer()
+	state := &terraform.InstanceState{
+		ID: "server-1",
+		Attributes: map[string]string{
+			"id":                                     "server-1",
+			"network_interfaces.#":                   "1",
+			"network_interfaces.0.id":                "nic-1",
+			"network_interfaces.0.name":              "eth1",
+			"network_interfaces.0.subnet":            "subnet-1",
+			"network_interfaces.0.interface_type":    "pci",
+			"network_interfaces.0.allow_ip_spoofing": "false",
+		},
+	}
+	nic := func(name string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "subnet": "subnet-1", "interface_type": "pci"}
+	}
+	for _, c := range []struct {
+		nics       []interface{}
+		requireNew bool
+	}{
+		{[]interface{}{nic("eth1-renamed")}, false},
+		{[]interface{}{nic("eth1"), nic("eth2")}, true},
+		{[]interface{}{}, true},
+	} {
+		raw := map[string]interface{}{"network_interfaces": c.nics}
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		count := diff.Attributes["network_interfaces.#"]
+		if requireNew := count != nil && count.RequiresNew; requireNew != c.requireNew {
+			t.Errorf("with %d network interfaces, got a replacement %t, want %t", len(c.nics), requireNew, c.requireNew)
+		}
+	}
+}
+
+func TestBareMetalServerNetworkInterfacesRecreate(t *testing.T) {
+	r := resourceIBMISBareMetalServer()
+	state := func(id string) *terraform.InstanceState {
+		attributes := map[string]string{
+			"id":                                             "server-1",
+			"network_interfaces.#":                           "1",
+			"network_interfaces.0.id":                        id,
+			"network_interfaces.0.subnet":                    "subnet-1",
+			"network_interfaces.0.interface_type":            "pci",
+			"network_interfaces.0.allow_ip_spoofing":         "false",
+			"network_interfaces.0.enable_infrastructure_nat": "true",
+		}
+		if id == "" {
+			// The network interface was deleted outside of Terraform
+			attributes = map[string]string{"id": "server-1", "network_interfaces.#": "1"}
+		}
+		return &terraform.InstanceState{ID: "server-1", Attributes: attributes}
+	}
+	raw := func(subnet string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"network_interfaces": []interface{}{map[string]interface{}{"subnet": subnet, "interface_type": "pci"}},
+		})
+	}
+
+	diff, err := r.Diff(context.Background(), state("nic-1"), raw("subnet-2"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.RequiresNew() {
+		t.Fatal("expected the change of subnet of an existing network interface to replace the server")
+	}
+
+	diff, err = r.Diff(context.Background(), state(""), raw("subnet-2"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["network_interfaces.0.subnet"] == nil || diff.Attributes["network_interfaces.0.subnet"].New != "subnet-2" {
+		t.Fatalf("expected the deleted network interface to be created again, got %#v", diff)
+	}
+	if diff.RequiresNew() {
+		t.Fatal("expected the deleted network interface to be created again without replacing the server")
+	}
+}
+
+func TestFlattenBareMetalServerNetworkInterfaceWithoutName(t *testing.T) {
+	id, interfaceType, flag := "nic-1", "pci", true
+	nic := flattenBareMetalServerNetworkInterface(vpcext.BareMetalServerNetworkInterface{
+		ID:                      &id,
+		AllowIPSpoofing:         &flag,
+		EnableInfrastructureNat: &flag,
+		InterfaceType:           &interfaceType,
+	}, false)
+	if _, ok := nic["name"]; ok || nic["id"] != id {
+		t.Fatalf("unexpected network interface %v", nic)
+	}
+}
+
+func testAccCheckIBMISBareMetalServerDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_bare_metal_server" {
+			continue
+		}
+		_, _, err := client.GetBareMetalServer(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Bare metal server still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISBareMetalServerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBareMetalServer(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIBMISBareMetalServerConfig(vpcname, subnetname, sshname, publicKey, name, action string) string {
+	actionConfig := ""
+	if action != "" {
+		actionConfig = fmt.Sprintf("action  = %q", action)
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	  resource "ibm_is_bare_metal_server" "testacc_bms" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		zone    = "%s"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		%s
+		primary_network_interface {
+		  subnet        = ibm_is_subnet.testacc_subnet.id
+		  allowed_vlans = [101, 102]
+		}
+		network_interfaces {
+		  name           = "eth-vlan101"
+		  subnet         = ibm_is_subnet.testacc_subnet.id
+		  interface_type = "vlan"
+		  vlan           = 101
+		}
+	  }`, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, name, isBareMetalServerImageID, isBareMetalServerProfileName, ISZoneName, actionConfig)
+}
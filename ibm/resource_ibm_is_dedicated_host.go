@@ -406,7 +406,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 	dedicatedHost, response, err := vpcClient.CreateDedicatedHostWithContext(context, createDedicatedHostOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	d.SetId(*dedicatedHost.ID)
@@ -436,7 +436,7 @@ func resourceIbmIsDedicatedHostRead(context context.Context, d *schema.ResourceD
 			return nil
 		}
 		log.Printf("[DEBUG] GetDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 
 	if err = d.Set("available_memory", intValue(dedicatedHost.AvailableMemory)); err != nil {
@@ -607,7 +607,7 @@ func resourceIbmIsDedicatedHostUpdate(context context.Context, d *schema.Resourc
 		_, response, err := vpcClient.UpdateDedicatedHostWithContext(context, updateDedicatedHostOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateDedicatedHostWithContext fails %s\n%s", err, response)
-			return diag.FromErr(err)
+			return diagFromErr(context, newAPIError(err, response))
 		}
 	}
 
@@ -631,7 +631,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 			return nil
 		}
 		log.Printf("[DEBUG] GetDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 	if dedicatedHost != nil && dedicatedHost.LifecycleState != nil && *dedicatedHost.LifecycleState != isDedicatedHostSuspended && *dedicatedHost.LifecycleState != isDedicatedHostFailed {
 
@@ -653,7 +653,7 @@ func resourceIbmIsDedicatedHostDelete(context context.Context, d *schema.Resourc
 	response, err = vpcClient.DeleteDedicatedHostWithContext(context, deleteDedicatedHostOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteDedicatedHostWithContext failed %s\n%s", err, response)
-		return diag.FromErr(err)
+		return diagFromErr(context, newAPIError(err, response))
 	}
 	_, err = isWaitForDedicatedHostDelete(vpcClient, d, d.Id())
 	if err != nil {
@@ -679,7 +679,7 @@ func isWaitForDedicatedHostDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData
 				if response != nil && response.StatusCode == 404 {
 					return dedicatedhost, isDedicatedHostDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error getting dedicated Host: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error getting dedicated Host: %w", newAPIError(err, response))
 			}
 			if *dedicatedhost.State == isDedicatedHostFailed {
 				return dedicatedhost, *dedicatedhost.State, fmt.Errorf("The  Dedicated host %s failed to delete: %v", d.Id(), err)
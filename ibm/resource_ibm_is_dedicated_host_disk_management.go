@@ -91,13 +91,13 @@ func resourceIBMisDedicatedHostDiskManagementCreate(d *schema.ResourceData, meta
 
 		dedicatedHostDiskPatch, err := dedicatedHostDiskPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %w", err)
 		}
 		updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
 		_, _, err = sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
 		if err != nil {
-			return fmt.Errorf("Error calling UpdateDedicatedHostDisk: %s", err)
+			return fmt.Errorf("Error calling UpdateDedicatedHostDisk: %w", err)
 		}
 
 	}
@@ -128,13 +128,13 @@ func resourceIBMisDedicatedHostDiskManagementUpdate(d *schema.ResourceData, meta
 
 			dedicatedHostDiskPatch, err := dedicatedHostDiskPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %s", err)
+				return fmt.Errorf("Error calling asPatch for DedicatedHostDiskPatch: %w", err)
 			}
 			updateDedicatedHostDiskOptions.SetDedicatedHostDiskPatch(dedicatedHostDiskPatch)
 
 			_, response, err := sess.UpdateDedicatedHostDisk(updateDedicatedHostDiskOptions)
 			if err != nil {
-				return fmt.Errorf("Error updating dedicated host disk: %s %s", err, response)
+				return fmt.Errorf("Error updating dedicated host disk: %w", newAPIError(err, response))
 			}
 
 		}
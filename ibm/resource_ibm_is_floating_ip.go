@@ -10,6 +10,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -146,6 +147,15 @@ func classicVpcClient(meta interface{}) (*vpcclassicv1.VpcClassicV1, error) {
 	return sess, err
 }
 
+// vpcExtClient returns the client of the VPC APIs missing from the vpc-go-sdk, sharing the session of vpcClient
+func vpcExtClient(meta interface{}) (*vpcext.VpcExtV1, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	return vpcext.New(sess), nil
+}
+
 func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	sess, err := meta.(ClientSession).VpcV1API()
 	return sess, err
@@ -233,7 +243,7 @@ func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) err
 	}
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Floating IP err %w", newAPIError(err, response))
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
@@ -294,7 +304,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	floatingip, response, err := sess.CreateFloatingIP(createFloatingIPOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Floating IP err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Floating IP err %w", newAPIError(err, response))
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
@@ -349,7 +359,7 @@ func classicFipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Floating IP (%s): %w", id, newAPIError(err, response))
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -391,7 +401,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Floating IP (%s): %w", id, newAPIError(err, response))
 
 	}
 	d.Set(isFloatingIPName, *floatingip.Name)
@@ -455,7 +465,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Floating IP: %s\n%s", err, response)
+			return fmt.Errorf("Error getting Floating IP: %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
@@ -475,7 +485,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %w", err)
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
@@ -488,7 +498,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %w", err)
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
@@ -496,7 +506,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Floating IP: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc Floating IP: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -513,7 +523,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		fip, response, err := sess.GetFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Floating IP: %s\n%s", err, response)
+			return fmt.Errorf("Error getting Floating IP: %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
@@ -533,7 +543,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for FloatingIPPatch: %w", err)
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
@@ -546,14 +556,14 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		hasChanged = true
 		floatingIPPatch, err := floatingIPPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for floatingIPPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for floatingIPPatch: %w", err)
 		}
 		options.FloatingIPPatch = floatingIPPatch
 	}
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Floating IP: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc Floating IP: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -593,7 +603,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 			return nil
 		}
 
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Floating IP (%s): %w", id, newAPIError(err, response))
 
 	}
 
@@ -602,7 +612,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Floating IP : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Floating IP : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -626,7 +636,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error Getting Floating IP (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Floating IP (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcv1.DeleteFloatingIPOptions{
@@ -634,7 +644,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteFloatingIP(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Floating IP : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Floating IP : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -673,7 +683,7 @@ func classicFipExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting floating IP: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -691,7 +701,7 @@ func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting floating IP: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -722,7 +732,7 @@ func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id str
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %w", newAPIError(err, response))
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
@@ -754,7 +764,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && response.StatusCode == 404 {
 				return FloatingIP, isFloatingIPDeleted, nil
 			}
-			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %s\n%s", err, response)
+			return FloatingIP, "", fmt.Errorf("Error Getting Floating IP: %w", newAPIError(err, response))
 		}
 		return FloatingIP, isFloatingIPDeleting, err
 	}
@@ -782,7 +792,7 @@ func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassic
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Floating IP for the instance: %w", newAPIError(err, response))
 		}
 
 		if *instance.Status == "available" {
@@ -815,7 +825,7 @@ func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resour
 		}
 		instance, response, err := floatingipC.GetFloatingIP(getfipoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Floating IP for the instance: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Floating IP for the instance: %w", newAPIError(err, response))
 		}
 
 		if *instance.Status == "available" {
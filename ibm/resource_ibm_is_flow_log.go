@@ -229,7 +229,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
+		return fmt.Errorf("Create Flow Log Collector err %w", newAPIError(err, response))
 	}
 	d.SetId(*flowlogCollector.ID)
 
@@ -262,7 +262,7 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Flow Log Collector: %w", newAPIError(err, response))
 	}
 
 	if flowlogCollector.Name != nil {
@@ -342,7 +342,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Flow Log Collector: %w", newAPIError(err, response))
 	}
 
 	if d.HasChange(isFlowLogTags) {
@@ -366,12 +366,12 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		flowLogCollectorPatch, err := flowLogCollectorPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FlowLogCollectorPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for FlowLogCollectorPatch: %w", err)
 		}
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
 		_, response, err = sess.UpdateFlowLogCollector(updoptions)
 		if err != nil {
-			return fmt.Errorf("Error updating flow log collector:%s\n%s", err, response)
+			return fmt.Errorf("Error updating flow log collector:%w", newAPIError(err, response))
 		}
 	}
 
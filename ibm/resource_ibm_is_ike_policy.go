@@ -250,7 +250,7 @@ func classicIkepCreate(d *schema.ResourceData, meta interface{}, authenticationA
 
 	ike, response, err := sess.CreateIkePolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] ike policy err %w", newAPIError(err, response))
 	}
 	d.SetId(*ike.ID)
 	log.Printf("[INFO] ike policy : %s", *ike.ID)
@@ -290,7 +290,7 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 	}
 	ike, response, err := sess.CreateIkePolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ike policy err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] ike policy err %w", newAPIError(err, response))
 	}
 	d.SetId(*ike.ID)
 	log.Printf("[INFO] ike policy : %s", *ike.ID)
@@ -331,7 +331,7 @@ func classicIkepGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	d.Set(isIKEName, *ike.Name)
@@ -394,7 +394,7 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	d.Set(isIKEName, *ike.Name)
@@ -479,13 +479,13 @@ func classicIkepUpdate(d *schema.ResourceData, meta interface{}, id string) erro
 		ikePolicyPatchModel.IkeVersion = &ikeVersion
 		ikePolicyPatch, err := ikePolicyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ikePolicyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for ikePolicyPatch: %w", err)
 		}
 		options.IkePolicyPatch = ikePolicyPatch
 
 		_, response, err := sess.UpdateIkePolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IKE Policy(%s): %s\n%s", id, err, response)
+			return fmt.Errorf("Error on update of IKE Policy(%s): %w", id, newAPIError(err, response))
 		}
 	}
 	return nil
@@ -516,13 +516,13 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		ikePolicyPatchModel.IkeVersion = &ikeVersion
 		ikePolicyPatch, err := ikePolicyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for IkePolicyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for IkePolicyPatch: %w", err)
 		}
 		options.IkePolicyPatch = ikePolicyPatch
 
 		_, response, err := sess.UpdateIkePolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IKE Policy(%s): %s\n%s", id, err, response)
+			return fmt.Errorf("Error on update of IKE Policy(%s): %w", id, newAPIError(err, response))
 		}
 	}
 	return nil
@@ -564,7 +564,7 @@ func classicIkepDelete(d *schema.ResourceData, meta interface{}, id string) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteIkePolicyOptions := &vpcclassicv1.DeleteIkePolicyOptions{
@@ -572,7 +572,7 @@ func classicIkepDelete(d *schema.ResourceData, meta interface{}, id string) erro
 	}
 	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Deleting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -592,7 +592,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteIkePolicyOptions := &vpcv1.DeleteIkePolicyOptions{
@@ -600,7 +600,7 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteIkePolicy(deleteIkePolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IKE Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Deleting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -635,7 +635,7 @@ func classicikepExists(d *schema.ResourceData, meta interface{}, id string) (boo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return false, fmt.Errorf("Error getting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	return true, nil
@@ -654,7 +654,7 @@ func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, erro
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
+		return false, fmt.Errorf("Error getting IKE Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	return true, nil
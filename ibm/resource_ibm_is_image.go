@@ -278,7 +278,7 @@ func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, oper
 
 	image, response, err := sess.CreateImage(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Image creation err %w", newAPIError(err, response))
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Floating IP : %s", *image.ID)
@@ -334,7 +334,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 	}
 	image, response, err := sess.CreateImage(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Image creation err %w", newAPIError(err, response))
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
@@ -392,14 +392,14 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		}
 		_, response, err = sess.CreateInstanceAction(createinsactoptions)
 		if err != nil {
-			return fmt.Errorf("Error stopping Instance (%s) to which the source_volume (%s) is attached  : %s\n%s", insId, volume, err, response)
+			return fmt.Errorf("Error stopping Instance (%s) to which the source_volume (%s) is attached  : %w", insId, volume, newAPIError(err, response))
 		}
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(context.Background(), sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			return err
 		}
 	} else if *instance.Status != "stopped" {
-		_, err = isWaitForInstanceActionStop(sess, d.Timeout(schema.TimeoutCreate), insId, d)
+		_, err = isWaitForInstanceActionStop(context.Background(), sess, d.Timeout(schema.TimeoutCreate), insId, d)
 		if err != nil {
 			return err
 		}
@@ -423,7 +423,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 	}
 	image, response, err := sess.CreateImage(imagOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Image creation err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Image creation err %w", newAPIError(err, response))
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
@@ -465,7 +465,7 @@ func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) res
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Image: %w", newAPIError(err, response))
 		}
 
 		if *image.Status == "available" || *image.Status == "failed" {
@@ -496,7 +496,7 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 		}
 		image, response, err := imageC.GetImage(getimgoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Image: %w", newAPIError(err, response))
 		}
 
 		if *image.Status == "available" || *image.Status == "failed" {
@@ -546,7 +546,7 @@ func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Image IP: %s\n%s", err, response)
+			return fmt.Errorf("Error getting Image IP: %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isImageTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
@@ -564,13 +564,13 @@ func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		imagePatch, err := imagePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for ImagePatch: %w", err)
 		}
 		options.ImagePatch = imagePatch
 
 		_, response, err := sess.UpdateImage(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of resource vpc Image: %s\n%s", err, response)
+			return fmt.Errorf("Error on update of resource vpc Image: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -587,7 +587,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		image, response, err := sess.GetImage(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Image IP: %s\n%s", err, response)
+			return fmt.Errorf("Error getting Image IP: %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isImageTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
@@ -605,12 +605,12 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		imagePatch, err := imagePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for ImagePatch: %w", err)
 		}
 		options.ImagePatch = imagePatch
 		_, response, err := sess.UpdateImage(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of resource vpc Image: %s\n%s", err, response)
+			return fmt.Errorf("Error on update of resource vpc Image: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -651,7 +651,7 @@ func classicImgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Image (%s): %w", id, newAPIError(err, response))
 	}
 	// d.Set(isImageArchitecure, image.Architecture)
 	d.Set(isImageMinimumProvisionedSize, *image.MinimumProvisionedSize)
@@ -705,7 +705,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Image (%s): %w", id, newAPIError(err, response))
 	}
 	// d.Set(isImageArchitecure, image.Architecture)
 	if image.MinimumProvisionedSize != nil {
@@ -792,7 +792,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Image (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcclassicv1.DeleteImageOptions{
@@ -800,7 +800,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteImage(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Image : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Image : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -823,7 +823,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Image (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Image (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcv1.DeleteImageOptions{
@@ -831,7 +831,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteImage(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Image : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Image : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -867,7 +867,7 @@ func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id strin
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
 			}
-			return image, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return image, "", fmt.Errorf("Error Getting Image: %w", newAPIError(err, response))
 		}
 		return image, isImageDeleting, err
 	}
@@ -898,7 +898,7 @@ func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefr
 			if response != nil && response.StatusCode == 404 {
 				return image, isImageDeleted, nil
 			}
-			return image, "", fmt.Errorf("Error Getting Image: %s\n%s", err, response)
+			return image, "", fmt.Errorf("Error Getting Image: %w", newAPIError(err, response))
 		}
 		return image, isImageDeleting, err
 	}
@@ -932,7 +932,7 @@ func classicImgExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Image: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -950,7 +950,7 @@ func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Image: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isImageExportJobImage            = "image"
+	isImageExportJobID               = "image_export_job"
+	isImageExportJobName             = "name"
+	isImageExportJobFormat           = "format"
+	isImageExportJobStorageBucket    = "storage_bucket"
+	isImageExportJobBucketName       = "name"
+	isImageExportJobBucketCRN        = "crn"
+	isImageExportJobStatus           = "status"
+	isImageExportJobStatusReasons    = "status_reasons"
+	isImageExportJobStorageHref      = "storage_href"
+	isImageExportJobStorageObject    = "storage_object"
+	isImageExportJobEncryptedDataKey = "encrypted_data_key"
+	isImageExportJobStartedAt        = "started_at"
+	isImageExportJobCompletedAt      = "completed_at"
+
+	isImageExportJobStatusQueued    = "queued"
+	isImageExportJobStatusRunning   = "running"
+	isImageExportJobStatusSucceeded = "succeeded"
+	isImageExportJobStatusFailed    = "failed"
+	isImageExportJobStatusDeleting  = "deleting"
+	isImageExportJobDeleteDone      = "done"
+)
+
+// resourceIBMISImageExportJob exports an image to an object of a Cloud Object Storage bucket, and waits for the export
+// to complete. Destroying it cancels the export if it is still running; the exported object is left in its bucket.
+func resourceIBMISImageExportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISImageExportJobCreate,
+		ReadContext:   resourceIBMISImageExportJobRead,
+		UpdateContext: resourceIBMISImageExportJobUpdate,
+		DeleteContext: resourceIBMISImageExportJobDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isImageExportJobImage: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the image to export.",
+			},
+			isImageExportJobStorageBucket: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The Cloud Object Storage bucket to export the image to, by name or CRN. The bucket must exist and the VPC service must be authorized to write to it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isImageExportJobBucketName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"storage_bucket.0.name", "storage_bucket.0.crn"},
+							Description:  "The globally unique name of the bucket.",
+						},
+						isImageExportJobBucketCRN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"storage_bucket.0.name", "storage_bucket.0.crn"},
+							Description:  "The CRN of the bucket.",
+						},
+					},
+				},
+			},
+			isImageExportJobFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      vpcext.ImageExportJobFormatQcow2,
+				ValidateFunc: InvokeValidator("ibm_is_image_export_job", isImageExportJobFormat),
+				Description:  "The format of the exported image: qcow2 or vhd.",
+			},
+			isImageExportJobName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_image_export_job", isImageExportJobName),
+				Description:  "The user-defined name for this export job. It is also the name of the exported object, with the extension of the format.",
+			},
+			isImageExportJobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the export job.",
+			},
+			isImageExportJobStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the export job: queued, running, succeeded, failed or deleting.",
+			},
+			isImageExportJobStatusReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons of the status of the export job.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A snake case string succinctly identifying the status reason.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An explanation of the status reason.",
+						},
+						"more_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A link to documentation about the status reason.",
+						},
+					},
+				},
+			},
+			isImageExportJobStorageHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Object Storage location of the exported image object, e.g. cos://us-south/my-bucket/my-image.qcow2.",
+			},
+			isImageExportJobStorageObject: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the exported image object in the bucket.",
+			},
+			isImageExportJobEncryptedDataKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data encryption key of an encrypted image, wrapped by its root key, to decrypt the exported object with.",
+			},
+			isImageExportJobStartedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the export job started running.",
+			},
+			isImageExportJobCompletedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the export job completed.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the export job was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this export job.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISImageExportJobValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isImageExportJobFormat,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", vpcext.ImageExportJobFormatQcow2, vpcext.ImageExportJobFormatVHD),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isImageExportJobName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_image_export_job", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMISImageExportJobCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Get(isImageExportJobImage).(string)
+	format := d.Get(isImageExportJobFormat).(string)
+	bucket := &vpcext.Reference{}
+	bucketMap := d.Get(isImageExportJobStorageBucket).([]interface{})[0].(map[string]interface{})
+	if name := bucketMap[isImageExportJobBucketName].(string); name != "" {
+		bucket.Name = &name
+	}
+	if crn := bucketMap[isImageExportJobBucketCRN].(string); crn != "" {
+		bucket.CRN = &crn
+	}
+	prototype := &vpcext.ImageExportJobPrototype{
+		Format:        &format,
+		StorageBucket: bucket,
+	}
+	if name, ok := d.GetOk(isImageExportJobName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+
+	job, response, err := client.CreateImageExportJob(context, imageID, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateImageExportJob failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", imageID, *job.ID))
+	log.Printf("[INFO] Image export job : %s", d.Id())
+
+	_, err = isWaitForImageExportJobSucceeded(context, client, imageID, *job.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISImageExportJobRead(context, d, meta)
+}
+
+func resourceIBMISImageExportJobRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of imageID/exportJobID", d.Id()))
+	}
+	imageID, jobID := parts[0], parts[1]
+
+	job, response, err := client.GetImageExportJob(context, imageID, jobID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetImageExportJob failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	d.Set(isImageExportJobImage, imageID)
+	for k, v := range dataSourceIBMISImageExportJobToMap(*job) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMISImageExportJobUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isImageExportJobName) {
+		patch := map[string]interface{}{
+			isImageExportJobName: d.Get(isImageExportJobName).(string),
+		}
+		imageID := d.Get(isImageExportJobImage).(string)
+		jobID := d.Get(isImageExportJobID).(string)
+		_, response, err := client.UpdateImageExportJob(context, imageID, jobID, patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateImageExportJob failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	return resourceIBMISImageExportJobRead(context, d, meta)
+}
+
+func resourceIBMISImageExportJobDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Get(isImageExportJobImage).(string)
+	jobID := d.Get(isImageExportJobID).(string)
+	response, err := client.DeleteImageExportJob(context, imageID, jobID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteImageExportJob failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForImageExportJobDeleted(context, client, imageID, jobID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dataSourceIBMISImageExportJobToMap returns the attributes of an export job common to the ibm_is_image_export_job
+// resource and the export job data sources
+func dataSourceIBMISImageExportJobToMap(job vpcext.ImageExportJob) map[string]interface{} {
+	jobMap := map[string]interface{}{}
+
+	jobMap[isImageExportJobID] = job.ID
+	jobMap[isImageExportJobName] = job.Name
+	jobMap[isImageExportJobFormat] = job.Format
+	if job.StorageBucket != nil {
+		jobMap[isImageExportJobStorageBucket] = []map[string]interface{}{
+			{
+				isImageExportJobBucketName: job.StorageBucket.Name,
+				isImageExportJobBucketCRN:  job.StorageBucket.CRN,
+			},
+		}
+	}
+	jobMap[isImageExportJobStatus] = job.Status
+	statusReasons := make([]map[string]interface{}, 0, len(job.StatusReasons))
+	for _, reason := range job.StatusReasons {
+		statusReasons = append(statusReasons, map[string]interface{}{
+			"code":      reason.Code,
+			"message":   reason.Message,
+			"more_info": reason.MoreInfo,
+		})
+	}
+	jobMap[isImageExportJobStatusReasons] = statusReasons
+	jobMap[isImageExportJobStorageHref] = job.StorageHref
+	if job.StorageObject != nil {
+		jobMap[isImageExportJobStorageObject] = job.StorageObject.Name
+	}
+	jobMap[isImageExportJobEncryptedDataKey] = job.EncryptedDataKey
+	jobMap[isImageExportJobStartedAt] = job.StartedAt
+	jobMap[isImageExportJobCompletedAt] = job.CompletedAt
+	jobMap["created_at"] = job.CreatedAt
+	jobMap["href"] = job.Href
+	jobMap["resource_type"] = job.ResourceType
+
+	return jobMap
+}
+
+func isWaitForImageExportJobSucceeded(ctx context.Context, client *vpcext.VpcExtV1, imageID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to succeed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isImageExportJobStatusQueued, isImageExportJobStatusRunning},
+		Target:  []string{isImageExportJobStatusSucceeded, isImageExportJobStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			job, response, err := client.GetImageExportJob(ctx, imageID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Image Export Job: %w", newAPIError(err, response))
+			}
+			if *job.Status == isImageExportJobStatusFailed {
+				reason := ""
+				if len(job.StatusReasons) > 0 && job.StatusReasons[0].Message != nil {
+					reason = ": " + *job.StatusReasons[0].Message
+				}
+				return job, *job.Status, fmt.Errorf("Image export job (%s) failed%s", id, reason)
+			}
+			return job, *job.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForImageExportJobDeleted(ctx context.Context, client *vpcext.VpcExtV1, imageID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for image export job (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isImageExportJobStatusDeleting},
+		Target:  []string{isImageExportJobDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			job, response, err := client.GetImageExportJob(ctx, imageID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return job, isImageExportJobDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Image Export Job: %w", newAPIError(err, response))
+			}
+			return job, isImageExportJobStatusDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
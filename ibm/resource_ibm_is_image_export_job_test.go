@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISImageExportJob_basic(t *testing.T) {
+	imageName := fmt.Sprintf("tf-image-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-export-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-export-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISImageExportJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISImageExportJobConfig(imageName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISImageExportJobExists("ibm_is_image_export_job.testacc_export"),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.testacc_export", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.testacc_export", "format", "qcow2"),
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.testacc_export", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet("ibm_is_image_export_job.testacc_export", "storage_href"),
+					resource.TestCheckResourceAttr("data.ibm_is_image_export_jobs.testacc_exports", "export_jobs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_image_export_job.testacc_export", "storage_href", "ibm_is_image_export_job.testacc_export", "storage_href"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISImageExportJobConfig(imageName, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_image_export_job.testacc_export", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_image_export_job.testacc_export",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISImageExportJobDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_image_export_job" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetImageExportJob(context.Background(), parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("Image export job still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISImageExportJobExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetImageExportJob(context.Background(), parts[0], parts[1])
+		return err
+	}
+}
+
+func testAccCheckIBMISImageExportJobConfig(imageName, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_image" "testacc_image" {
+		href             = "%s"
+		name             = "%s"
+		operating_system = "%s"
+	}
+
+	resource "ibm_is_image_export_job" "testacc_export" {
+		image = ibm_is_image.testacc_image.id
+		name  = "%s"
+		storage_bucket {
+			name = "%s"
+		}
+	}
+
+	data "ibm_is_image_export_jobs" "testacc_exports" {
+		image = ibm_is_image_export_job.testacc_export.image
+	}
+
+	data "ibm_is_image_export_job" "testacc_export" {
+		image            = ibm_is_image_export_job.testacc_export.image
+		image_export_job = ibm_is_image_export_job.testacc_export.image_export_job
+	}`, image_cos_url, imageName, image_operating_system, name, isImageExportBucket)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -86,18 +87,21 @@ const (
 
 	isPlacementTargetDedicatedHost      = "dedicated_host"
 	isPlacementTargetDedicatedHostGroup = "dedicated_host_group"
+	isPlacementTargetPlacementGroup     = "placement_group"
 	isInstancePlacementTarget           = "placement_target"
+
+	isInstanceIgnoreUnmanagedNetworkInterfaces = "ignore_unmanaged_network_interfaces"
 )
 
 func resourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMisInstanceCreate,
-		Read:   resourceIBMisInstanceRead,
-		Update: resourceIBMisInstanceUpdate,
-		Delete: resourceIBMisInstanceDelete,
-		Exists: resourceIBMisInstanceExists,
+		CreateContext: resourceIBMisInstanceCreate,
+		ReadContext:   resourceIBMisInstanceRead,
+		UpdateContext: resourceIBMisInstanceUpdate,
+		DeleteContext: resourceIBMisInstanceDelete,
+		Exists:        resourceIBMisInstanceExists,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
+			StateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
 				log.Printf("[INFO] Instance (%s) importing", d.Id())
 				id := d.Id()
 				instanceC, err := vpcClient(meta)
@@ -107,13 +111,13 @@ func resourceIBMISInstance() *schema.Resource {
 				getinsOptions := &vpcv1.GetInstanceOptions{
 					ID: &id,
 				}
-				instance, response, err := instanceC.GetInstance(getinsOptions)
+				instance, response, err := instanceC.GetInstanceWithContext(context, getinsOptions)
 				if err != nil {
 					if response != nil && response.StatusCode == 404 {
 						d.SetId("")
 						return nil, nil
 					}
-					return nil, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+					return nil, fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 				}
 				var volumes []string
 				volumes = make([]string, 0)
@@ -139,6 +143,9 @@ func resourceIBMISInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return resourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceVolumeCapacityCustomizeDiff(diff, "boot_volume.0.size")
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -329,6 +336,13 @@ func resourceIBMISInstance() *schema.Resource {
 				},
 			},
 
+			isInstanceIgnoreUnmanagedNetworkInterfaces: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Ignore the secondary network interfaces which are not declared in network_interfaces, such as the ones attached with ibm_is_instance_network_interface resources, instead of replacing the instance to remove them",
+			},
+
 			isInstanceUserData: {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -348,35 +362,39 @@ func resourceIBMISInstance() *schema.Resource {
 			},
 
 			isInstanceBootVolume: {
-				Type:             schema.TypeList,
-				DiffSuppressFunc: applyOnce,
-				Optional:         true,
-				Computed:         true,
-				MaxItems:         1,
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						isInstanceBootAttachmentName: {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: applyOnce,
 						},
 
 						isInstanceVolumeSnapshot: {
-							Type:          schema.TypeString,
-							RequiredWith:  []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceProfile, isInstanceKeys, isInstanceVPC},
-							AtLeastOneOf:  []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot"},
-							ConflictsWith: []string{isInstanceImage, isInstanceSourceTemplate},
-							Optional:      true,
-							ForceNew:      true,
+							Type:             schema.TypeString,
+							RequiredWith:     []string{isInstanceZone, isInstancePrimaryNetworkInterface, isInstanceProfile, isInstanceKeys, isInstanceVPC},
+							AtLeastOneOf:     []string{isInstanceImage, isInstanceSourceTemplate, "boot_volume.0.snapshot"},
+							ConflictsWith:    []string{isInstanceImage, isInstanceSourceTemplate},
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: applyOnce,
 						},
 						isInstanceBootEncryption: {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: applyOnce,
 						},
 						isInstanceBootSize: {
-							Type:     schema.TypeInt,
-							Computed: true,
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The capacity of the boot volume in gigabytes, which can be expanded in place",
 						},
 						isInstanceBootIOPS: {
 							Type:     schema.TypeInt,
@@ -416,7 +434,7 @@ func resourceIBMISInstance() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{isPlacementTargetDedicatedHostGroup},
+				ConflictsWith: []string{isPlacementTargetDedicatedHostGroup, isPlacementTargetPlacementGroup},
 				Description:   "Unique Identifier of the Dedicated Host where the instance will be placed",
 			},
 
@@ -424,10 +442,18 @@ func resourceIBMISInstance() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{isPlacementTargetDedicatedHost},
+				ConflictsWith: []string{isPlacementTargetDedicatedHost, isPlacementTargetPlacementGroup},
 				Description:   "Unique Identifier of the Dedicated Host Group where the instance will be placed",
 			},
 
+			isPlacementTargetPlacementGroup: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isPlacementTargetDedicatedHost, isPlacementTargetDedicatedHostGroup},
+				Description:   "Unique Identifier of the Placement Group for restricting the placement of the instance",
+			},
+
 			isInstanceCPU: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -596,7 +622,7 @@ func resourceIBMISInstanceValidator() *ResourceValidator {
 	return &ibmISInstanceValidator
 }
 
-func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func classicInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -633,6 +659,9 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 			}
 		}
 		volcap := 100
+		if size, ok := bootvol[isInstanceBootSize].(int); ok && size != 0 {
+			volcap = size
+		}
 		volcapint64 := int64(volcap)
 		volprof := "general-purpose"
 		volTemplate.Capacity = &volcapint64
@@ -751,17 +780,17 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 	options := &vpcclassicv1.CreateInstanceOptions{
 		InstancePrototype: instanceproto,
 	}
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.SetId(*instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForClassicInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForClassicInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -778,7 +807,7 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 	return nil
 }
 
-func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceCreateByImage(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -815,6 +844,14 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		instanceproto.PlacementTarget = dHostGrpPlaementTarget
 	}
 
+	if placementGroupIdInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
+		placementGroupIdStr := placementGroupIdInf.(string)
+		placementGroupPlacementTarget := &vpcv1.InstancePlacementTargetPrototype{
+			ID: &placementGroupIdStr,
+		}
+		instanceproto.PlacementTarget = placementGroupPlacementTarget
+	}
+
 	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
 		bootvol := boot.([]interface{})[0].(map[string]interface{})
 		var volTemplate = &vpcv1.VolumePrototypeInstanceByImageContext{}
@@ -831,6 +868,9 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 			}
 		}
 		volcap := 100
+		if size, ok := bootvol[isInstanceBootSize].(int); ok && size != 0 {
+			volcap = size
+		}
 		volcapint64 := int64(volcap)
 		volprof := "general-purpose"
 		volTemplate.Profile = &vpcv1.VolumeProfileIdentity{
@@ -959,17 +999,17 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.SetId(*instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -986,7 +1026,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	return nil
 }
 
-func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
+func instanceCreateByTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image, template string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1030,6 +1070,9 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 			}
 		}
 		volcap := 100
+		if size, ok := bootvol[isInstanceBootSize].(int); ok && size != 0 {
+			volcap = size
+		}
 		volcapint64 := int64(volcap)
 		volprof := "general-purpose"
 
@@ -1159,17 +1202,17 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.SetId(*instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -1186,7 +1229,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	return nil
 }
 
-func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
+func instanceCreateByVolume(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1220,6 +1263,9 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 			}
 		}
 		volcap := 100
+		if size, ok := bootvol[isInstanceBootSize].(int); ok && size != 0 {
+			volcap = size
+		}
 		volcapint64 := int64(volcap)
 		volTemplate.Capacity = &volcapint64
 		volprof := "general-purpose"
@@ -1354,17 +1400,17 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		InstancePrototype: instanceproto,
 	}
 
-	instance, response, err := sess.CreateInstance(options)
+	instance, response, err := sess.CreateInstanceWithContext(ctx, options)
 	if err != nil {
 		log.Printf("[DEBUG] Instance err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.SetId(*instance.ID)
 
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
@@ -1381,10 +1427,10 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	return nil
 }
 
-func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 
 	profile := d.Get(isInstanceProfile).(string)
@@ -1396,48 +1442,48 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	template := d.Get(isInstanceSourceTemplate).(string)
 
 	if userDetails.generation == 1 {
-		err := classicInstanceCreate(d, meta, profile, name, vpcID, zone, image)
+		err := classicInstanceCreate(context, d, meta, profile, name, vpcID, zone, image)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	} else {
 		if snapshot != "" {
-			err := instanceCreateByVolume(d, meta, profile, name, vpcID, zone)
+			err := instanceCreateByVolume(context, d, meta, profile, name, vpcID, zone)
 			if err != nil {
-				return err
+				return diagFromErr(context, err)
 			}
 		} else if template != "" {
-			err := instanceCreateByTemplate(d, meta, profile, name, vpcID, zone, image, template)
+			err := instanceCreateByTemplate(context, d, meta, profile, name, vpcID, zone, image, template)
 			if err != nil {
-				return err
+				return diagFromErr(context, err)
 			}
 		} else {
-			err := instanceCreateByImage(d, meta, profile, name, vpcID, zone, image)
+			err := instanceCreateByImage(context, d, meta, profile, name, vpcID, zone, image)
 			if err != nil {
-				return err
+				return diagFromErr(context, err)
 			}
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(d, meta)
+	return resourceIBMisInstanceUpdate(context, d, meta)
 }
 
-func isWaitForClassicInstanceAvailable(instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForClassicInstanceAvailable(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isInstanceProvisioning},
 		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isClassicInstanceRefreshFunc(instanceC, id, d),
+		Refresh:    isClassicInstanceRefreshFunc(ctx, instanceC, id, d),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	communicator := make(chan interface{})
@@ -1445,7 +1491,7 @@ func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isInstanceProvisioning},
 		Target:     []string{isInstanceStatusRunning, "available", "failed", ""},
-		Refresh:    isInstanceRefreshFunc(instanceC, id, d, communicator),
+		Refresh:    isInstanceRefreshFunc(ctx, instanceC, id, d, communicator),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
@@ -1453,20 +1499,20 @@ func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isClassicInstanceRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
+func isClassicInstanceRefreshFunc(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getinsOptions := &vpcclassicv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting instance: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting instance: %w", newAPIError(err, response))
 		}
 
 		d.Set(isInstanceStatus, *instance.Status)
@@ -1479,14 +1525,14 @@ func isClassicInstanceRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id strin
 	}
 }
 
-func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
+func isInstanceRefreshFunc(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, communicator chan interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 		}
 		d.Set(isInstanceStatus, *instance.Status)
 
@@ -1511,7 +1557,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -1524,52 +1570,80 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("Error retrying instance action start: %s\n%s", err, response)
+				select {
+				case communicator <- fmt.Errorf("Error retrying instance action start: %w", newAPIError(err, response)):
+				case <-ctx.Done():
+				}
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(ctx, instanceC, waitTimeout, id, d)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("Error retrying instance action start: %s\n%s", err, response)
+				select {
+				case communicator <- fmt.Errorf("Error retrying instance action start: %w", newAPIError(err, response)):
+				case <-ctx.Done():
+				}
 				return
 			}
 		case <-communicator:
 			// indicates refresh func is reached target and not proceed with the thread
 			subticker.Stop()
 			return
+		case <-ctx.Done():
+			// Terraform interrupted the operation, stop retrying
+			subticker.Stop()
+			return
 
 		}
 	}
 }
-func resourceIBMisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	ID := d.Id()
 	if userDetails.generation == 1 {
-		err := classicInstanceGet(d, meta, ID)
+		err := classicInstanceGet(context, d, meta, ID)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	} else {
-		err := instanceGet(d, meta, ID)
+		err := instanceGet(context, d, meta, ID)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 	return nil
 }
 
-func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) error {
+// instanceManagedNetworkInterfaces returns the IDs of the secondary network interfaces declared in network_interfaces
+// when ignore_unmanaged_network_interfaces is set, or nil when network_interfaces manages all of them. The network
+// interfaces of a new instance were all created from network_interfaces.
+func instanceManagedNetworkInterfaces(d *schema.ResourceData) map[string]bool {
+	if !d.Get(isInstanceIgnoreUnmanagedNetworkInterfaces).(bool) || d.IsNewResource() {
+		return nil
+	}
+	managed := map[string]bool{}
+	for _, nic := range d.Get(isInstanceNetworkInterfaces).([]interface{}) {
+		if nicMap, ok := nic.(map[string]interface{}); ok {
+			if id, ok := nicMap["id"].(string); ok && id != "" {
+				managed[id] = true
+			}
+		}
+	}
+	return managed
+}
+
+func classicInstanceGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -1577,13 +1651,13 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 	getinsOptions := &vpcclassicv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 	}
 	d.Set(isInstanceName, *instance.Name)
 	if instance.Profile != nil {
@@ -1622,9 +1696,9 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 			InstanceID: &id,
 			ID:         instance.PrimaryNetworkInterface.ID,
 		}
-		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+		insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 		if err != nil {
-			return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return fmt.Errorf("Error getting network interfaces attached to the instance %w", newAPIError(err, response))
 		}
 		currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 		if len(insnic.SecurityGroups) != 0 {
@@ -1651,9 +1725,9 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 					InstanceID: &id,
 					ID:         intfc.ID,
 				}
-				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+				insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return fmt.Errorf("Error getting network interfaces attached to the instance %w", newAPIError(err, response))
 				}
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
 				if len(insnic.SecurityGroups) != 0 {
@@ -1748,7 +1822,7 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 	return nil
 }
 
-func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceGet(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1756,13 +1830,13 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Instance: %s\n%s", err, response)
+		return fmt.Errorf("Error getting Instance: %w", newAPIError(err, response))
 	}
 
 	d.Set(isInstanceName, *instance.Name)
@@ -1802,9 +1876,9 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			InstanceID: &id,
 			ID:         instance.PrimaryNetworkInterface.ID,
 		}
-		insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+		insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 		if err != nil {
-			return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+			return fmt.Errorf("Error getting network interfaces attached to the instance %w", newAPIError(err, response))
 		}
 		currentPrimNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 		currentPrimNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -1822,8 +1896,12 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 
 	if instance.NetworkInterfaces != nil {
 		interfacesList := make([]map[string]interface{}, 0)
+		managedNics := instanceManagedNetworkInterfaces(d)
 		for _, intfc := range instance.NetworkInterfaces {
 			if *intfc.ID != *instance.PrimaryNetworkInterface.ID {
+				if managedNics != nil && !managedNics[*intfc.ID] {
+					continue
+				}
 				currentNic := map[string]interface{}{}
 				currentNic["id"] = *intfc.ID
 				currentNic[isInstanceNicName] = *intfc.Name
@@ -1832,9 +1910,9 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 					InstanceID: &id,
 					ID:         intfc.ID,
 				}
-				insnic, response, err := instanceC.GetInstanceNetworkInterface(getnicoptions)
+				insnic, response, err := instanceC.GetInstanceNetworkInterfaceWithContext(ctx, getnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error getting network interfaces attached to the instance %s\n%s", err, response)
+					return fmt.Errorf("Error getting network interfaces attached to the instance %w", newAPIError(err, response))
 				}
 				currentNic[isInstanceNicAllowIPSpoofing] = *insnic.AllowIPSpoofing
 				currentNic[isInstanceNicSubnet] = *insnic.Subnet.ID
@@ -1885,7 +1963,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 			options := &vpcv1.GetVolumeOptions{
 				ID: instance.BootVolumeAttachment.Volume.ID,
 			}
-			vol, response, err := instanceC.GetVolume(options)
+			vol, response, err := instanceC.GetVolumeWithContext(ctx, options)
 			if err != nil {
 				log.Printf("Error Getting Boot Volume (%s): %s\n%s", id, err, response)
 			}
@@ -1934,11 +2012,14 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func classicInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
 		return err
 	}
+	if d.HasChange("boot_volume.0.size") {
+		return fmt.Errorf("The boot volume of a classic instance cannot be expanded")
+	}
 	id := d.Id()
 	if d.HasChange(isInstanceVolumes) {
 		ovs, nvs := d.GetChange(isInstanceVolumes)
@@ -1956,11 +2037,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						ID: &add[i],
 					},
 				}
-				vol, response, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
+				vol, response, err := instanceC.CreateInstanceVolumeAttachmentWithContext(ctx, createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("Error while attaching volume %q for instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return errorAt(isInstanceVolumes, fmt.Errorf("Error while attaching volume %q for instance %s\n%w", add[i], d.Id(), newAPIError(err, response)))
 				}
-				_, err = isWaitForClassicInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForClassicInstanceVolumeAttached(ctx, instanceC, d, id, *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -1972,7 +2053,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				listvolattoptions := &vpcclassicv1.ListInstanceVolumeAttachmentsOptions{
 					InstanceID: &id,
 				}
-				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+				vols, _, err := instanceC.ListInstanceVolumeAttachmentsWithContext(ctx, listvolattoptions)
 				if err != nil {
 					return err
 				}
@@ -1982,11 +2063,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							InstanceID: &id,
 							ID:         vol.ID,
 						}
-						response, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+						response, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing volume %q for instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return errorAt(isInstanceVolumes, fmt.Errorf("Error while removing volume %q for instance %s\n%w", remove[i], d.Id(), newAPIError(err, response)))
 						}
-						_, err = isWaitForClassicInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForClassicInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
 							return err
 						}
@@ -2010,11 +2091,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &add[i],
 					ID:              &networkID,
 				}
-				_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
+				_, response, err := instanceC.AddSecurityGroupNetworkInterfaceWithContext(ctx, createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return errorAt("primary_network_interface.0.security_groups", fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%w", add[i], d.Id(), newAPIError(err, response)))
 				}
-				_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -2028,11 +2109,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &remove[i],
 					ID:              &networkID,
 				}
-				response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
+				response, err := instanceC.RemoveSecurityGroupNetworkInterfaceWithContext(ctx, deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return errorAt("primary_network_interface.0.security_groups", fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%w", remove[i], d.Id(), newAPIError(err, response)))
 				}
-				_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -2072,11 +2153,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &add[i],
 							ID:              &networkID,
 						}
-						_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
+						_, response, err := instanceC.AddSecurityGroupNetworkInterfaceWithContext(ctx, createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return errorAt(securitygrpKey, fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%w", add[i], d.Id(), newAPIError(err, response)))
 						}
-						_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -2091,11 +2172,11 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &remove[i],
 							ID:              &networkID,
 						}
-						response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
+						response, err := instanceC.RemoveSecurityGroupNetworkInterfaceWithContext(ctx, deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return errorAt(securitygrpKey, fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%w", remove[i], d.Id(), newAPIError(err, response)))
 						}
-						_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -2132,13 +2213,13 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for ImagePatch: %w", err)
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updnetoptions)
 		if err != nil {
-			return err
+			return errorAt(isInstanceName, err)
 		}
 	}
 
@@ -2146,7 +2227,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcclassicv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
 			log.Printf("Error Getting Instance: %s\n%s", err, response)
 		}
@@ -2160,7 +2241,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2187,11 +2268,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					},
 					DeleteVolumeOnInstanceDelete: &volautoDelete,
 				}
-				vol, _, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
+				vol, _, err := instanceC.CreateInstanceVolumeAttachmentWithContext(ctx, createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
+					return errorAt(isInstanceVolumes, fmt.Errorf("Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err))
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(ctx, instanceC, d, id, *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -2203,7 +2284,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 					InstanceID: &id,
 				}
-				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+				vols, _, err := instanceC.ListInstanceVolumeAttachmentsWithContext(ctx, listvolattoptions)
 				if err != nil {
 					return err
 				}
@@ -2213,11 +2294,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							InstanceID: &id,
 							ID:         vol.ID,
 						}
-						_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+						_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
+							return errorAt(isInstanceVolumes, fmt.Errorf("Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err))
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
 							return err
 						}
@@ -2241,11 +2322,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &add[i],
 					ID:              &networkID,
 				}
-				_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
+				_, response, err := instanceC.AddSecurityGroupNetworkInterfaceWithContext(ctx, createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return errorAt("primary_network_interface.0.security_groups", fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%w", add[i], d.Id(), newAPIError(err, response)))
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -2259,11 +2340,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					SecurityGroupID: &remove[i],
 					ID:              &networkID,
 				}
-				response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
+				response, err := instanceC.RemoveSecurityGroupNetworkInterfaceWithContext(ctx, deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return errorAt("primary_network_interface.0.security_groups", fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%w", remove[i], d.Id(), newAPIError(err, response)))
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -2286,15 +2367,15 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		networkInterfacePatch, err := networkInterfacePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %w", err)
 		}
 		updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
-		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
+		_, response, err := instanceC.UpdateInstanceNetworkInterfaceWithContext(ctx, updatepnicfoptions)
 		if err != nil {
-			return fmt.Errorf("Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+			return errorAt("primary_network_interface.0.name", fmt.Errorf("Error while updating name %s for primary network interface of instance %s\n%w", newName, d.Id(), newAPIError(err, response)))
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -2320,11 +2401,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &add[i],
 							ID:              &networkID,
 						}
-						_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
+						_, response, err := instanceC.AddSecurityGroupNetworkInterfaceWithContext(ctx, createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return errorAt(securitygrpKey, fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%w", add[i], d.Id(), newAPIError(err, response)))
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -2339,11 +2420,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							SecurityGroupID: &remove[i],
 							ID:              &networkID,
 						}
-						response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
+						response, err := instanceC.RemoveSecurityGroupNetworkInterfaceWithContext(ctx, deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return errorAt(securitygrpKey, fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%w", remove[i], d.Id(), newAPIError(err, response)))
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -2368,13 +2449,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				networkInterfacePatch, err := instancePatchModel.AsPatch()
 				if err != nil {
-					return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err)
+					return fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %w", err)
 				}
 				updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
-				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
+				_, response, err := instanceC.UpdateInstanceNetworkInterfaceWithContext(ctx, updatepnicfoptions)
 				if err != nil {
-					return fmt.Errorf("Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+					return errorAt(networkNameKey, fmt.Errorf("Error while updating name %s for network interface of instance %s\n%w", newName, d.Id(), newAPIError(err, response)))
 				}
 				if err != nil {
 					return err
@@ -2395,13 +2476,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstancePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for InstancePatch: %w", err)
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+		_, _, err = instanceC.UpdateInstanceWithContext(ctx, updnetoptions)
 		if err != nil {
-			return err
+			return errorAt(isInstanceName, err)
 		}
 	}
 
@@ -2410,13 +2491,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
+		instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("Error Getting Instance (%s): %s\n%s", id, err, response)
+			return fmt.Errorf("Error Getting Instance (%s): %w", id, newAPIError(err, response))
 		}
 
 		if instance != nil && *instance.Status == "running" {
@@ -2425,14 +2506,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return nil
 				}
-				return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+				return fmt.Errorf("Error Creating Instance Action: %w", newAPIError(err, response))
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+			_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 			if err != nil {
 				return err
 			}
@@ -2451,13 +2532,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstancePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for InstancePatch: %w", err)
 		}
 		updnetoptions.InstancePatch = instancePatch
 
-		_, response, err = instanceC.UpdateInstance(updnetoptions)
+		_, response, err = instanceC.UpdateInstanceWithContext(ctx, updnetoptions)
 		if err != nil {
-			return fmt.Errorf("Error in UpdateInstancePatch: %s\n%s", err, response)
+			return errorAt(isInstanceProfile, fmt.Errorf("Error in UpdateInstancePatch: %w", newAPIError(err, response)))
 		}
 
 		actiontype := "start"
@@ -2465,14 +2546,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+			return fmt.Errorf("Error Creating Instance Action: %w", newAPIError(err, response))
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -2482,9 +2563,18 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
+	}
+	if d.HasChange("boot_volume.0.size") && instance.BootVolumeAttachment != nil && instance.BootVolumeAttachment.Volume != nil {
+		volumePatch := map[string]interface{}{
+			"capacity": int64(d.Get("boot_volume.0.size").(int)),
+		}
+		err = volUpdateCapacity(instanceC, *instance.BootVolumeAttachment.Volume.ID, volumePatch, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
 	}
 	if d.HasChange(isInstanceTags) {
 		oldList, newList := d.GetChange(isInstanceTags)
@@ -2497,28 +2587,28 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	if userDetails.generation == 1 {
-		err := classicInstanceUpdate(d, meta)
+		err := classicInstanceUpdate(context, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	} else {
-		err := instanceUpdate(d, meta)
+		err := instanceUpdate(context, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 
-	return resourceIBMisInstanceRead(d, meta)
+	return resourceIBMisInstanceRead(context, d, meta)
 }
 
-func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -2527,36 +2617,36 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	getinsOptions := &vpcclassicv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
+	_, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Instance (%s): %w", id, newAPIError(err, response))
 	}
 	actiontype := "stop"
 	createinsactoptions := &vpcclassicv1.CreateInstanceActionOptions{
 		InstanceID: &id,
 		Type:       &actiontype,
 	}
-	_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+	_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+		return fmt.Errorf("Error Creating Instance Action: %w", newAPIError(err, response))
 	}
-	_, err = isWaitForClassicInstanceActionStop(instanceC, d, meta, id)
+	_, err = isWaitForClassicInstanceActionStop(ctx, instanceC, d, meta, id)
 	if err != nil {
 		return err
 	}
 	listvolattoptions := &vpcclassicv1.ListInstanceVolumeAttachmentsOptions{
 		InstanceID: &id,
 	}
-	vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+	vols, response, err := instanceC.ListInstanceVolumeAttachmentsWithContext(ctx, listvolattoptions)
 	if err != nil {
-		return fmt.Errorf("Error Listing volume attachments to the instance: %s\n%s", err, response)
+		return fmt.Errorf("Error Listing volume attachments to the instance: %w", newAPIError(err, response))
 	}
 	bootvolid := ""
 	for _, vol := range vols.VolumeAttachments {
@@ -2565,11 +2655,11 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 				InstanceID: &id,
 				ID:         vol.ID,
 			}
-			_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+			_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, delvolattoptions)
 			if err != nil {
 				return fmt.Errorf("Error while removing volume attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 			}
-			_, err = isWaitForClassicInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+			_, err = isWaitForClassicInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 			if err != nil {
 				return err
 			}
@@ -2581,11 +2671,11 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	deleteinstanceOptions := &vpcclassicv1.DeleteInstanceOptions{
 		ID: &id,
 	}
-	_, err = instanceC.DeleteInstance(deleteinstanceOptions)
+	_, err = instanceC.DeleteInstanceWithContext(ctx, deleteinstanceOptions)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicInstanceDelete(instanceC, d, d.Id())
+	_, err = isWaitForClassicInstanceDelete(ctx, instanceC, d, d.Id())
 	if err != nil {
 		return err
 	}
@@ -2598,7 +2688,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	return nil
 }
 
-func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2608,13 +2698,13 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	getinsOptions := &vpcv1.GetInstanceOptions{
 		ID: &id,
 	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
+	_, response, err := instanceC.GetInstanceWithContext(ctx, getinsOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Instance (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Instance (%s): %w", id, newAPIError(err, response))
 	}
 
 	bootvolid := ""
@@ -2625,23 +2715,23 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			InstanceID: &id,
 			Type:       &actiontype,
 		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
+		_, response, err = instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
 			}
-			return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
+			return fmt.Errorf("Error Creating Instance Action: %w", newAPIError(err, response))
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			return err
 		}
 		listvolattoptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
 			InstanceID: &id,
 		}
-		vols, response, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
+		vols, response, err := instanceC.ListInstanceVolumeAttachmentsWithContext(ctx, listvolattoptions)
 		if err != nil {
-			return fmt.Errorf("Error Listing volume attachments to the instance: %s\n%s", err, response)
+			return fmt.Errorf("Error Listing volume attachments to the instance: %w", newAPIError(err, response))
 		}
 		for _, vol := range vols.VolumeAttachments {
 			if *vol.Type == "data" {
@@ -2649,11 +2739,11 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 					InstanceID: &id,
 					ID:         vol.ID,
 				}
-				_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+				_, err := instanceC.DeleteInstanceVolumeAttachmentWithContext(ctx, delvolattoptions)
 				if err != nil {
 					return fmt.Errorf("Error while removing volume Attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -2666,17 +2756,17 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	deleteinstanceOptions := &vpcv1.DeleteInstanceOptions{
 		ID: &id,
 	}
-	_, err = instanceC.DeleteInstance(deleteinstanceOptions)
+	_, err = instanceC.DeleteInstanceWithContext(ctx, deleteinstanceOptions)
 	if err != nil {
 		return err
 	}
 	if cleanDelete {
-		_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+		_, err = isWaitForInstanceDelete(ctx, instanceC, d, d.Id())
 		if err != nil {
 			return err
 		}
 		if _, ok := d.GetOk(isInstanceBootVolume); ok {
-			_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForVolumeDeleted(ctx, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return err
 			}
@@ -2685,22 +2775,22 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(context, err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicInstanceDelete(d, meta, id)
+		err := classicInstanceDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	} else {
-		err := instanceDelete(d, meta, id)
+		err := instanceDelete(context, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(context, err)
 		}
 	}
 
@@ -2721,7 +2811,7 @@ func classicInstanceExists(d *schema.ResourceData, meta interface{}, id string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -2739,7 +2829,7 @@ func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -2759,7 +2849,7 @@ func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool
 	}
 }
 
-func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForClassicInstanceDelete(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -2768,12 +2858,12 @@ func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *sch
 			getinsoptions := &vpcclassicv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to delete: %v", d.Id(), err)
@@ -2785,10 +2875,10 @@ func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *sch
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForInstanceDelete(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -2797,12 +2887,12 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return instance, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 			}
 			if *instance.Status == isInstanceFailed {
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to delete: %v", d.Id(), err)
@@ -2814,9 +2904,9 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
+func isWaitForClassicInstanceActionStop(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -2825,9 +2915,9 @@ func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d
 			getinsoptions := &vpcclassicv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 			}
 			if *instance.Status == isInstanceStatusFailed {
 				return instance, *instance.Status, fmt.Errorf("The  instance %s failed to stop: %v", d.Id(), err)
@@ -2839,9 +2929,9 @@ func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStop(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -2850,9 +2940,9 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 			getinsoptions := &vpcv1.GetInstanceOptions{
 				ID: &id,
 			}
-			instance, response, err := instanceC.GetInstance(getinsoptions)
+			instance, response, err := instanceC.GetInstanceWithContext(ctx, getinsoptions)
 			if err != nil {
-				return nil, "", fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 			}
 			select {
 			case data := <-communicator:
@@ -2874,13 +2964,13 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStopAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStopAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -2893,44 +2983,51 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 				InstanceID: &id,
 				Type:       &actiontype,
 			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
+			_, response, err := instanceC.CreateInstanceActionWithContext(ctx, createinsactoptions)
 			if err != nil {
-				communicator <- fmt.Errorf("Error retrying instance action stop: %s\n%s", err, response)
+				select {
+				case communicator <- fmt.Errorf("Error retrying instance action stop: %w", newAPIError(err, response)):
+				case <-ctx.Done():
+				}
 				return
 			}
 		case <-communicator:
 			// indicates refresh func is reached target and not proceed with the thread)
 			subticker.Stop()
 			return
+		case <-ctx.Done():
+			// Terraform interrupted the operation, stop retrying
+			subticker.Stop()
+			return
 
 		}
 	}
 }
 
-func isWaitForClassicInstanceVolumeAttached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForClassicInstanceVolumeAttached(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance volume (%s) to be attched.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isInstanceVolumeAttaching},
 		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isClassicInstanceVolumeRefreshFunc(instanceC, id, volID),
+		Refresh:    isClassicInstanceVolumeRefreshFunc(ctx, instanceC, id, volID),
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id, volID string) resource.StateRefreshFunc {
+func isClassicInstanceVolumeRefreshFunc(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, id, volID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getvolattoptions := &vpcclassicv1.GetInstanceVolumeAttachmentOptions{
 			InstanceID: &id,
 			ID:         &volID,
 		}
-		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+		vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Attaching volume: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Attaching volume: %w", newAPIError(err, response))
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -2941,30 +3038,30 @@ func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) volume (%s) to be attached.", id, volID)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isInstanceVolumeAttaching},
 		Target:     []string{isInstanceVolumeAttached, ""},
-		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
+		Refresh:    isInstanceVolumeRefreshFunc(ctx, instanceC, id, volID),
 		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
+func isInstanceVolumeRefreshFunc(ctx context.Context, instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
 			InstanceID: &id,
 			ID:         &volID,
 		}
-		vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+		vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Attaching volume: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Attaching volume: %w", newAPIError(err, response))
 		}
 
 		if *vol.Status == isInstanceVolumeAttached {
@@ -2975,7 +3072,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 	}
 }
 
-func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForClassicInstanceVolumeDetached(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -2985,12 +3082,12 @@ func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1
 				InstanceID: &id,
 				ID:         &volID,
 			}
-			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+			vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Detaching volume: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error Detaching volume: %w", newAPIError(err, response))
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
@@ -3002,10 +3099,10 @@ func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeDetached(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -3015,12 +3112,12 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				InstanceID: &id,
 				ID:         &volID,
 			}
-			vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+			vol, response, err := instanceC.GetInstanceVolumeAttachmentWithContext(ctx, getvolattoptions)
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
 					return vol, isInstanceDeleteDone, nil
 				}
-				return nil, "", fmt.Errorf("Error Detaching: %s\n%s", err, response)
+				return nil, "", fmt.Errorf("Error Detaching: %w", newAPIError(err, response))
 			}
 			if *vol.Status == isInstanceFailed {
 				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
@@ -3032,7 +3129,7 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIbmIsInstanceInstanceDiskToMap(instanceDisk vpcv1.InstanceDisk) map[string]interface{} {
@@ -92,13 +92,13 @@ func resourceIBMisInstanceDiskManagementCreate(d *schema.ResourceData, meta inte
 
 		instanceDiskPatch, err := instanceDiskPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %w", err)
 		}
 		updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
 		_, response, err := sess.UpdateInstanceDisk(updateInstanceDiskOptions)
 		if err != nil {
-			return fmt.Errorf("Error calling UpdateInstanceDisk: %s %s", err, response)
+			return fmt.Errorf("Error calling UpdateInstanceDisk: %w", newAPIError(err, response))
 		}
 
 	}
@@ -130,13 +130,13 @@ func resourceIBMisInstanceDiskManagementUpdate(d *schema.ResourceData, meta inte
 
 			instanceDiskPatch, err := instanceDiskPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %s", err)
+				return fmt.Errorf("Error calling asPatch for InstanceDiskPatch: %w", err)
 			}
 			updateInstanceDiskOptions.SetInstanceDiskPatch(instanceDiskPatch)
 
 			_, _, err = sess.UpdateInstanceDisk(updateInstanceDiskOptions)
 			if err != nil {
-				return fmt.Errorf("Error updating instance disk: %s", err)
+				return fmt.Errorf("Error updating instance disk: %w", err)
 			}
 
 		}
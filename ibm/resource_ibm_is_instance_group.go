@@ -333,12 +333,12 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		instanceGroupUpdateOptions.ID = &instanceGroupID
 		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceGroupPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for InstanceGroupPatch: %w", err)
 		}
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating InstanceGroup: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating InstanceGroup: %w", newAPIError(err, response))
 		}
 
 		// wait for instance group health update with update timeout configured.
@@ -439,14 +439,14 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroupPatchModel.MembershipCount = &zeroMembers
 	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+		return fmt.Errorf("Error calling asPatch for ImagePatch: %w", err)
 	}
 
 	instanceGroupUpdateOptions.ID = &instanceGroupID
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 	if err != nil {
-		return fmt.Errorf("Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
+		return fmt.Errorf("Error updating instanceGroup's instance count to 0 : %w", newAPIError(err, response))
 	}
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
@@ -509,7 +509,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting InstanceGroup: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
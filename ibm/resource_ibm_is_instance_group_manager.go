@@ -331,7 +331,7 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 		updateInstanceGroupManagerOptions.InstanceGroupID = &instanceGroupID
 		instanceGroupManagerPatch, err := instanceGroupManagerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceGroupManagerPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for InstanceGroupManagerPatch: %w", err)
 		}
 		updateInstanceGroupManagerOptions.InstanceGroupManagerPatch = instanceGroupManagerPatch
 
@@ -342,7 +342,7 @@ func resourceIBMISInstanceGroupManagerUpdate(d *schema.ResourceData, meta interf
 
 		_, response, err := sess.UpdateInstanceGroupManager(&updateInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating InstanceGroup manager: %s\n%s", err, response)
+			return fmt.Errorf("Error updating InstanceGroup manager: %w", newAPIError(err, response))
 		}
 	}
 	return resourceIBMISInstanceGroupManagerRead(d, meta)
@@ -449,7 +449,7 @@ func resourceIBMISInstanceGroupManagerDelete(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup Manager: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting the InstanceGroup Manager: %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -228,7 +228,7 @@ func resourceIBMISInstanceGroupManagerActionCreate(d *schema.ResourceData, meta
 		runat := v.(string)
 		datetime, err := strfmt.ParseDateTime(runat)
 		if err != nil {
-			return fmt.Errorf("error in converting run_at to datetime format %s", err)
+			return fmt.Errorf("error in converting run_at to datetime format %w", err)
 		}
 		instanceGroupManagerActionPrototype.RunAt = &datetime
 	}
@@ -306,7 +306,7 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 		runat := d.Get("run_at").(string)
 		datetime, err := strfmt.ParseDateTime(runat)
 		if err != nil {
-			return fmt.Errorf("error in converting run_at to datetime format %s", err)
+			return fmt.Errorf("error in converting run_at to datetime format %w", err)
 		}
 		instanceGroupManagerActionPatchModel.RunAt = &datetime
 		changed = true
@@ -353,7 +353,7 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 
 		instanceGroupManagerActionPatch, err := instanceGroupManagerActionPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("error calling asPatch for instanceGroupManagerActionPatch: %s", err)
+			return fmt.Errorf("error calling asPatch for instanceGroupManagerActionPatch: %w", err)
 		}
 		updateInstanceGroupManagerActionOptions.InstanceGroupManagerActionPatch = instanceGroupManagerActionPatch
 
@@ -363,7 +363,7 @@ func resourceIBMISInstanceGroupManagerActionUpdate(d *schema.ResourceData, meta
 		}
 		_, response, err := sess.UpdateInstanceGroupManagerAction(updateInstanceGroupManagerActionOptions)
 		if err != nil {
-			return fmt.Errorf("error updating InstanceGroup manager action: %s\n%s", err, response)
+			return fmt.Errorf("error updating InstanceGroup manager action: %w", newAPIError(err, response))
 		}
 	}
 	return resourceIBMISInstanceGroupManagerRead(d, meta)
@@ -498,7 +498,7 @@ func resourceIBMISInstanceGroupManagerActionDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error Deleting the InstanceGroup Manager Action: %s\n%s", err, response)
+		return fmt.Errorf("error Deleting the InstanceGroup Manager Action: %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -529,7 +529,7 @@ func resourceIBMISInstanceGroupManagerActionExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("error Getting InstanceGroup Manager Action: %s\n%s", err, response)
+		return false, fmt.Errorf("error Getting InstanceGroup Manager Action: %w", newAPIError(err, response))
 	}
 
 	return true, nil
@@ -201,7 +201,7 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(d *schema.ResourceData, meta
 
 		_, response, err := sess.UpdateInstanceGroupManagerPolicy(&updateInstanceGroupManagerPolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating InstanceGroup Manager Policy: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating InstanceGroup Manager Policy: %w", newAPIError(err, response))
 		}
 	}
 	return resourceIBMISInstanceGroupManagerPolicyRead(d, meta)
@@ -280,7 +280,7 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup Manager Policy: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting the InstanceGroup Manager Policy: %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -314,7 +314,7 @@ func resourceIBMISInstanceGroupManagerPolicyExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceGroup Manager Policy: %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting InstanceGroup Manager Policy: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -199,12 +199,12 @@ func resourceIBMISInstanceGroupMembershipUpdate(d *schema.ResourceData, meta int
 			updateInstanceGroupMembershipOptions.InstanceGroupID = &instanceGroupID
 			instanceGroupMembershipPatch, err := instanceGroupMembershipPatchModel.AsPatch()
 			if err != nil {
-				return fmt.Errorf("Error calling asPatch for InstanceGroupMembershipPatch: %s", err)
+				return fmt.Errorf("Error calling asPatch for InstanceGroupMembershipPatch: %w", err)
 			}
 			updateInstanceGroupMembershipOptions.InstanceGroupMembershipPatch = instanceGroupMembershipPatch
 			_, response, err := sess.UpdateInstanceGroupMembership(&updateInstanceGroupMembershipOptions)
 			if err != nil {
-				return fmt.Errorf("Error updating InstanceGroup Membership: %s\n%s", err, response)
+				return fmt.Errorf("Error updating InstanceGroup Membership: %w", newAPIError(err, response))
 			}
 		}
 	}
@@ -291,7 +291,7 @@ func resourceIBMISInstanceGroupMembershipDelete(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup Membership: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting the InstanceGroup Membership: %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceNetworkInterfaceInstance         = "instance"
+	isInstanceNetworkInterfaceNetworkInterface = "network_interface"
+	isInstanceNetworkInterfaceFloatingIP       = "floating_ip"
+	isInstanceNetworkInterfaceStatus           = "status"
+
+	isInstanceNetworkInterfaceStatusPending   = "pending"
+	isInstanceNetworkInterfaceStatusAvailable = "available"
+	isInstanceNetworkInterfaceStatusDeleting  = "deleting"
+	isInstanceNetworkInterfaceStatusFailed    = "failed"
+	isInstanceNetworkInterfaceDeleteDone      = "done"
+)
+
+func resourceIBMISInstanceNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISInstanceNetworkInterfaceCreate,
+		ReadContext:   resourceIBMISInstanceNetworkInterfaceRead,
+		UpdateContext: resourceIBMISInstanceNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMISInstanceNetworkInterfaceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceNetworkInterfaceInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the instance.",
+			},
+			isInstanceNicSubnet: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the subnet of the network interface.",
+			},
+			isInstanceNicName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_instance_network_interface", isInstanceNicName),
+				Description:  "The user-defined name for the network interface. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			isInstanceNicPrimaryIpv4Address: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The primary IPv4 address. If unspecified, a single available address is selected from the subnet.",
+			},
+			isInstanceNicAllowIPSpoofing: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether source IP spoofing is allowed on this interface.",
+			},
+			isInstanceNicSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups of the network interface. If unspecified, the VPC's default security group is used.",
+			},
+			isInstanceNetworkInterfaceFloatingIP: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The unique identifier of a floating IP to associate with the network interface.",
+			},
+			isInstanceNetworkInterfaceNetworkInterface: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the network interface.",
+			},
+			isInstanceNetworkInterfaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the network interface.",
+			},
+			isInstanceNicPortSpeed: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The network interface port speed in Mbps.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the network interface was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this network interface.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of this network interface as it relates to an instance.",
+			},
+		},
+	}
+}
+
+func resourceIBMISInstanceNetworkInterfaceValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceNicName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	ibmISInstanceNetworkInterfaceValidator := ResourceValidator{ResourceName: "ibm_is_instance_network_interface", Schema: validateSchema}
+	return &ibmISInstanceNetworkInterfaceValidator
+}
+
+func resourceIBMISInstanceNetworkInterfaceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get(isInstanceNetworkInterfaceInstance).(string)
+	subnetID := d.Get(isInstanceNicSubnet).(string)
+	ibmMutexKV.Lock(instanceID)
+	defer ibmMutexKV.Unlock(instanceID)
+
+	options := &vpcv1.CreateInstanceNetworkInterfaceOptions{
+		InstanceID: &instanceID,
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: &subnetID,
+		},
+	}
+	allowIPSpoofing := d.Get(isInstanceNicAllowIPSpoofing).(bool)
+	options.AllowIPSpoofing = &allowIPSpoofing
+	if name, ok := d.GetOk(isInstanceNicName); ok {
+		nameStr := name.(string)
+		options.Name = &nameStr
+	}
+	if address, ok := d.GetOk(isInstanceNicPrimaryIpv4Address); ok {
+		addressStr := address.(string)
+		options.PrimaryIpv4Address = &addressStr
+	}
+	if sgs, ok := d.GetOk(isInstanceNicSecurityGroups); ok {
+		for _, sg := range expandStringList(sgs.(*schema.Set).List()) {
+			sgID := sg
+			options.SecurityGroups = append(options.SecurityGroups, &vpcv1.SecurityGroupIdentity{ID: &sgID})
+		}
+	}
+
+	nic, response, err := sess.CreateInstanceNetworkInterfaceWithContext(context, options)
+	if err != nil {
+		log.Printf("[DEBUG] CreateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *nic.ID))
+	log.Printf("[INFO] Instance network interface : %s", d.Id())
+
+	_, err = isWaitForInstanceNetworkInterfaceAvailable(context, sess, instanceID, *nic.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	if floatingIP, ok := d.GetOk(isInstanceNetworkInterfaceFloatingIP); ok {
+		if err = instanceNetworkInterfaceFloatingIPAdd(context, sess, instanceID, *nic.ID, floatingIP.(string)); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	return resourceIBMISInstanceNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMISInstanceNetworkInterfaceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceID/networkInterfaceID", d.Id()))
+	}
+	instanceID, nicID := parts[0], parts[1]
+
+	options := &vpcv1.GetInstanceNetworkInterfaceOptions{
+		InstanceID: &instanceID,
+		ID:         &nicID,
+	}
+	nic, response, err := sess.GetInstanceNetworkInterfaceWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	d.Set(isInstanceNetworkInterfaceInstance, instanceID)
+	d.Set(isInstanceNetworkInterfaceNetworkInterface, *nic.ID)
+	d.Set(isInstanceNicName, *nic.Name)
+	d.Set(isInstanceNicSubnet, *nic.Subnet.ID)
+	d.Set(isInstanceNicPrimaryIpv4Address, *nic.PrimaryIpv4Address)
+	d.Set(isInstanceNicAllowIPSpoofing, *nic.AllowIPSpoofing)
+	sgs := make([]string, 0, len(nic.SecurityGroups))
+	for _, sg := range nic.SecurityGroups {
+		sgs = append(sgs, *sg.ID)
+	}
+	d.Set(isInstanceNicSecurityGroups, newStringSet(schema.HashString, sgs))
+	floatingIP := ""
+	if len(nic.FloatingIps) != 0 {
+		floatingIP = *nic.FloatingIps[0].ID
+	}
+	d.Set(isInstanceNetworkInterfaceFloatingIP, floatingIP)
+	d.Set(isInstanceNetworkInterfaceStatus, *nic.Status)
+	d.Set(isInstanceNicPortSpeed, *nic.PortSpeed)
+	d.Set("created_at", nic.CreatedAt.String())
+	d.Set("href", *nic.Href)
+	d.Set("type", *nic.Type)
+
+	return nil
+}
+
+func resourceIBMISInstanceNetworkInterfaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, nicID := parts[0], parts[1]
+
+	if d.HasChange(isInstanceNicName) || d.HasChange(isInstanceNicAllowIPSpoofing) {
+		name := d.Get(isInstanceNicName).(string)
+		allowIPSpoofing := d.Get(isInstanceNicAllowIPSpoofing).(bool)
+		networkInterfacePatchModel := &vpcv1.NetworkInterfacePatch{
+			Name:            &name,
+			AllowIPSpoofing: &allowIPSpoofing,
+		}
+		networkInterfacePatch, err := networkInterfacePatchModel.AsPatch()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error calling asPatch for NetworkInterfacePatch: %s", err))
+		}
+		options := &vpcv1.UpdateInstanceNetworkInterfaceOptions{
+			InstanceID:            &instanceID,
+			ID:                    &nicID,
+			NetworkInterfacePatch: networkInterfacePatch,
+		}
+		_, response, err := sess.UpdateInstanceNetworkInterfaceWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	if d.HasChange(isInstanceNicSecurityGroups) {
+		oldSgs, newSgs := d.GetChange(isInstanceNicSecurityGroups)
+		if err = securityGroupTargetBindingsUpdate(context, sess, nicID, oldSgs.(*schema.Set), newSgs.(*schema.Set)); err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	if d.HasChange(isInstanceNetworkInterfaceFloatingIP) {
+		oldFloatingIP, newFloatingIP := d.GetChange(isInstanceNetworkInterfaceFloatingIP)
+		if oldFloatingIPStr := oldFloatingIP.(string); oldFloatingIPStr != "" {
+			options := &vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions{
+				InstanceID:         &instanceID,
+				NetworkInterfaceID: &nicID,
+				ID:                 &oldFloatingIPStr,
+			}
+			response, err := sess.RemoveInstanceNetworkInterfaceFloatingIPWithContext(context, options)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				log.Printf("[DEBUG] RemoveInstanceNetworkInterfaceFloatingIPWithContext failed %s\n%s", err, response)
+				return diagFromErr(context, newAPIError(err, response))
+			}
+		}
+		if newFloatingIP.(string) != "" {
+			if err = instanceNetworkInterfaceFloatingIPAdd(context, sess, instanceID, nicID, newFloatingIP.(string)); err != nil {
+				return diagFromErr(context, err)
+			}
+		}
+	}
+
+	return resourceIBMISInstanceNetworkInterfaceRead(context, d, meta)
+}
+
+func resourceIBMISInstanceNetworkInterfaceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, nicID := parts[0], parts[1]
+	ibmMutexKV.Lock(instanceID)
+	defer ibmMutexKV.Unlock(instanceID)
+
+	options := &vpcv1.DeleteInstanceNetworkInterfaceOptions{
+		InstanceID: &instanceID,
+		ID:         &nicID,
+	}
+	response, err := sess.DeleteInstanceNetworkInterfaceWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteInstanceNetworkInterfaceWithContext failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForInstanceNetworkInterfaceDeleted(context, sess, instanceID, nicID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func instanceNetworkInterfaceFloatingIPAdd(ctx context.Context, sess *vpcv1.VpcV1, instanceID, nicID, floatingIP string) error {
+	options := &vpcv1.AddInstanceNetworkInterfaceFloatingIPOptions{
+		InstanceID:         &instanceID,
+		NetworkInterfaceID: &nicID,
+		ID:                 &floatingIP,
+	}
+	_, response, err := sess.AddInstanceNetworkInterfaceFloatingIPWithContext(ctx, options)
+	if err != nil {
+		log.Printf("[DEBUG] AddInstanceNetworkInterfaceFloatingIPWithContext failed %s\n%s", err, response)
+		return newAPIError(err, response)
+	}
+	return nil
+}
+
+func isWaitForInstanceNetworkInterfaceAvailable(ctx context.Context, sess *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of instance (%s) to be available.", id, instanceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isInstanceNetworkInterfaceStatusPending},
+		Target:  []string{isInstanceNetworkInterfaceStatusAvailable, isInstanceNetworkInterfaceStatusFailed},
+		Refresh: func() (interface{}, string, error) {
+			options := &vpcv1.GetInstanceNetworkInterfaceOptions{
+				InstanceID: &instanceID,
+				ID:         &id,
+			}
+			nic, response, err := sess.GetInstanceNetworkInterfaceWithContext(ctx, options)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Instance Network Interface: %w", newAPIError(err, response))
+			}
+			if *nic.Status == isInstanceNetworkInterfaceStatusFailed {
+				return nic, *nic.Status, fmt.Errorf("Network interface (%s) of instance (%s) went into failed state", id, instanceID)
+			}
+			return nic, *nic.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForInstanceNetworkInterfaceDeleted(ctx context.Context, sess *vpcv1.VpcV1, instanceID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of instance (%s) to be deleted.", id, instanceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isInstanceNetworkInterfaceStatusDeleting, isInstanceNetworkInterfaceStatusAvailable},
+		Target:  []string{isInstanceNetworkInterfaceDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			options := &vpcv1.GetInstanceNetworkInterfaceOptions{
+				InstanceID: &instanceID,
+				ID:         &id,
+			}
+			nic, response, err := sess.GetInstanceNetworkInterfaceWithContext(ctx, options)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nic, isInstanceNetworkInterfaceDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Instance Network Interface: %w", newAPIError(err, response))
+			}
+			if *nic.Status == isInstanceNetworkInterfaceStatusFailed {
+				return nic, *nic.Status, fmt.Errorf("Network interface (%s) of instance (%s) went into failed state during the deletion", id, instanceID)
+			}
+			return nic, isInstanceNetworkInterfaceStatusDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
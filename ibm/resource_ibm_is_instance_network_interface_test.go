@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISInstanceNetworkInterface_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, "eth2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_network_interface.testacc_nic", "name", "eth2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_network_interface.testacc_nic", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_network_interface.testacc_nic", "allow_ip_spoofing", "false"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "network_interfaces.0.name", "eth1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, "eth2-upd", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_network_interface.testacc_nic", "name", "eth2-upd"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_network_interface.testacc_nic", "allow_ip_spoofing", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_network_interface.testacc_nic", "security_groups.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_network_interface.testacc_nic", "floating_ip",
+						"ibm_is_floating_ip.testacc_fip", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "network_interfaces.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_instance_network_interface.testacc_nic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestInstanceManagedNetworkInterfaces(t *testing.T) {
+	nics := []interface{}{
+		map[string]interface{}{"id": "0717-nic1", "subnet": "0717-subnet"},
+	}
+	d := schema.TestResourceDataRaw(t, resourceIBMISInstance().Schema, map[string]interface{}{
+		"network_interfaces": nics,
+	})
+	d.SetId("0717-instance")
+	if managed := instanceManagedNetworkInterfaces(d); managed != nil {
+		t.Errorf("expected all the network interfaces to be managed, got %v", managed)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceIBMISInstance().Schema, map[string]interface{}{
+		"network_interfaces":                  nics,
+		"ignore_unmanaged_network_interfaces": true,
+	})
+	d.SetId("0717-instance")
+	managed := instanceManagedNetworkInterfaces(d)
+	if len(managed) != 1 || !managed["0717-nic1"] {
+		t.Errorf("expected only 0717-nic1 to be managed, got %v", managed)
+	}
+
+	d.MarkNewResource()
+	if managed := instanceManagedNetworkInterfaces(d); managed != nil {
+		t.Errorf("expected all the network interfaces of a new instance to be managed, got %v", managed)
+	}
+}
+
+func testAccCheckIBMISInstanceNetworkInterfaceDestroy(s *terraform.State) error {
+	sess, err := vpcClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_instance_network_interface" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		options := &vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: &parts[0],
+			ID:         &parts[1],
+		}
+		_, _, err = sess.GetInstanceNetworkInterfaceWithContext(context.Background(), options)
+		if err == nil {
+			return fmt.Errorf("Instance network interface still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISInstanceNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicName string, update bool) string {
+	updateConfig := ""
+	if update {
+		updateConfig = `
+		allow_ip_spoofing = true
+		security_groups   = [ibm_is_vpc.testacc_vpc.default_security_group, ibm_is_security_group.testacc_sg.id]
+		floating_ip       = ibm_is_floating_ip.testacc_fip.id`
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	  resource "ibm_is_security_group" "testacc_sg" {
+		name = "%s-sg"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	  }
+
+	  resource "ibm_is_floating_ip" "testacc_fip" {
+		name = "%s-fip"
+		zone = "%s"
+	  }
+
+	  resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+		network_interfaces {
+		  subnet = ibm_is_subnet.testacc_subnet.id
+		  name   = "eth1"
+		}
+		ignore_unmanaged_network_interfaces = true
+	  }
+
+	  resource "ibm_is_instance_network_interface" "testacc_nic" {
+		instance = ibm_is_instance.testacc_instance.id
+		subnet   = ibm_is_subnet.testacc_subnet.id
+		name     = "%s"
+		%s
+	  }`, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, name, name, ISZoneName, name, isImage, instanceProfileName, ISZoneName, nicName, updateConfig)
+}
@@ -296,7 +296,7 @@ func resourceIBMISInstanceTemplate() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{isPlacementTargetDedicatedHostGroup},
+				ConflictsWith: []string{isPlacementTargetDedicatedHostGroup, isPlacementTargetPlacementGroup},
 				Description:   "Unique Identifier of the Dedicated Host where the instance will be placed",
 			},
 
@@ -304,10 +304,18 @@ func resourceIBMISInstanceTemplate() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{isPlacementTargetDedicatedHost},
+				ConflictsWith: []string{isPlacementTargetDedicatedHost, isPlacementTargetPlacementGroup},
 				Description:   "Unique Identifier of the Dedicated Host Group where the instance will be placed",
 			},
 
+			isPlacementTargetPlacementGroup: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isPlacementTargetDedicatedHost, isPlacementTargetDedicatedHostGroup},
+				Description:   "Unique Identifier of the Placement Group for restricting the placement of the instance",
+			},
+
 			isInstanceTemplatePlacementTarget: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -434,6 +442,14 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 		instanceproto.PlacementTarget = dHostGrpPlaementTarget
 	}
 
+	if placementGroupIdInf, ok := d.GetOk(isPlacementTargetPlacementGroup); ok {
+		placementGroupIdStr := placementGroupIdInf.(string)
+		placementGroupPlacementTarget := &vpcv1.InstancePlacementTargetPrototype{
+			ID: &placementGroupIdStr,
+		}
+		instanceproto.PlacementTarget = placementGroupPlacementTarget
+	}
+
 	// BOOT VOLUME ATTACHMENT for instance template
 	if boot, ok := d.GetOk(isInstanceTemplateBootVolume); ok {
 		bootvol := boot.([]interface{})[0].(map[string]interface{})
@@ -647,7 +663,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
 	if err != nil {
-		return fmt.Errorf("Error creating InstanceTemplate: %s\n%s", err, response)
+		return fmt.Errorf("Error creating InstanceTemplate: %w", newAPIError(err, response))
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.SetId(*instance.ID)
@@ -664,7 +680,7 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	}
 	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Instance template: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Instance template: %w", newAPIError(err, response))
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
 	d.Set(isInstanceTemplateName, *instance.Name)
@@ -843,7 +859,7 @@ func instanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		instanceTemplatePatch, err := instanceTemplatePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceTemplatePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for InstanceTemplatePatch: %w", err)
 		}
 		updnetoptions.InstanceTemplatePatch = instanceTemplatePatch
 
@@ -884,7 +900,7 @@ func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting InstanceTemplate: %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting InstanceTemplate: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
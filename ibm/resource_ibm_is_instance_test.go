@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,9 +14,17 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_is_instance", &resource.Sweeper{
+		Name: "ibm_is_instance",
+		F:    testSweepISInstances,
+	})
+}
+
 func TestAccIBMISInstance_basic(t *testing.T) {
 	var instance string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -755,3 +764,129 @@ func testAccCheckIBMISInstanceVolumeUpdate(vpcname, subnetname, sshname, publicK
 	
 `, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, volName, ISZoneName, name, isImage, instanceProfileName, ISZoneName)
 }
+
+func testSweepISInstances(region string) error {
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	start := ""
+	allrecs := []vpcv1.Instance{}
+	for {
+		options := &vpcv1.ListInstancesOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		instances, response, err := sess.ListInstances(options)
+		if err != nil {
+			return fmt.Errorf("Error Fetching Instances %s\n%s", err, response)
+		}
+		start = GetNext(instances.Next)
+		allrecs = append(allrecs, instances.Instances...)
+		if start == "" {
+			break
+		}
+	}
+	var errs []error
+	for _, instance := range allrecs {
+		if !isSweepable(*instance.Name) {
+			continue
+		}
+		attributes := map[string]interface{}{
+			isEnableCleanDelete: true,
+		}
+		if err := sweepResource(resourceIBMISInstance(), "ibm_is_instance", *instance.ID, *instance.Name, attributes, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return sweepErrors("ibm_is_instance", errs)
+}
+
+func TestIBMISInstancePlacementTargetConflicts(t *testing.T) {
+	base := map[string]interface{}{
+		"name":    "tf-instance",
+		"image":   "r006-image",
+		"profile": "bx2-2x8",
+		"vpc":     "r006-vpc",
+		"zone":    "us-south-1",
+		"keys":    []interface{}{"r006-key"},
+		"primary_network_interface": []interface{}{
+			map[string]interface{}{"subnet": "0717-subnet"},
+		},
+	}
+	cases := []struct {
+		targets map[string]interface{}
+		valid   bool
+	}{
+		{map[string]interface{}{"placement_group": "r006-pg"}, true},
+		{map[string]interface{}{"dedicated_host": "0717-dh"}, true},
+		{map[string]interface{}{"placement_group": "r006-pg", "dedicated_host": "0717-dh"}, false},
+		{map[string]interface{}{"placement_group": "r006-pg", "dedicated_host_group": "0717-dhg"}, false},
+		{map[string]interface{}{"dedicated_host": "0717-dh", "dedicated_host_group": "0717-dhg"}, false},
+	}
+	for _, c := range cases {
+		for name, r := range map[string]*schema.Resource{"ibm_is_instance": resourceIBMISInstance(), "ibm_is_instance_template": resourceIBMISInstanceTemplate()} {
+			raw := map[string]interface{}{}
+			for k, v := range base {
+				raw[k] = v
+			}
+			for k, v := range c.targets {
+				raw[k] = v
+			}
+			diags := r.Validate(terraform.NewResourceConfigRaw(raw))
+			if diags.HasError() == c.valid {
+				t.Errorf("%s with %v: expected valid %t, got %v", name, c.targets, c.valid, diags)
+			}
+		}
+	}
+}
+
+func TestIBMISInstanceBootVolumeDiff(t *testing.T) {
+	r := resourceIBMISInstance()
+	state := &terraform.InstanceState{
+		ID: "0717-instance",
+		Attributes: map[string]string{
+			"id":                       "0717-instance",
+			"name":                     "tf-instance",
+			"image":                    "r006-image",
+			"profile":                  "bx2-2x8",
+			"vpc":                      "r006-vpc",
+			"zone":                     "us-south-1",
+			"boot_volume.#":            "1",
+			"boot_volume.0.name":       "tf-boot",
+			"boot_volume.0.encryption": "crn:v1:bluemix:public:kms:us-south:a/1:1:key:1",
+			"boot_volume.0.size":       "100",
+		},
+	}
+	raw := map[string]interface{}{
+		"name":    "tf-instance",
+		"image":   "r006-image",
+		"profile": "bx2-2x8",
+		"vpc":     "r006-vpc",
+		"zone":    "us-south-1",
+		"boot_volume": []interface{}{map[string]interface{}{
+			"name":       "tf-boot-renamed",
+			"encryption": "crn:v1:bluemix:public:kms:us-south:a/1:1:key:2",
+			"size":       150,
+		}},
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["boot_volume.0.size"] == nil || diff.Attributes["boot_volume.0.size"].New != "150" {
+		t.Fatalf("expected the boot volume to be expanded, got %#v", diff)
+	}
+	for _, k := range []string{"boot_volume.0.name", "boot_volume.0.encryption"} {
+		if attr := diff.Attributes[k]; attr != nil && attr.Old != attr.New {
+			t.Errorf("expected the change of %s to be suppressed once the instance is created, got %#v", k, attr)
+		}
+	}
+	if diff.RequiresNew() {
+		t.Fatal("expected the boot volume to be expanded in place")
+	}
+}
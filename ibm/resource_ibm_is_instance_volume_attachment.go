@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -291,7 +292,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		log.Printf("[DEBUG] Instance volume attachment create err %s\n%s", err, response)
 		return fmt.Errorf("Error while attaching volume for instance %s: %q", instanceId, err)
 	}
-	_, err = isWaitForInstanceVolumeAttached(sess, d, instanceId, *instanceVolAtt.ID)
+	_, err = isWaitForInstanceVolumeAttached(context.Background(), sess, d, instanceId, *instanceVolAtt.ID)
 	if err != nil {
 		return err
 	}
@@ -337,7 +338,7 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Instance volume attachment : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Instance volume attachment : %w", newAPIError(err, response))
 	}
 	d.Set(isInstanceId, instanceId)
 
@@ -440,7 +441,7 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 		ID:         &id,
 	}
 	_, err = instanceC.DeleteInstanceVolumeAttachment(deleteInstanceVolAttOptions)
-	_, err = isWaitForInstanceVolumeDetached(instanceC, d, instanceId, id)
+	_, err = isWaitForInstanceVolumeDetached(context.Background(), instanceC, d, instanceId, id)
 	if err != nil {
 		return fmt.Errorf("Error while deleting volume attachment (%s) from instance (%s) : %q", id, instanceId, err)
 	}
@@ -450,9 +451,9 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 		}
 		response, err := instanceC.DeleteVolume(deleteVolumeOptions)
 		if err != nil {
-			return fmt.Errorf("Error while deleting volume : %s\n%s", err, response)
+			return fmt.Errorf("Error while deleting volume : %w", newAPIError(err, response))
 		}
-		_, err = isWaitForVolumeDeleted(instanceC, volId, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForVolumeDeleted(context.Background(), instanceC, volId, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -505,7 +506,7 @@ func instanceVolAttExists(d *schema.ResourceData, meta interface{}, instanceId,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Instance volume attachment: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Instance volume attachment: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -235,7 +235,7 @@ func classicIpsecpCreate(d *schema.ResourceData, meta interface{}, authenticatio
 	}
 	ipSec, response, err := sess.CreateIpsecPolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] ipSec policy err %w", newAPIError(err, response))
 	}
 	d.SetId(*ipSec.ID)
 	log.Printf("[INFO] ipSec policy : %s", *ipSec.ID)
@@ -270,7 +270,7 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 	}
 	ipSec, response, err := sess.CreateIpsecPolicy(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] ipSec policy err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] ipSec policy err %w", newAPIError(err, response))
 	}
 	d.SetId(*ipSec.ID)
 	log.Printf("[INFO] ipSec policy : %s", *ipSec.ID)
@@ -311,7 +311,7 @@ func classicIpsecpGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	d.Set(isIpSecName, *ipSec.Name)
@@ -375,7 +375,7 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 	d.Set(isIpSecName, *ipSec.Name)
 	d.Set(isIpSecAuthenticationAlg, *ipSec.AuthenticationAlgorithm)
@@ -459,13 +459,13 @@ func classicIpsecpUpdate(d *schema.ResourceData, meta interface{}, id string) er
 		}
 		ipsecPolicyPatch, err := ipsecPolicyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for IPsecPolicyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for IPsecPolicyPatch: %w", err)
 		}
 		options.IPsecPolicyPatch = ipsecPolicyPatch
 
 		_, response, err := sess.UpdateIpsecPolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
+			return fmt.Errorf("Error on update of IPSEC Policy(%s): %w", id, newAPIError(err, response))
 		}
 	}
 	return nil
@@ -496,13 +496,13 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		ipsecPolicyPatch, err := ipsecPolicyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for IPsecPolicyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for IPsecPolicyPatch: %w", err)
 		}
 		options.IPsecPolicyPatch = ipsecPolicyPatch
 
 		_, response, err := sess.UpdateIpsecPolicy(options)
 		if err != nil {
-			return fmt.Errorf("Error on update of IPSEC Policy(%s): %s\n%s", id, err, response)
+			return fmt.Errorf("Error on update of IPSEC Policy(%s): %w", id, newAPIError(err, response))
 		}
 	}
 	return nil
@@ -542,7 +542,7 @@ func classicIpsecpDelete(d *schema.ResourceData, meta interface{}, id string) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteIpsecPolicyOptions := &vpcclassicv1.DeleteIpsecPolicyOptions{
@@ -550,7 +550,7 @@ func classicIpsecpDelete(d *schema.ResourceData, meta interface{}, id string) er
 	}
 	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Deleting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -570,14 +570,14 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 	deleteIpsecPolicyOptions := &vpcv1.DeleteIpsecPolicyOptions{
 		ID: &id,
 	}
 	response, err = sess.DeleteIpsecPolicy(deleteIpsecPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Deleting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -612,7 +612,7 @@ func classicIpsecpExists(d *schema.ResourceData, meta interface{}, id string) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -630,7 +630,7 @@ func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
+		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %w", id, newAPIError(err, response))
 	}
 	return true, nil
 }
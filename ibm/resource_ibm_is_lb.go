@@ -302,7 +302,7 @@ func classicLBCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg
 
 	lb, response, err := sess.CreateLoadBalancer(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balancer err %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Load Balancer err %w", newAPIError(err, response))
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
@@ -380,7 +380,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 
 	lb, response, err := sess.CreateLoadBalancer(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balancer err %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Load Balancer err %w", newAPIError(err, response))
 	}
 	d.SetId(*lb.ID)
 	log.Printf("[INFO] Load Balancer : %s", *lb.ID)
@@ -434,7 +434,7 @@ func classicLBGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(isLBName, *lb.Name)
 	if *lb.IsPublic {
@@ -508,7 +508,7 @@ func lbGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(isLBName, *lb.Name)
 	if *lb.IsPublic {
@@ -648,7 +648,7 @@ func classicLBUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Load Balancer : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isLBTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
@@ -667,13 +667,13 @@ func classicLBUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		loadBalancerPatch, err := loadBalancerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %w", err)
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating vpc Load Balancer : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -690,7 +690,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Load Balancer : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Load Balancer : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isLBTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *lb.CRN)
@@ -708,13 +708,13 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		loadBalancerPatch, err := loadBalancerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %w", err)
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating vpc Load Balancer : %w", newAPIError(err, response))
 		}
 	}
 	if hasChangedLog {
@@ -732,13 +732,13 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		loadBalancerPatch, err := loadBalancerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPatch: %w", err)
 		}
 		updateLoadBalancerOptions.LoadBalancerPatch = loadBalancerPatch
 
 		_, response, err := sess.UpdateLoadBalancer(updateLoadBalancerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating vpc Load Balancer : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating vpc Load Balancer : %w", newAPIError(err, response))
 		}
 	}
 
@@ -750,7 +750,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 				createSecurityGroupTargetBindingOptions.ID = &id
 				_, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating Security Group Target Binding %s\n%s", err, response)
+					return fmt.Errorf("Error while creating Security Group Target Binding %w", newAPIError(err, response))
 				}
 			}
 		}
@@ -765,12 +765,12 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 					if response != nil && response.StatusCode == 404 {
 						continue
 					}
-					return fmt.Errorf("Error Getting Security Group Target for this load balancer (%s): %s\n%s", d, err, response)
+					return fmt.Errorf("Error Getting Security Group Target for this load balancer (%s): %w", d, newAPIError(err, response))
 				}
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(d, id)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error Deleting Security Group Target for this load balancer : %s\n%s", err, response)
+					return fmt.Errorf("Error Deleting Security Group Target for this load balancer : %w", newAPIError(err, response))
 				}
 			}
 		}
@@ -815,7 +815,7 @@ func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting vpc load balancer(%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteLoadBalancerOptions := &vpcclassicv1.DeleteLoadBalancerOptions{
@@ -823,7 +823,7 @@ func classicLBDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting vpc load balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting vpc load balancer : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -848,7 +848,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer(%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting vpc load balancer(%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteLoadBalancerOptions := &vpcv1.DeleteLoadBalancerOptions{
@@ -856,7 +856,7 @@ func lbDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteLoadBalancer(deleteLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting vpc load balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting vpc load balancer : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLBDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -892,7 +892,7 @@ func isClassicLBDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, id string) res
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
 			}
-			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return lb, isLBDeleting, nil
 	}
@@ -924,7 +924,7 @@ func isLBDeleteRefreshFunc(lbc *vpcv1.VpcV1, id string) resource.StateRefreshFun
 			if response != nil && response.StatusCode == 404 {
 				return lb, isLBDeleted, nil
 			}
-			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %s\n%s", id, err, response)
+			return nil, "failed", fmt.Errorf("The vpc load balancer %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return lb, isLBDeleting, nil
 	}
@@ -958,7 +958,7 @@ func classicLBExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting vpc load balancer: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting vpc load balancer: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -976,7 +976,7 @@ func lbExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting vpc load balancer: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting vpc load balancer: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -1004,7 +1004,7 @@ func isLBRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefreshFunc {
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -1038,7 +1038,7 @@ func isClassicLBRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId string) resour
 		}
 		lb, response, err := sess.GetLoadBalancer(getlboptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 		}
 
 		if *lb.ProvisioningStatus == "active" || *lb.ProvisioningStatus == "failed" {
@@ -229,7 +229,7 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 
 	lbListener, response, err := sess.CreateLoadBalancerListener(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Load Balanacer Listener err %w", newAPIError(err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
 	_, err = isWaitForClassicLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
@@ -279,7 +279,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 
 	lbListener, response, err := sess.CreateLoadBalancerListener(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Load Balanacer Listener err %w", newAPIError(err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
 	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
@@ -321,7 +321,7 @@ func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbLis
 		}
 		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Listener: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer Listener: %w", newAPIError(err, response))
 		}
 
 		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
@@ -356,7 +356,7 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 		}
 		lblis, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Listener: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer Listener: %w", newAPIError(err, response))
 		}
 
 		if *lblis.ProvisioningStatus == "active" || *lblis.ProvisioningStatus == "failed" {
@@ -411,7 +411,7 @@ func classicLBListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbList
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Listener : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Listener : %w", newAPIError(err, response))
 	}
 	d.Set(isLBListenerLBID, lbID)
 	d.Set(isLBListenerPort, *lbListener.Port)
@@ -432,7 +432,7 @@ func classicLBListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbList
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -453,7 +453,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Listener : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Listener : %w", newAPIError(err, response))
 	}
 	d.Set(isLBListenerLBID, lbID)
 	d.Set(isLBListenerPort, *lbListener.Port)
@@ -475,7 +475,7 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -564,7 +564,7 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 	if hasChanged {
 		loadBalancerListenerPatch, err := loadBalancerListenerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPatch: %w", err)
 		}
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
@@ -579,7 +579,7 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 		}
 		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Load Balancer Listener : %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForClassicLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
@@ -658,7 +658,7 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	if hasChanged {
 		loadBalancerListenerPatch, err := loadBalancerListenerPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPatch: %w", err)
 		}
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
@@ -673,7 +673,7 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		_, response, err := sess.UpdateLoadBalancerListener(updateLoadBalancerListenerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Load Balancer Listener : %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
@@ -738,7 +738,7 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
+		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %w", lbListenerID, newAPIError(err, response))
 	}
 	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -751,7 +751,7 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 	}
 	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Load Balancer Pool : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -783,7 +783,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
+		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %w", lbListenerID, newAPIError(err, response))
 	}
 	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -796,7 +796,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	}
 	response, err = sess.DeleteLoadBalancerListener(deleteLoadBalancerListenerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Load Balancer Pool : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -838,7 +838,7 @@ func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID,
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
+			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %w", lbListenerID, newAPIError(err, response))
 		}
 		return lbLis, isLBListenerDeleting, nil
 	}
@@ -870,7 +870,7 @@ func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string)
 			if response != nil && response.StatusCode == 404 {
 				return lbLis, isLBListenerDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %s\n%s", lbListenerID, err, response)
+			return nil, "", fmt.Errorf("The vpc load balancer listener %s failed to delete: %w", lbListenerID, newAPIError(err, response))
 		}
 		return lbLis, isLBListenerDeleting, nil
 	}
@@ -916,7 +916,7 @@ func classicLBListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbL
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer Listener: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -936,7 +936,7 @@ func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer Listener: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -799,7 +799,7 @@ func classicLbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer policy: %w", newAPIError(err, response))
 	}
 
 	return true, nil
@@ -835,7 +835,7 @@ func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer policy: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -952,7 +952,7 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 	if hasChanged {
 		loadBalancerListenerPolicyPatch, err := loadBalancerListenerPolicyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %w", err)
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
@@ -962,7 +962,7 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+			return fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForClassicLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -1046,7 +1046,7 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 	if hasChanged {
 		loadBalancerListenerPolicyPatch, err := loadBalancerListenerPolicyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %w", err)
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
@@ -1060,7 +1060,7 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicy(&updatePolicyOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating in policy : %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -1128,7 +1128,7 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return fmt.Errorf("Error in classicLbListenerPolicyGet : %w", newAPIError(err, response))
 	}
 
 	deleteLbListenerPolicyOptions := &vpcclassicv1.DeleteLoadBalancerListenerPolicyOptions{
@@ -1145,7 +1145,7 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 
 	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error in classicLbListenerPolicycDelete: %s\n%s", err, response)
+		return fmt.Errorf("Error in classicLbListenerPolicycDelete: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLbListenerPolicyClassicDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1190,7 +1190,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 
 	response, err = sess.DeleteLoadBalancerListenerPolicy(deleteLbListenerPolicyOptions)
 	if err != nil {
-		return fmt.Errorf("Error in lbListenerPolicyDelete: %s\n%s", err, response)
+		return fmt.Errorf("Error in lbListenerPolicyDelete: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1264,7 +1264,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return fmt.Errorf("Error in classicLbListenerPolicyGet : %w", newAPIError(err, response))
 	}
 
 	d.Set(isLBListenerPolicyLBID, lbID)
@@ -1286,7 +1286,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 			}
 			ruleInfo, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRulesOptions)
 			if err != nil {
-				return fmt.Errorf("Error in classicLbListenerPolicyGet rule: %s\n%s", err, response)
+				return fmt.Errorf("Error in classicLbListenerPolicyGet rule: %w", newAPIError(err, response))
 			}
 
 			r := map[string]interface{}{
@@ -1327,7 +1327,7 @@ func classicLbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID,
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
@@ -1355,7 +1355,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 			d.SetId("")
 			return nil
 		}
-		return err
+		return newAPIError(err, response)
 	}
 
 	//set the argument values
@@ -1407,7 +1407,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
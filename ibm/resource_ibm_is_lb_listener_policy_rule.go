@@ -576,7 +576,7 @@ func classicLbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{},
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting policy: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -612,7 +612,7 @@ func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting policy: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -696,7 +696,7 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 	if hasChanged {
 		loadBalancerListenerPolicyRulePatch, err := loadBalancerListenerPolicyRulePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyRulePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyRulePatch: %w", err)
 		}
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
@@ -707,7 +707,7 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 		}
 		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+			return fmt.Errorf("Error Getting Instance: %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForClassicLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -759,7 +759,7 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 	if hasChanged {
 		loadBalancerListenerPolicyRulePatch, err := loadBalancerListenerPolicyRulePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyRulePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyRulePatch: %w", err)
 		}
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
@@ -775,7 +775,7 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 
 		_, response, err := sess.UpdateLoadBalancerListenerPolicyRule(&updatePolicyRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating in policy : %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
@@ -846,7 +846,7 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return fmt.Errorf("Error in classicLbListenerPolicyGet : %w", newAPIError(err, response))
 	}
 
 	deleteLbListenerPolicyRuleOptions := &vpcclassicv1.DeleteLoadBalancerListenerPolicyRuleOptions{
@@ -859,7 +859,7 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error in classicLbListenerPolicyRuleDelete: %s\n%s", err, response)
+		return fmt.Errorf("Error in classicLbListenerPolicyRuleDelete: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLbListenerPolicyRuleClassicDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -890,7 +890,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in LbListenerPolicyGet : %s\n%s", err, response)
+		return fmt.Errorf("Error in LbListenerPolicyGet : %w", newAPIError(err, response))
 	}
 
 	deleteLbListenerPolicyRuleOptions := &vpcv1.DeleteLoadBalancerListenerPolicyRuleOptions{
@@ -901,7 +901,7 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	}
 	response, err = sess.DeleteLoadBalancerListenerPolicyRule(deleteLbListenerPolicyRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
+		return fmt.Errorf("Error in lbListenerPolicyRuleDelete: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -983,7 +983,7 @@ func classicLbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lb
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error in classicLbListenerPolicyGet : %s\n%s", err, response)
+		return fmt.Errorf("Error in classicLbListenerPolicyGet : %w", newAPIError(err, response))
 	}
 
 	d.Set(isLBListenerPolicyRuleLBID, lbID)
@@ -1000,7 +1000,7 @@ func classicLbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lb
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
@@ -1029,7 +1029,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 			d.SetId("")
 			return nil
 		}
-		return err
+		return newAPIError(err, response)
 	}
 
 	//set the argument values
@@ -1047,7 +1047,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 
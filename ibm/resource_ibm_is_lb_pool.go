@@ -311,7 +311,7 @@ func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, a
 	}
 	lbPool, response, err := sess.CreateLoadBalancerPool(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] lbpool create err: %w", newAPIError(err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
@@ -372,7 +372,7 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	}
 	lbPool, response, err := sess.CreateLoadBalancerPool(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool create err: %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] lbpool create err: %w", newAPIError(err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
@@ -436,7 +436,7 @@ func classicLBPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID s
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Pool : %w", newAPIError(err, response))
 	}
 
 	d.Set(isLBPoolName, *lbPool.Name)
@@ -466,7 +466,7 @@ func classicLBPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID s
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -488,7 +488,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Pool : %w", newAPIError(err, response))
 	}
 	d.Set(isLBPoolName, *lbPool.Name)
 	d.Set(isLBPool, lbPoolID)
@@ -518,7 +518,7 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -627,13 +627,13 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 
 		LoadBalancerPoolPatch, err := loadBalancerPoolPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolPatch: %w", err)
 		}
 		updateLoadBalancerPoolOptions.LoadBalancerPoolPatch = LoadBalancerPoolPatch
 
 		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Load Balancer Pool : %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
@@ -730,13 +730,13 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 
 		LoadBalancerPoolPatch, err := loadBalancerPoolPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolPatch: %w", err)
 		}
 		updateLoadBalancerPoolOptions.LoadBalancerPoolPatch = LoadBalancerPoolPatch
 
 		_, response, err := sess.UpdateLoadBalancerPool(updateLoadBalancerPoolOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Load Balancer Pool : %w", newAPIError(err, response))
 		}
 
 		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
@@ -802,7 +802,7 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
+		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %w", lbPoolID, newAPIError(err, response))
 	}
 	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -822,7 +822,7 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	}
 	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Load Balancer Pool : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -854,7 +854,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
+		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %w", lbPoolID, newAPIError(err, response))
 	}
 	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -874,7 +874,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	}
 	response, err = sess.DeleteLoadBalancerPool(deleteLoadBalancerPoolOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Load Balancer Pool : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -932,7 +932,7 @@ func classicLBPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer pool: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -952,7 +952,7 @@ func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer pool: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -981,7 +981,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 		}
 		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool: %w", newAPIError(err, response))
 		}
 
 		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
@@ -1016,7 +1016,7 @@ func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId
 		}
 		lbPool, response, err := sess.GetLoadBalancerPool(getlbpOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool: %w", newAPIError(err, response))
 		}
 
 		if *lbPool.ProvisioningStatus == isLBPoolActive || *lbPool.ProvisioningStatus == isLBPoolFailed {
@@ -1054,7 +1054,7 @@ func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPo
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
+			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %w", lbPoolId, newAPIError(err, response))
 		}
 		return lbPool, isLBPoolDeletePending, nil
 	}
@@ -1087,7 +1087,7 @@ func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource
 			if response != nil && response.StatusCode == 404 {
 				return lbPool, isLBPoolDeleteDone, nil
 			}
-			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %s\n%s", lbPoolId, err, response)
+			return nil, "", fmt.Errorf("The vpc load balancer pool %s failed to delete: %w", lbPoolId, newAPIError(err, response))
 		}
 		return lbPool, isLBPoolDeletePending, nil
 	}
@@ -47,28 +47,11 @@ func resourceIBMISLBPoolMember() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			isLBPoolID: {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
-					if o == "" {
-						return false
-					}
-					// if state file entry and tf file entry matches
-					if strings.Compare(n, o) == 0 {
-						return true
-					}
-
-					if strings.Contains(n, "/") {
-						new := strings.Split(n, "/")
-						if strings.Compare(new[1], o) == 0 {
-							return true
-						}
-					}
-
-					return false
-				},
-				Description: "Loadblancer Poold ID",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: lbPoolIDDiffSuppress,
+				Description:      "Loadblancer Poold ID",
 			},
 
 			isLBID: {
@@ -201,7 +184,7 @@ func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	}
 	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] lbpool member create err: %w", newAPIError(err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
@@ -269,7 +252,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	}
 	lbPoolMember, response, err := sess.CreateLoadBalancerPoolMember(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] lbpool member create err: %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] lbpool member create err: %w", newAPIError(err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
@@ -320,7 +303,7 @@ func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPo
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool Member: %w", newAPIError(err, response))
 		}
 
 		if *lbPoolMem.ProvisioningStatus == isLBPoolMemberActive {
@@ -356,7 +339,7 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 		}
 		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Load Balancer Pool Member: %w", newAPIError(err, response))
 		}
 
 		if *lbPoolMem.ProvisioningStatus == isLBPoolMemberActive {
@@ -418,7 +401,7 @@ func classiclbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Pool Member: %w", newAPIError(err, response))
 	}
 
 	d.Set(isLBPoolID, lbPoolID)
@@ -436,7 +419,7 @@ func classiclbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -458,7 +441,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Pool Member: %w", newAPIError(err, response))
 	}
 	d.Set(isLBPoolID, lbPoolID)
 	d.Set(isLBID, lbID)
@@ -480,7 +463,7 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	}
 	lb, response, err := sess.GetLoadBalancer(getLoadBalancerOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Load Balancer : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *lb.CRN)
 	return nil
@@ -563,13 +546,13 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 
 		loadBalancerPoolMemberPatch, err := loadBalancerPoolMemberPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolMemberPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolMemberPatch: %w", err)
 		}
 		updatelbpmoptions.LoadBalancerPoolMemberPatch = loadBalancerPoolMemberPatch
 
 		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Load Balancer Pool Member: %w", newAPIError(err, response))
 		}
 		_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -650,13 +633,13 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 
 		loadBalancerPoolMemberPatch, err := loadBalancerPoolMemberPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolMemberPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for LoadBalancerPoolMemberPatch: %w", err)
 		}
 		updatelbpmoptions.LoadBalancerPoolMemberPatch = loadBalancerPoolMemberPatch
 
 		_, response, err := sess.UpdateLoadBalancerPoolMember(updatelbpmoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Load Balancer Pool Member: %w", newAPIError(err, response))
 		}
 		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -729,7 +712,7 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Pool Member: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -755,7 +738,7 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	}
 	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %w", newAPIError(err, response))
 	}
 
 	_, err = isWaitForClassicLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
@@ -796,7 +779,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Load Balancer Pool Member: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -822,7 +805,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	}
 	response, err = sess.DeleteLoadBalancerPoolMember(dellbpmoptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %w", newAPIError(err, response))
 	}
 
 	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
@@ -887,7 +870,7 @@ func isDeleteClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
-			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %w", newAPIError(err, response))
 		}
 		return lbPoolMem, isLBPoolMemberDeletePending, nil
 	}
@@ -906,7 +889,7 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 			if response != nil && response.StatusCode == 404 {
 				return lbPoolMem, isLBPoolMemberDeleted, nil
 			}
-			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Deleting Load balancer pool member: %w", newAPIError(err, response))
 		}
 		return lbPoolMem, isLBPoolMemberDeletePending, nil
 	}
@@ -955,7 +938,7 @@ func classiclbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer pool member: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -976,11 +959,31 @@ func lbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Load balancer pool member: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
 
+// lbPoolIDDiffSuppress suppresses the diff between a pool ID in the state and the lbID/poolID ID of an ibm_is_lb_pool
+func lbPoolIDDiffSuppress(k, o, n string, d *schema.ResourceData) bool {
+	if o == "" {
+		return false
+	}
+	// if state file entry and tf file entry matches
+	if strings.Compare(n, o) == 0 {
+		return true
+	}
+
+	if strings.Contains(n, "/") {
+		new := strings.Split(n, "/")
+		if strings.Compare(new[1], o) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getPoolId(id string) (string, error) {
 	if strings.Contains(id, "/") {
 		parts, err := idParts(id)
@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBPoolMembers   = "members"
+	isLBPoolMemberID  = "id"
+	isLBPoolMembersLB = "lb"
+)
+
+// resourceIBMISLBPoolMembers manages all the members of a load balancer pool at once. Every change replaces the
+// members of the pool in a single call, and waits once for the load balancer, unlike ibm_is_lb_pool_member which
+// waits for each member. Members of the pool not in the configuration are removed.
+func resourceIBMISLBPoolMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISLBPoolMembersCreate,
+		ReadContext:   resourceIBMISLBPoolMembersRead,
+		UpdateContext: resourceIBMISLBPoolMembersUpdate,
+		DeleteContext: resourceIBMISLBPoolMembersDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return lbPoolMembersCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isLBPoolMembersLB: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the load balancer.",
+			},
+			isLBPoolID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: lbPoolIDDiffSuppress,
+				Description:      "The unique identifier of the load balancer pool.",
+			},
+			isLBPoolMembers: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceIBMISLBPoolMemberHash,
+				Description: "The members of the load balancer pool. The members of the pool not in this set are removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isLBPoolMemberPort: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_is_lb_pool_members", isLBPoolMemberPort),
+							Description:  "The port number of the application running in the member.",
+						},
+						isLBPoolMemberTargetAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address of the member, for a load balancer of the application family. Exactly one of target_address and target_id must be set.",
+						},
+						isLBPoolMemberTargetID: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The unique identifier of the virtual server instance of the member, for a load balancer of the network family. Exactly one of target_address and target_id must be set.",
+						},
+						isLBPoolMemberWeight: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: InvokeValidator("ibm_is_lb_pool_members", isLBPoolMemberWeight),
+							Description:  "The weight of the member, only applies to the pools with the weighted_round_robin algorithm.",
+						},
+						isLBPoolMemberID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the member.",
+						},
+						isLBPoolMemberHealth: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health of the member.",
+						},
+						isLBPoolMemberProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of the member.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMISLBPoolMembersValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isLBPoolMemberPort,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isLBPoolMemberWeight,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "100",
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_lb_pool_members", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMISLBPoolMemberHash hashes the arguments of a member only, so that its computed attributes don't tell
+// it apart from the member in the configuration
+func resourceIBMISLBPoolMemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m[isLBPoolMemberPort].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m[isLBPoolMemberTargetAddress].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m[isLBPoolMemberTargetID].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m[isLBPoolMemberWeight].(int)))
+	return hashcode.String(buf.String())
+}
+
+// lbPoolMembersCustomizeDiff rejects the members with both a target address and a target ID, and the members given
+// twice. A member with neither is rejected on apply, as its target may only be unknown during plan.
+func lbPoolMembersCustomizeDiff(diff *schema.ResourceDiff) error {
+	targets := map[string]bool{}
+	for _, member := range diff.Get(isLBPoolMembers).(*schema.Set).List() {
+		memberMap := member.(map[string]interface{})
+		address := memberMap[isLBPoolMemberTargetAddress].(string)
+		id := memberMap[isLBPoolMemberTargetID].(string)
+		if address != "" && id != "" {
+			return fmt.Errorf("%s: only one of %s and %s can be set, got %s and %s", isLBPoolMembers, isLBPoolMemberTargetAddress, isLBPoolMemberTargetID, address, id)
+		}
+		if address == "" && id == "" {
+			continue
+		}
+		target := fmt.Sprintf("%s%s:%d", address, id, memberMap[isLBPoolMemberPort].(int))
+		if targets[target] {
+			return fmt.Errorf("%s: the member %s is given more than once", isLBPoolMembers, target)
+		}
+		targets[target] = true
+	}
+	return nil
+}
+
+// expandLBPoolMembers returns the prototypes of the members of the set
+func expandLBPoolMembers(members *schema.Set) ([]vpcv1.LoadBalancerPoolMemberPrototype, error) {
+	prototypes := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, members.Len())
+	for _, member := range members.List() {
+		memberMap := member.(map[string]interface{})
+		port := int64(memberMap[isLBPoolMemberPort].(int))
+		weight := int64(memberMap[isLBPoolMemberWeight].(int))
+		prototype := vpcv1.LoadBalancerPoolMemberPrototype{
+			Port:   &port,
+			Weight: &weight,
+		}
+		if address := memberMap[isLBPoolMemberTargetAddress].(string); address != "" {
+			prototype.Target = &vpcv1.LoadBalancerPoolMemberTargetPrototype{Address: &address}
+		} else if id := memberMap[isLBPoolMemberTargetID].(string); id != "" {
+			prototype.Target = &vpcv1.LoadBalancerPoolMemberTargetPrototype{ID: &id}
+		} else {
+			return nil, fmt.Errorf("%s: one of %s and %s must be set for the member on port %d", isLBPoolMembers, isLBPoolMemberTargetAddress, isLBPoolMemberTargetID, port)
+		}
+		prototypes = append(prototypes, prototype)
+	}
+	return prototypes, nil
+}
+
+func resourceIBMISLBPoolMembersCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID := d.Get(isLBPoolMembersLB).(string)
+	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = lbPoolMembersReplace(d, meta, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", lbID, lbPoolID))
+	log.Printf("[INFO] lbpool members : %s", d.Id())
+
+	return resourceIBMISLBPoolMembersRead(context, d, meta)
+}
+
+// lbPoolMembersReplace replaces the members of the pool with the ones of the configuration. It holds the lock of the
+// load balancer, shared with ibm_is_lb_pool_member, and waits for the load balancer before and after the replacement.
+func lbPoolMembersReplace(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	members, err := expandLBPoolMembers(d.Get(isLBPoolMembers).(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	ibmMutexKV.Lock(isLBKey)
+	defer ibmMutexKV.Unlock(isLBKey)
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+
+	options := &vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+		Members:        members,
+	}
+	_, response, err := sess.ReplaceLoadBalancerPoolMembers(options)
+	if err != nil {
+		log.Printf("[DEBUG] ReplaceLoadBalancerPoolMembers failed %s\n%s", err, response)
+		return newAPIError(err, response)
+	}
+
+	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"Error checking for load balancer (%s) is active: %s", lbID, err)
+	}
+	return nil
+}
+
+func resourceIBMISLBPoolMembersRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of lbID/poolID", d.Id()))
+	}
+	lbID, lbPoolID := parts[0], parts[1]
+
+	options := &vpcv1.ListLoadBalancerPoolMembersOptions{
+		LoadBalancerID: &lbID,
+		PoolID:         &lbPoolID,
+	}
+	collection, response, err := sess.ListLoadBalancerPoolMembers(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListLoadBalancerPoolMembers failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	members := make([]interface{}, 0, len(collection.Members))
+	for _, member := range collection.Members {
+		memberMap := map[string]interface{}{
+			isLBPoolMemberID:                 *member.ID,
+			isLBPoolMemberPort:               int(*member.Port),
+			isLBPoolMemberTargetAddress:      "",
+			isLBPoolMemberTargetID:           "",
+			isLBPoolMemberWeight:             0,
+			isLBPoolMemberHealth:             *member.Health,
+			isLBPoolMemberProvisioningStatus: *member.ProvisioningStatus,
+		}
+		if member.Weight != nil {
+			memberMap[isLBPoolMemberWeight] = int(*member.Weight)
+		}
+		if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+			if target.Address != nil {
+				memberMap[isLBPoolMemberTargetAddress] = *target.Address
+			}
+			if target.ID != nil {
+				memberMap[isLBPoolMemberTargetID] = *target.ID
+			}
+		}
+		members = append(members, memberMap)
+	}
+
+	d.Set(isLBPoolMembersLB, lbID)
+	d.Set(isLBPoolID, lbPoolID)
+	if err = d.Set(isLBPoolMembers, schema.NewSet(resourceIBMISLBPoolMemberHash, members)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting %s: %s", isLBPoolMembers, err))
+	}
+
+	return nil
+}
+
+func resourceIBMISLBPoolMembersUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isLBPoolMembers) {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = lbPoolMembersReplace(d, meta, parts[0], parts[1], d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	return resourceIBMISLBPoolMembersRead(context, d, meta)
+}
+
+func resourceIBMISLBPoolMembersDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(isLBPoolMembers, schema.NewSet(resourceIBMISLBPoolMemberHash, nil))
+	err = lbPoolMembersReplace(d, meta, parts[0], parts[1], d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if apiErr, ok := asAPIError(err); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
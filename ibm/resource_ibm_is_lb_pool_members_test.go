@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISLBPoolMembers_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbpms-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbpmsc-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISLBPoolMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, ISZoneName, ISCIDR, name, poolName, []string{"10.240.0.10", "10.240.0.11", "10.240.0.12"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolMembersCount("ibm_is_lb_pool_members.testacc_lb_mems", 3),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool_members.testacc_lb_mems", "members.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, ISZoneName, ISCIDR, name, poolName, []string{"10.240.0.11", "10.240.0.13"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISLBPoolMembersCount("ibm_is_lb_pool_members.testacc_lb_mems", 2),
+					resource.TestCheckResourceAttr(
+						"ibm_is_lb_pool_members.testacc_lb_mems", "members.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_lb_pool_members.testacc_lb_mems",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIBMISLBPoolMembersCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name    string
+		members []interface{}
+		valid   bool
+	}{
+		{"addresses", []interface{}{
+			map[string]interface{}{"port": 80, "target_address": "10.240.0.10"},
+			map[string]interface{}{"port": 80, "target_address": "10.240.0.11"},
+		}, true},
+		{"same address on two ports", []interface{}{
+			map[string]interface{}{"port": 80, "target_address": "10.240.0.10"},
+			map[string]interface{}{"port": 8080, "target_address": "10.240.0.10"},
+		}, true},
+		{"address and ID", []interface{}{
+			map[string]interface{}{"port": 80, "target_address": "10.240.0.10", "target_id": "0717-instance"},
+		}, false},
+		{"member given twice", []interface{}{
+			map[string]interface{}{"port": 80, "target_address": "10.240.0.10", "weight": 10},
+			map[string]interface{}{"port": 80, "target_address": "10.240.0.10", "weight": 20},
+		}, false},
+	}
+	for _, c := range cases {
+		raw := map[string]interface{}{
+			"lb":      "r006-lb",
+			"pool":    "r006-pool",
+			"members": c.members,
+		}
+		_, err := resourceIBMISLBPoolMembers().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		if (err == nil) != c.valid {
+			t.Errorf("%s: expected valid %t, got %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func testAccCheckIBMISLBPoolMembersDestroy(s *terraform.State) error {
+	sess, err := vpcClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_lb_pool_members" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		options := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &parts[0],
+			PoolID:         &parts[1],
+		}
+		collection, _, err := sess.ListLoadBalancerPoolMembers(options)
+		if err == nil && len(collection.Members) != 0 {
+			return fmt.Errorf("LB pool still has %d members: %s", len(collection.Members), rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISLBPoolMembersCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		sess, err := vpcClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		options := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &parts[0],
+			PoolID:         &parts[1],
+		}
+		collection, _, err := sess.ListLoadBalancerPoolMembers(options)
+		if err != nil {
+			return err
+		}
+		if len(collection.Members) != count {
+			return fmt.Errorf("Expected %d LB pool members, got %d", count, len(collection.Members))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISLBPoolMembersConfig(vpcname, subnetname, zone, cidr, name, poolName string, addresses []string) string {
+	members := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		members = append(members, fmt.Sprintf(`
+		members {
+			port           = 8080
+			target_address = "%s"
+		}`, address))
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = "${ibm_is_vpc.testacc_vpc.id}"
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = ["${ibm_is_subnet.testacc_subnet.id}"]
+	}
+	resource "ibm_is_lb_pool" "testacc_lb_pool" {
+		name = "%s"
+		lb = "${ibm_is_lb.testacc_LB.id}"
+		algorithm = "round_robin"
+		protocol = "http"
+		health_delay= 45
+		health_retries = 5
+		health_timeout = 30
+		health_type = "tcp"
+	}
+	resource "ibm_is_lb_pool_members" "testacc_lb_mems" {
+		lb   = ibm_is_lb.testacc_LB.id
+		pool = element(split("/", ibm_is_lb_pool.testacc_lb_pool.id), 1)
+		%s
+	}`, vpcname, subnetname, zone, cidr, name, poolName, strings.Join(members, "\n"))
+}
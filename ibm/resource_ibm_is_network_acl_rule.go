@@ -469,7 +469,7 @@ func resourceIBMISNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Network ACL Rule (%s) : %s\n%s", ruleId, err, response)
+		return fmt.Errorf("Error getting Network ACL Rule (%s) : %w", ruleId, newAPIError(err, response))
 	}
 	err = nwaclRuleGet(d, meta, nwACLID, nwaclRule)
 	if err != nil {
@@ -740,12 +740,12 @@ func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	if hasChanged {
 		updateNetworkACLOptionsPatch, err := updateNetworkACLOptionsPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for NetworkACLOptionsPatch : %s", err)
+			return fmt.Errorf("Error calling asPatch for NetworkACLOptionsPatch : %w", err)
 		}
 		updateNetworkACLRuleOptions.NetworkACLRulePatch = updateNetworkACLOptionsPatch
 		_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Network ACL Rule : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Network ACL Rule : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -782,7 +782,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Network ACL Rule  (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Network ACL Rule  (%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
@@ -791,7 +791,7 @@ func nwaclRuleDelete(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	}
 	response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Network ACL Rule : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Network ACL Rule : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -811,7 +811,7 @@ func nwaclRuleExists(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Network ACL Rule: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Network ACL Rule: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
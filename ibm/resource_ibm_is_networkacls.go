@@ -423,7 +423,7 @@ func classicNwaclCreate(d *schema.ResourceData, meta interface{}, name string) e
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %w", newAPIError(err, response))
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
@@ -483,7 +483,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 
 	nwacl, response, err := sess.CreateNetworkACL(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG]Error while creating Network ACL err %w", newAPIError(err, response))
 	}
 	d.SetId(*nwacl.ID)
 	log.Printf("[INFO] Network ACL : %s", *nwacl.ID)
@@ -546,7 +546,7 @@ func classicNwaclGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting Network ACL(%s) : %w", id, newAPIError(err, response))
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLSubnets, len(nwacl.Subnets))
@@ -655,7 +655,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Network ACL(%s) : %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting Network ACL(%s) : %w", id, newAPIError(err, response))
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -805,13 +805,13 @@ func classicNwaclUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		}
 		networkACLPatch, err := networkACLPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %w", err)
 		}
 		updateNetworkAclOptions.NetworkACLPatch = networkACLPatch
 
 		_, response, err := sess.UpdateNetworkACL(updateNetworkAclOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return fmt.Errorf("Error Updating Network ACL(%s) : %w", id, newAPIError(err, response))
 		}
 	}
 	if d.HasChange(isNetworkACLRules) {
@@ -848,12 +848,12 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		networkACLPatch, err := networkACLPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %w", err)
 		}
 		updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 		_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+			return fmt.Errorf("Error Updating Network ACL(%s) : %w", id, newAPIError(err, response))
 		}
 	}
 	if d.HasChange(isNetworkACLTags) {
@@ -920,7 +920,7 @@ func classicNwaclDelete(d *schema.ResourceData, meta interface{}, id string) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Network ACL (%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteNetworkAclOptions := &vpcclassicv1.DeleteNetworkACLOptions{
@@ -928,7 +928,7 @@ func classicNwaclDelete(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Network ACL : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Network ACL : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -950,7 +950,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Network ACL (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Network ACL (%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteNetworkAclOptions := &vpcv1.DeleteNetworkACLOptions{
@@ -958,7 +958,7 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteNetworkACL(deleteNetworkAclOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Network ACL : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Network ACL : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -992,7 +992,7 @@ func classicNwaclExists(d *schema.ResourceData, meta interface{}, id string) (bo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Network ACL: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -1010,7 +1010,7 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Network ACL: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -1058,7 +1058,7 @@ func classicClearRules(nwaclC *vpcclassicv1.VpcClassicV1, nwaclid string) error
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Listing network ACL rules : %s\n%s", err, response)
+			return fmt.Errorf("Error Listing network ACL rules : %w", newAPIError(err, response))
 		}
 		start = GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -1085,7 +1085,7 @@ func classicClearRules(nwaclC *vpcclassicv1.VpcClassicV1, nwaclid string) error
 
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Deleting network ACL rule : %s\n%s", err, response)
+			return fmt.Errorf("Error Deleting network ACL rule : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -1103,7 +1103,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 		}
 		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkAclRulesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Listing network ACL rules : %s\n%s", err, response)
+			return fmt.Errorf("Error Listing network ACL rules : %w", newAPIError(err, response))
 		}
 		start = GetNext(rawrules.Next)
 		allrecs = append(allrecs, rawrules.Rules...)
@@ -1130,7 +1130,7 @@ func clearRules(nwaclC *vpcv1.VpcV1, nwaclid string) error {
 
 		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Deleting network ACL rule : %s\n%s", err, response)
+			return fmt.Errorf("Error Deleting network ACL rule : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -1260,7 +1260,7 @@ func classicCreateInlineRules(nwaclC *vpcclassicv1.VpcClassicV1, nwaclid string,
 		}
 		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Creating network ACL rule : %s\n%s", err, response)
+			return fmt.Errorf("Error Creating network ACL rule : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -1367,7 +1367,7 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 		}
 		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
 		if err != nil {
-			return fmt.Errorf("Error Creating network ACL rule : %s\n%s", err, response)
+			return fmt.Errorf("Error Creating network ACL rule : %w", newAPIError(err, response))
 		}
 	}
 	return nil
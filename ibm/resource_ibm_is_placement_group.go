@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isPlacementGroupName           = "name"
+	isPlacementGroupStrategy       = "strategy"
+	isPlacementGroupResourceGroup  = "resource_group"
+	isPlacementGroupTags           = "tags"
+	isPlacementGroupLifecycleState = "lifecycle_state"
+
+	isPlacementGroupLifecycleStatePending   = "pending"
+	isPlacementGroupLifecycleStateUpdating  = "updating"
+	isPlacementGroupLifecycleStateStable    = "stable"
+	isPlacementGroupLifecycleStateDeleting  = "deleting"
+	isPlacementGroupLifecycleStateFailed    = "failed"
+	isPlacementGroupLifecycleStateSuspended = "suspended"
+	isPlacementGroupDeleteDone              = "done"
+)
+
+func resourceIbmIsPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmIsPlacementGroupCreate,
+		ReadContext:   resourceIbmIsPlacementGroupRead,
+		UpdateContext: resourceIbmIsPlacementGroupUpdate,
+		DeleteContext: resourceIbmIsPlacementGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceTagsCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isPlacementGroupStrategy: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_is_placement_group", isPlacementGroupStrategy),
+				Description:  "The strategy for this placement group: host_spread places the instances on unique hosts, power_spread places the instances on hosts that don't share a power source.",
+			},
+			isPlacementGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_placement_group", isPlacementGroupName),
+				Description:  "The unique user-defined name for this placement group. If unspecified, the name will be a hyphenated list of randomly-selected words.",
+			},
+			isPlacementGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			isPlacementGroupTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_is_placement_group", "tag")},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags of the placement group",
+			},
+			isPlacementGroupLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the placement group.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the placement group was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this placement group.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this placement group.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIbmIsPlacementGroupValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isPlacementGroupStrategy,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", vpcext.PlacementGroupStrategyHostSpread, vpcext.PlacementGroupStrategyPowerSpread),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isPlacementGroupName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_placement_group", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	strategy := d.Get(isPlacementGroupStrategy).(string)
+	prototype := &vpcext.PlacementGroupPrototype{
+		Strategy: &strategy,
+	}
+	if name, ok := d.GetOk(isPlacementGroupName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if resourceGroup, ok := d.GetOk(isPlacementGroupResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		prototype.ResourceGroup = &vpcext.Reference{ID: &resourceGroupStr}
+	}
+
+	placementGroup, response, err := client.CreatePlacementGroup(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePlacementGroup failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(*placementGroup.ID)
+	log.Printf("[INFO] Placement group : %s", d.Id())
+
+	_, err = isWaitForPlacementGroupStable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	if _, ok := d.GetOk(isPlacementGroupTags); ok {
+		oldList, newList := d.GetChange(isPlacementGroupTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, *placementGroup.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource placement group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIbmIsPlacementGroupRead(context, d, meta)
+}
+
+func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	placementGroup, response, err := client.GetPlacementGroup(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPlacementGroup failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	for k, v := range dataSourceIbmIsPlacementGroupToMap(*placementGroup) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	tags, err := GetTagsUsingCRN(meta, *placementGroup.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of resource placement group (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isPlacementGroupTags, tags)
+
+	return nil
+}
+
+func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isPlacementGroupName) {
+		patch := map[string]interface{}{
+			isPlacementGroupName: d.Get(isPlacementGroupName).(string),
+		}
+		_, response, err := client.UpdatePlacementGroup(context, d.Id(), patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePlacementGroup failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	if d.HasChange(isPlacementGroupTags) {
+		oldList, newList := d.GetChange(isPlacementGroupTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
+		if err != nil {
+			log.Printf(
+				"Error on update of resource placement group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIbmIsPlacementGroupRead(context, d, meta)
+}
+
+func resourceIbmIsPlacementGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.DeletePlacementGroup(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeletePlacementGroup failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForPlacementGroupDeleted(context, client, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForPlacementGroupStable(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for placement group (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isPlacementGroupLifecycleStatePending, isPlacementGroupLifecycleStateUpdating},
+		Target:  []string{isPlacementGroupLifecycleStateStable, isPlacementGroupLifecycleStateFailed, isPlacementGroupLifecycleStateSuspended},
+		Refresh: func() (interface{}, string, error) {
+			placementGroup, response, err := client.GetPlacementGroup(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Placement Group: %w", newAPIError(err, response))
+			}
+			if *placementGroup.LifecycleState == isPlacementGroupLifecycleStateFailed {
+				return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("Placement group (%s) went into failed state during the operation", id)
+			}
+			return placementGroup, *placementGroup.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForPlacementGroupDeleted(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for placement group (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isPlacementGroupLifecycleStateDeleting, isPlacementGroupLifecycleStateStable},
+		Target:  []string{isPlacementGroupDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			placementGroup, response, err := client.GetPlacementGroup(ctx, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return placementGroup, isPlacementGroupDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Placement Group: %w", newAPIError(err, response))
+			}
+			if *placementGroup.LifecycleState == isPlacementGroupLifecycleStateFailed {
+				return placementGroup, *placementGroup.LifecycleState, fmt.Errorf("Placement group (%s) went into failed state during the deletion", id)
+			}
+			return placementGroup, isPlacementGroupLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
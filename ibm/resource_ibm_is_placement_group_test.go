@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIbmIsPlacementGroupBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pg-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-pg-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIbmIsPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsPlacementGroupConfig("host_spread", name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIsPlacementGroupExists("ibm_is_placement_group.testacc_pg"),
+					resource.TestCheckResourceAttr("ibm_is_placement_group.testacc_pg", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_placement_group.testacc_pg", "strategy", "host_spread"),
+					resource.TestCheckResourceAttr("ibm_is_placement_group.testacc_pg", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_placement_group.testacc_pg", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIbmIsPlacementGroupConfig("host_spread", nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_placement_group.testacc_pg", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_placement_group.testacc_pg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIbmIsPlacementGroupInstanceTemplate(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	templatename := fmt.Sprintf("tf-template-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-pg-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIbmIsPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIsPlacementGroupInstanceTemplateConfig(vpcname, subnetname, sshname, publicKey, templatename, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.testacc_template", "placement_group",
+						"ibm_is_placement_group.testacc_pg", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_template.testacc_template", "placement_target.0.id",
+						"ibm_is_placement_group.testacc_pg", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmIsPlacementGroupDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_placement_group" {
+			continue
+		}
+		_, _, err := client.GetPlacementGroup(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Placement group still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIbmIsPlacementGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetPlacementGroup(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIbmIsPlacementGroupConfig(strategy, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_placement_group" "testacc_pg" {
+		strategy = "%s"
+		name     = "%s"
+	  }`, strategy, name)
+}
+
+func testAccCheckIbmIsPlacementGroupInstanceTemplateConfig(vpcname, subnetname, sshname, publicKey, templatename, name string) string {
+	return testAccCheckIbmIsPlacementGroupConfig("power_spread", name) + fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+
+	  resource "ibm_is_instance_template" "testacc_template" {
+		name            = "%s"
+		image           = "%s"
+		profile         = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		keys            = [ibm_is_ssh_key.testacc_sshkey.id]
+		placement_group = ibm_is_placement_group.testacc_pg.id
+		primary_network_interface {
+		  subnet = ibm_is_subnet.testacc_subnet.id
+		}
+	  }`, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, templatename, isImage, instanceProfileName, ISZoneName)
+}
@@ -225,7 +225,7 @@ func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Public Gateway %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Public Gateway %w", newAPIError(err, response))
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
@@ -286,7 +286,7 @@ func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string)
 
 	publicgw, response, err := sess.CreatePublicGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Public Gateway %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Public Gateway %w", newAPIError(err, response))
 	}
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
@@ -330,7 +330,7 @@ func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error getting Public Gateway : %w", newAPIError(err, response))
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -363,7 +363,7 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 		}
 		publicgw, response, err := publicgwC.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error getting Public Gateway : %w", newAPIError(err, response))
 		}
 
 		if *publicgw.Status == isPublicGatewayProvisioningDone {
@@ -408,7 +408,7 @@ func classicPgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Public Gateway : %w", newAPIError(err, response))
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -453,7 +453,7 @@ func pgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Public Gateway : %w", newAPIError(err, response))
 	}
 	d.Set(isPublicGatewayName, *publicgw.Name)
 	if publicgw.FloatingIP != nil {
@@ -527,7 +527,7 @@ func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Public Gateway : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
@@ -546,13 +546,13 @@ func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		PublicGatewayPatch, err := PublicGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %w", err)
 		}
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Public Gateway  : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Public Gateway  : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -569,7 +569,7 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		publicgw, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Public Gateway : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
@@ -587,12 +587,12 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		PublicGatewayPatch, err := PublicGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for PublicGatewayPatch: %w", err)
 		}
 		updatePublicGatewayOptions.PublicGatewayPatch = PublicGatewayPatch
 		_, response, err := sess.UpdatePublicGateway(updatePublicGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Public Gateway  : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Public Gateway  : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -633,7 +633,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Public Gateway (%s): %w", id, newAPIError(err, response))
 	}
 
 	deletePublicGatewayOptions := &vpcclassicv1.DeletePublicGatewayOptions{
@@ -641,7 +641,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Public Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Public Gateway : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -666,7 +666,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Public Gateway (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Public Gateway (%s): %w", id, newAPIError(err, response))
 	}
 
 	deletePublicGatewayOptions := &vpcv1.DeletePublicGatewayOptions{
@@ -674,7 +674,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeletePublicGateway(deletePublicGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Public Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Public Gateway : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -710,7 +710,7 @@ func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id s
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
@@ -742,7 +742,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 			if response != nil && response.StatusCode == 404 {
 				return pgw, isPublicGatewayDeleted, nil
 			}
-			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %s\n%s", id, err, response)
+			return nil, "", fmt.Errorf("The Public Gateway %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return pgw, isPublicGatewayDeleting, nil
 	}
@@ -776,7 +776,7 @@ func classicPgwExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Public Gateway: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -794,7 +794,7 @@ func pgwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Public Gateway: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -9,6 +9,9 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -23,6 +26,7 @@ const (
 	isSecurityGroupResourceGroup = "resource_group"
 	isSecurityGroupTags          = "tags"
 	isSecurityGroupCRN           = "crn"
+	isSecurityGroupInlineRule    = "rule"
 )
 
 func resourceIBMISSecurityGroup() *schema.Resource {
@@ -81,6 +85,15 @@ func resourceIBMISSecurityGroup() *schema.Resource {
 				},
 			},
 
+			isSecurityGroupInlineRule: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Rules of the security group. When set, the rules of the group which are not declared are removed",
+				Elem: &schema.Resource{
+					Schema: makeIBMISSecurityGroupInlineRuleSchema(),
+				},
+			},
+
 			isSecurityGroupResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -150,6 +163,9 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	vpc := d.Get(isSecurityGroupVPC).(string)
 	if userDetails.generation == 1 {
+		if _, ok := d.GetOk(isSecurityGroupInlineRule); ok {
+			return fmt.Errorf("The %s argument is not supported on classic infrastructure, use ibm_is_security_group_rule resources", isSecurityGroupInlineRule)
+		}
 		err := classicSgCreate(d, meta, vpc)
 		if err != nil {
 			return err
@@ -187,7 +203,7 @@ func classicSgCreate(d *schema.ResourceData, meta interface{}, vpc string) error
 
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Security Group %w", newAPIError(err, response))
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
@@ -224,7 +240,7 @@ func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
 	}
 	sg, response, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Security Group %w", newAPIError(err, response))
 	}
 	d.SetId(*sg.ID)
 	v := os.Getenv("IC_ENV_TAGS")
@@ -236,6 +252,12 @@ func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
 		}
 	}
+	if _, ok := d.GetOk(isSecurityGroupInlineRule); ok {
+		err = sgInlineRulesUpdate(d, meta, *sg.ID)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -273,7 +295,7 @@ func classicSgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Security Group : %w", newAPIError(err, response))
 	}
 	tags, err := GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -410,7 +432,7 @@ func sgGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Security Group : %w", newAPIError(err, response))
 	}
 	tags, err := GetTagsUsingCRN(meta, *group.CRN)
 	if err != nil {
@@ -511,6 +533,12 @@ func sgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	d.Set(isSecurityGroupRules, rules)
 	d.SetId(*group.ID)
+	if inlineRules, ok := d.GetOk(isSecurityGroupInlineRule); ok {
+		d.Set(isSecurityGroupInlineRule, flattenSecurityGroupInlineRules(inlineRules.(*schema.Set), group.Rules))
+		setSecurityGroupInlineRules(*group.ID, true)
+	} else {
+		setSecurityGroupInlineRules(*group.ID, false)
+	}
 	if group.ResourceGroup != nil {
 		d.Set(isSecurityGroupResourceGroup, group.ResourceGroup.ID)
 		d.Set(ResourceGroupName, group.ResourceGroup.Name)
@@ -543,6 +571,16 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange(isSecurityGroupInlineRule) {
+		if userDetails.generation == 1 {
+			return fmt.Errorf("The %s argument is not supported on classic infrastructure, use ibm_is_security_group_rule resources", isSecurityGroupInlineRule)
+		}
+		err = sgInlineRulesUpdate(d, meta, id)
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
@@ -577,12 +615,12 @@ func classicSgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		securityGroupPatch, err := securityGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for SecurityGroupPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for SecurityGroupPatch: %w", err)
 		}
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Security Group : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Security Group : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -602,12 +640,12 @@ func sgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 		}
 		securityGroupPatch, err := securityGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for SecurityGroupPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for SecurityGroupPatch: %w", err)
 		}
 		updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 		_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Security Group : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Security Group : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -648,7 +686,7 @@ func classicSgDelete(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Security Group (%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteSecurityGroupOptions := &vpcclassicv1.DeleteSecurityGroupOptions{
@@ -656,7 +694,7 @@ func classicSgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Security Group : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -676,7 +714,7 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Security Group (%s): %w", id, newAPIError(err, response))
 	}
 
 	start := ""
@@ -710,7 +748,7 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(id, *securityGroupTargetReference.ID)
 				response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 				if err != nil {
-					return fmt.Errorf("Error Deleting Security Group Targets : %s\n%s", err, response)
+					return fmt.Errorf("Error Deleting Security Group Targets : %w", newAPIError(err, response))
 				}
 
 			}
@@ -722,8 +760,9 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSecurityGroup(deleteSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Security Group : %w", newAPIError(err, response))
 	}
+	setSecurityGroupInlineRules(id, false)
 	d.SetId("")
 	return nil
 }
@@ -756,7 +795,7 @@ func classicSgExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Security Group: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -774,7 +813,7 @@ func sgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error)
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Security Group: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -826,3 +865,431 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+func makeIBMISSecurityGroupInlineRuleSchema() map[string]*schema.Schema {
+	ports := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isSecurityGroupRulePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+					},
+					isSecurityGroupRulePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+					},
+				},
+			},
+		}
+	}
+	tcp, udp := ports(), ports()
+	tcp.Description = "protocol=tcp"
+	udp.Description = "protocol=udp"
+
+	return map[string]*schema.Schema{
+
+		isSecurityGroupRuleDirection: {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+		},
+
+		isSecurityGroupRuleIPVersion: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      isSecurityGroupRuleIPVersionDefault,
+			Description:  "IP version: ipv4 or ipv6",
+			ValidateFunc: InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+		},
+
+		isSecurityGroupRuleRemote: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+		},
+
+		isSecurityGroupRuleProtocolICMP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "protocol=icmp",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isSecurityGroupRuleType: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      securityGroupInlineRuleICMPAny,
+						Description:  "ICMP traffic type to allow, any type when not set",
+						ValidateFunc: InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+					},
+					isSecurityGroupRuleCode: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      securityGroupInlineRuleICMPAny,
+						Description:  "ICMP traffic code to allow, any code when not set",
+						ValidateFunc: InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+					},
+				},
+			},
+		},
+
+		isSecurityGroupRuleProtocolTCP: tcp,
+
+		isSecurityGroupRuleProtocolUDP: udp,
+	}
+}
+
+// securityGroupInlineRuleICMPAny is the ICMP type or code of a rule which allows any type or code. Zero is a
+// valid type and code, and the rule blocks can't tell it from an unset value otherwise.
+const securityGroupInlineRuleICMPAny = -1
+
+// securityGroupsWithInlineRules are the security groups whose rules are declared in rule blocks. The
+// ibm_is_security_group_rule resources of these groups would be removed as drift, so they are rejected.
+var securityGroupsWithInlineRules = struct {
+	sync.RWMutex
+	ids map[string]bool
+}{ids: map[string]bool{}}
+
+func setSecurityGroupInlineRules(id string, inline bool) {
+	securityGroupsWithInlineRules.Lock()
+	defer securityGroupsWithInlineRules.Unlock()
+	if inline {
+		securityGroupsWithInlineRules.ids[id] = true
+	} else {
+		delete(securityGroupsWithInlineRules.ids, id)
+	}
+}
+
+func hasSecurityGroupInlineRules(id string) bool {
+	securityGroupsWithInlineRules.RLock()
+	defer securityGroupsWithInlineRules.RUnlock()
+	return securityGroupsWithInlineRules.ids[id]
+}
+
+// sgInlineRulesUpdate makes the rules of the security group match its rule blocks. Rules which are not declared
+// are updated in place to a missing rule of the same protocol when possible, or deleted.
+func sgInlineRulesUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	isSecurityGroupRuleKey := "security_group_rule_key_" + id
+	ibmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("Error getting Security Group (%s) rules: %w", id, newAPIError(err, response))
+	}
+
+	liveRules := map[string]map[string]interface{}{}
+	liveRuleIDs := map[string][]string{}
+	for _, rule := range group.Rules {
+		ruleID, r := flattenSecurityGroupInlineRule(rule)
+		if ruleID == "" {
+			continue
+		}
+		liveRules[ruleID] = r
+		key := securityGroupInlineRuleKey(r)
+		liveRuleIDs[key] = append(liveRuleIDs[key], ruleID)
+	}
+
+	missing := []map[string]interface{}{}
+	for _, v := range d.Get(isSecurityGroupInlineRule).(*schema.Set).List() {
+		r := v.(map[string]interface{})
+		key := securityGroupInlineRuleKey(r)
+		if ruleIDs := liveRuleIDs[key]; len(ruleIDs) > 0 {
+			liveRuleIDs[key] = ruleIDs[1:]
+			continue
+		}
+		missing = append(missing, r)
+	}
+	extra := []string{}
+	for _, ruleIDs := range liveRuleIDs {
+		extra = append(extra, ruleIDs...)
+	}
+	sort.Strings(extra)
+
+	for _, r := range missing {
+		prototype, patch, err := expandSecurityGroupInlineRule(r)
+		if err != nil {
+			return err
+		}
+		updated := false
+		for i, ruleID := range extra {
+			if !canUpdateSecurityGroupInlineRule(liveRules[ruleID], r) {
+				continue
+			}
+			securityGroupRulePatch, err := patch.AsPatch()
+			if err != nil {
+				return fmt.Errorf("Error calling asPatch for SecurityGroupRulePatch: %w", err)
+			}
+			updateSecurityGroupRuleOptions := &vpcv1.UpdateSecurityGroupRuleOptions{
+				SecurityGroupID:        &id,
+				ID:                     &ruleID,
+				SecurityGroupRulePatch: securityGroupRulePatch,
+			}
+			_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
+			if err != nil {
+				return fmt.Errorf("Error Updating Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
+			}
+			extra = append(extra[:i], extra[i+1:]...)
+			updated = true
+			break
+		}
+		if updated {
+			continue
+		}
+		createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &id,
+			SecurityGroupRulePrototype: prototype,
+		}
+		_, response, err := sess.CreateSecurityGroupRule(createSecurityGroupRuleOptions)
+		if err != nil {
+			return fmt.Errorf("Error while creating Security Group Rule %w", newAPIError(err, response))
+		}
+	}
+
+	for _, ruleID := range extra {
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &id,
+			ID:              &ruleID,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("Error Deleting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
+		}
+	}
+	setSecurityGroupInlineRules(id, d.Get(isSecurityGroupInlineRule).(*schema.Set).Len() > 0)
+	return nil
+}
+
+// flattenSecurityGroupInlineRules returns the rules of the security group as rule blocks. A rule equivalent
+// to a declared rule keeps the declared block, so that defaults filled in by the API do not show as changes.
+func flattenSecurityGroupInlineRules(declared *schema.Set, rules []vpcv1.SecurityGroupRuleIntf) []interface{} {
+	declaredRules := map[string][]interface{}{}
+	for _, v := range declared.List() {
+		key := securityGroupInlineRuleKey(v.(map[string]interface{}))
+		declaredRules[key] = append(declaredRules[key], v)
+	}
+	result := []interface{}{}
+	for _, rule := range rules {
+		ruleID, r := flattenSecurityGroupInlineRule(rule)
+		if ruleID == "" {
+			continue
+		}
+		key := securityGroupInlineRuleKey(r)
+		if matches := declaredRules[key]; len(matches) > 0 {
+			result = append(result, matches[0])
+			declaredRules[key] = matches[1:]
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+func flattenSecurityGroupInlineRule(rule vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}) {
+	r := make(map[string]interface{})
+	var ruleID string
+	var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+	switch reflect.TypeOf(rule).String() {
+	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
+		{
+			rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
+			ruleID = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			icmp := map[string]interface{}{
+				isSecurityGroupRuleType: securityGroupInlineRuleICMPAny,
+				isSecurityGroupRuleCode: securityGroupInlineRuleICMPAny,
+			}
+			if rule.Type != nil {
+				icmp[isSecurityGroupRuleType] = int(*rule.Type)
+			}
+			if rule.Code != nil {
+				icmp[isSecurityGroupRuleCode] = int(*rule.Code)
+			}
+			r[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+			remoteIntf = rule.Remote
+		}
+	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
+		{
+			rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
+			ruleID = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			remoteIntf = rule.Remote
+		}
+	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
+		{
+			rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
+			ruleID = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			ports := make(map[string]interface{})
+			if rule.PortMin != nil {
+				ports[isSecurityGroupRulePortMin] = int(*rule.PortMin)
+			}
+			if rule.PortMax != nil {
+				ports[isSecurityGroupRulePortMax] = int(*rule.PortMax)
+			}
+			if rule.Protocol != nil && *rule.Protocol == isSecurityGroupRuleProtocolUDP {
+				r[isSecurityGroupRuleProtocolUDP] = []interface{}{ports}
+			} else {
+				r[isSecurityGroupRuleProtocolTCP] = []interface{}{ports}
+			}
+			remoteIntf = rule.Remote
+		}
+	}
+	r[isSecurityGroupRuleRemote] = ""
+	remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote)
+	if ok && remote != nil {
+		if remote.ID != nil {
+			r[isSecurityGroupRuleRemote] = *remote.ID
+		} else if remote.Address != nil {
+			r[isSecurityGroupRuleRemote] = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+		}
+	}
+	return ruleID, r
+}
+
+func expandSecurityGroupInlineRule(r map[string]interface{}) (*vpcv1.SecurityGroupRulePrototype, *vpcv1.SecurityGroupRulePatch, error) {
+	direction := r[isSecurityGroupRuleDirection].(string)
+	ipVersion := isSecurityGroupRuleIPVersionDefault
+	if v, ok := r[isSecurityGroupRuleIPVersion].(string); ok && v != "" {
+		ipVersion = v
+	}
+	protocol := "all"
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+		Protocol:  &protocol,
+	}
+	patch := &vpcv1.SecurityGroupRulePatch{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+	}
+
+	if remote, ok := r[isSecurityGroupRuleRemote].(string); ok && remote != "" {
+		address, cidr, id, err := inferRemoteSecurityGroup(remote)
+		if err != nil {
+			return nil, nil, err
+		}
+		remoteTemplate := &vpcv1.SecurityGroupRuleRemotePrototype{}
+		remoteTemplateUpdate := &vpcv1.SecurityGroupRuleRemotePatch{}
+		if address != "" {
+			remoteTemplate.Address = &address
+			remoteTemplateUpdate.Address = &address
+		} else if cidr != "" {
+			remoteTemplate.CIDRBlock = &cidr
+			remoteTemplateUpdate.CIDRBlock = &cidr
+		} else if id != "" {
+			remoteTemplate.ID = &id
+			remoteTemplateUpdate.ID = &id
+		}
+		prototype.Remote = remoteTemplate
+		patch.Remote = remoteTemplateUpdate
+	}
+
+	for _, prot := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		l, ok := r[prot].([]interface{})
+		if !ok || len(l) == 0 {
+			continue
+		}
+		if protocol != "all" {
+			return nil, nil, fmt.Errorf("A rule of the security group accepts only one of the %s, %s and %s blocks", isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP)
+		}
+		protocol = prot
+		values, _ := l[0].(map[string]interface{})
+		if prot == isSecurityGroupRuleProtocolICMP {
+			// The type and the code are only sent when they are set, zero is a valid type and code
+			icmpType, hasType := values[isSecurityGroupRuleType].(int)
+			if hasType && icmpType != securityGroupInlineRuleICMPAny {
+				t := int64(icmpType)
+				prototype.Type, patch.Type = &t, &t
+			}
+			if icmpCode, ok := values[isSecurityGroupRuleCode].(int); ok && icmpCode != securityGroupInlineRuleICMPAny {
+				if prototype.Type == nil {
+					return nil, nil, fmt.Errorf("The icmp code of a rule of the security group requires an icmp type")
+				}
+				c := int64(icmpCode)
+				prototype.Code, patch.Code = &c, &c
+			}
+			continue
+		}
+		portMin, portMax := int64(1), int64(65535)
+		if values != nil {
+			portMin, portMax = int64(values[isSecurityGroupRulePortMin].(int)), int64(values[isSecurityGroupRulePortMax].(int))
+		}
+		prototype.PortMin, prototype.PortMax = &portMin, &portMax
+		patch.PortMin, patch.PortMax = &portMin, &portMax
+	}
+	return prototype, patch, nil
+}
+
+// securityGroupInlineRuleKey identifies the traffic allowed by a rule, whatever the defaults filled in by the API
+func securityGroupInlineRuleKey(r map[string]interface{}) string {
+	ipVersion, _ := r[isSecurityGroupRuleIPVersion].(string)
+	if ipVersion == "" {
+		ipVersion = isSecurityGroupRuleIPVersionDefault
+	}
+	remote, _ := r[isSecurityGroupRuleRemote].(string)
+	if remote == "" && ipVersion == isSecurityGroupRuleIPVersionDefault {
+		remote = "0.0.0.0/0"
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", r[isSecurityGroupRuleDirection], ipVersion, strings.ToLower(remote), securityGroupInlineRuleProtocol(r))
+}
+
+// securityGroupInlineRuleProtocol returns the protocol of a rule, with its ICMP type and code or its port range
+func securityGroupInlineRuleProtocol(r map[string]interface{}) string {
+	value := func(values map[string]interface{}, k string, def string) string {
+		if v, ok := values[k]; ok && v != securityGroupInlineRuleICMPAny {
+			return fmt.Sprint(v)
+		}
+		return def
+	}
+	for _, prot := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		l, ok := r[prot].([]interface{})
+		if !ok || len(l) == 0 {
+			continue
+		}
+		values, _ := l[0].(map[string]interface{})
+		if prot == isSecurityGroupRuleProtocolICMP {
+			icmpType, icmpCode := value(values, isSecurityGroupRuleType, "any"), value(values, isSecurityGroupRuleCode, "any")
+			if icmpType == "any" && icmpCode == "any" {
+				return prot
+			}
+			return fmt.Sprintf("%s:%s:%s", prot, icmpType, icmpCode)
+		}
+		return fmt.Sprintf("%s:%s-%s", prot, value(values, isSecurityGroupRulePortMin, "1"), value(values, isSecurityGroupRulePortMax, "65535"))
+	}
+	return "all"
+}
+
+// canUpdateSecurityGroupInlineRule reports whether a rule can be patched into another one. The protocol of a rule
+// cannot be changed, nor can its remote or its ICMP type be removed.
+func canUpdateSecurityGroupInlineRule(from, to map[string]interface{}) bool {
+	protocol := strings.SplitN(securityGroupInlineRuleProtocol(to), ":", 2)[0]
+	if protocol != strings.SplitN(securityGroupInlineRuleProtocol(from), ":", 2)[0] {
+		return false
+	}
+	if remote, _ := to[isSecurityGroupRuleRemote].(string); remote == "" {
+		return false
+	}
+	return protocol != isSecurityGroupRuleProtocolICMP || securityGroupInlineRuleProtocol(to) != isSecurityGroupRuleProtocolICMP
+}
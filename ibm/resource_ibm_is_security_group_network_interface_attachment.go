@@ -185,7 +185,7 @@ func classicSgnicCreate(d *schema.ResourceData, meta interface{}, sgID, nicID st
 	}
 	_, response, err := sess.AddSecurityGroupNetworkInterface(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
+		return fmt.Errorf("Error while creating SecurityGroup NetworkInterface Binding %w", newAPIError(err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", sgID, nicID))
 	return nil
@@ -202,7 +202,7 @@ func sgnicCreate(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	}
 	_, response, err := sess.AddSecurityGroupNetworkInterface(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating SecurityGroup NetworkInterface Binding %s\n%s", err, response)
+		return fmt.Errorf("Error while creating SecurityGroup NetworkInterface Binding %w", newAPIError(err, response))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", sgID, nicID))
 	return nil
@@ -248,7 +248,7 @@ func classicSgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 	d.Set(isSGNICAGroupId, sgID)
 	d.Set(isSGNICANicId, nicID)
@@ -287,7 +287,7 @@ func classicSgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID strin
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Security Group : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	return nil
@@ -308,7 +308,7 @@ func sgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID string) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 	d.Set(isSGNICAGroupId, sgID)
 	d.Set(isSGNICANicId, nicID)
@@ -347,7 +347,7 @@ func sgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID string) erro
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Security Group : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	return nil
@@ -396,7 +396,7 @@ func classicSgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID st
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 
 	removeSecurityGroupNetworkInterfaceOptions := &vpcclassicv1.RemoveSecurityGroupNetworkInterfaceOptions{
@@ -405,7 +405,7 @@ func classicSgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID st
 	}
 	response, err = sess.RemoveSecurityGroupNetworkInterface(removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return fmt.Errorf("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -427,7 +427,7 @@ func sgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 
 	removeSecurityGroupNetworkInterfaceOptions := &vpcv1.RemoveSecurityGroupNetworkInterfaceOptions{
@@ -436,7 +436,7 @@ func sgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	}
 	response, err = sess.RemoveSecurityGroupNetworkInterface(removeSecurityGroupNetworkInterfaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return fmt.Errorf("Error Deleting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -479,7 +479,7 @@ func classicSgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID st
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -498,7 +498,7 @@ func sgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID string) (
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
+		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %w", nicID, sgID, newAPIError(err, response))
 	}
 	return true, nil
 }
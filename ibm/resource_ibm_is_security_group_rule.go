@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -40,6 +41,13 @@ func resourceIBMISSecurityGroupRule() *schema.Resource {
 		Exists:   resourceIBMISSecurityGroupRuleExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			if group, ok := diff.Get(isSecurityGroupID).(string); ok && group != "" {
+				return securityGroupInlineRulesConflict(group)
+			}
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			isSecurityGroupID: {
@@ -265,7 +273,7 @@ func classicSgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 
 	rule, response, err := sess.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group Rule %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Security Group Rule %w", newAPIError(err, response))
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcclassicv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -302,6 +310,9 @@ func sgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+	if err := securityGroupInlineRulesConflict(parsed.secgrpID); err != nil {
+		return err
+	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
 	ibmMutexKV.Lock(isSecurityGroupRuleKey)
 	defer ibmMutexKV.Unlock(isSecurityGroupRuleKey)
@@ -313,7 +324,7 @@ func sgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 
 	rule, response, err := sess.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating Security Group Rule %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Security Group Rule %w", newAPIError(err, response))
 	}
 	switch reflect.TypeOf(rule).String() {
 	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
@@ -379,7 +390,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return fmt.Errorf("Error Getting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
 	}
 
 	d.Set(isSecurityGroupID, secgrpID)
@@ -388,7 +399,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Security Group : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -500,7 +511,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return fmt.Errorf("Error Getting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
 	}
 	d.Set(isSecurityGroupID, secgrpID)
 	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
@@ -508,7 +519,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 	}
 	sg, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Security Group : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting Security Group : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *sg.CRN)
 	switch reflect.TypeOf(sgrule).String() {
@@ -644,7 +655,7 @@ func classicSgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Security Group Rule : %s\n%s", err, response)
+		return fmt.Errorf("Error Updating Security Group Rule : %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -665,7 +676,7 @@ func sgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	updateSecurityGroupRuleOptions := sgTemplate
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Security Group Rule : %s\n%s", err, response)
+		return fmt.Errorf("Error Updating Security Group Rule : %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -716,7 +727,7 @@ func classicSgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, rul
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return fmt.Errorf("Error Getting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcclassicv1.DeleteSecurityGroupRuleOptions{
@@ -725,7 +736,7 @@ func classicSgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, rul
 	}
 	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group Rule : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Security Group Rule : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -748,7 +759,7 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return fmt.Errorf("Error Getting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
 	}
 
 	deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
@@ -757,7 +768,7 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 	}
 	response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Security Group Rule : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Security Group Rule : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -795,7 +806,7 @@ func classicSgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, rul
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return false, fmt.Errorf("Error getting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -814,7 +825,7 @@ func sgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
+		return false, fmt.Errorf("Error getting Security Group Rule (%s): %w", ruleID, newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -1126,7 +1137,7 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 	}
 	securityGroupRulePatch, err := securityGroupRulePatchModel.AsPatch()
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("Error calling asPatch for SecurityGroupRulePatch: %s", err)
+		return nil, nil, nil, fmt.Errorf("Error calling asPatch for SecurityGroupRulePatch: %w", err)
 	}
 	sgTemplateUpdate.SecurityGroupRulePatch = securityGroupRulePatch
 	//	log.Printf("[DEBUG] parse tag=%s\n\t%v  \n\t%v  \n\t%v  \n\t%v  \n\t%v \n\t%v \n\t%v \n\t%v  \n\t%v  \n\t%v  \n\t%v  \n\t%v ",
@@ -1135,6 +1146,15 @@ func parseIBMISSecurityGroupRuleDictionary(d *schema.ResourceData, tag string, s
 	return parsed, sgTemplate, sgTemplateUpdate, nil
 }
 
+// securityGroupInlineRulesConflict rejects a rule of a security group whose rules are declared in rule blocks,
+// which would remove it on the next apply
+func securityGroupInlineRulesConflict(secgrpID string) error {
+	if hasSecurityGroupInlineRules(secgrpID) {
+		return fmt.Errorf("Security group %s declares its rules in %s blocks, which remove the rules managed by ibm_is_security_group_rule resources. Declare this rule in the security group instead", secgrpID, isSecurityGroupInlineRule)
+	}
+	return nil
+}
+
 func makeTerraformRuleID(id1, id2 string) string {
 	// Include both group and rule id to create a unique Terraform id.  As a bonus,
 	// we can extract the group id as needed for API calls such as READ.
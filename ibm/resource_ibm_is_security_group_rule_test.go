@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -40,6 +41,23 @@ func TestAccIBMISSecurityGroupRule_basic(t *testing.T) {
 	})
 }
 
+func TestSecurityGroupRuleInlineRulesConflict(t *testing.T) {
+	r := resourceIBMISSecurityGroupRule()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		isSecurityGroupID:            "r006-inline-rules-group",
+		isSecurityGroupRuleDirection: "inbound",
+	})
+
+	if _, err := r.Diff(context.Background(), nil, config, nil); err != nil {
+		t.Fatalf("expected a rule of a group without rule blocks to plan, got %s", err)
+	}
+	setSecurityGroupInlineRules("r006-inline-rules-group", true)
+	defer setSecurityGroupInlineRules("r006-inline-rules-group", false)
+	if _, err := r.Diff(context.Background(), nil, config, nil); err == nil {
+		t.Fatal("expected a rule of a group with rule blocks to be rejected")
+	}
+}
+
 func testAccCheckIBMISSecurityGroupRuleDestroy(s *terraform.State) error {
 	userDetails, _ := testAccProvider.Meta().(ClientSession).BluemixUserDetails()
 
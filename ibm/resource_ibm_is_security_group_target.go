@@ -162,12 +162,12 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error Getting Security Group Targets (%s): %s\n%s", securityGroupID, err, response)
+		return fmt.Errorf("error Getting Security Group Targets (%s): %w", securityGroupID, newAPIError(err, response))
 	}
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
 	response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
 	if err != nil {
-		return fmt.Errorf("error Deleting Security Group Targets : %s\n%s", err, response)
+		return fmt.Errorf("error Deleting Security Group Targets : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -198,7 +198,7 @@ func resourceIBMISSecurityGroupTargetExists(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("error getting Security Group Target : %s\n%s", err, response)
+		return false, fmt.Errorf("error getting Security Group Target : %w", newAPIError(err, response))
 	}
 	return true, nil
 
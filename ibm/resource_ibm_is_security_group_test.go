@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -15,6 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_is_security_group", &resource.Sweeper{
+		Name:         "ibm_is_security_group",
+		Dependencies: []string{"ibm_is_instance"},
+		F:            testSweepISSecurityGroups,
+	})
+}
+
 func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 	var securityGroup string
 
@@ -51,6 +60,154 @@ func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISSecurityGroup_inlineRules(t *testing.T) {
+	var securityGroupID string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-inline-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						securityGroupID = s.RootModule().Resources["ibm_is_security_group.testacc_security_group"].Primary.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 443),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "2"),
+				),
+			},
+			{
+				// A rule added outside of Terraform shows as drift
+				PreConfig: func() {
+					sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+					direction, protocol := "inbound", "icmp"
+					_, _, err := sess.CreateSecurityGroupRule(&vpcv1.CreateSecurityGroupRuleOptions{
+						SecurityGroupID: &securityGroupID,
+						SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+							Direction: &direction,
+							Protocol:  &protocol,
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 443),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 443),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestSecurityGroupInlineRuleKey(t *testing.T) {
+	cases := []struct {
+		declared map[string]interface{}
+		rule     vpcv1.SecurityGroupRuleIntf
+	}{
+		{
+			map[string]interface{}{"direction": "outbound", "ip_version": "ipv4", "remote": ""},
+			&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+				ID: core.StringPtr("r1"), Direction: core.StringPtr("outbound"), IPVersion: core.StringPtr("ipv4"), Protocol: core.StringPtr("all"),
+				Remote: &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+			},
+		},
+		{
+			map[string]interface{}{"direction": "inbound", "ip_version": "ipv4", "remote": "10.0.0.0/8", "tcp": []interface{}{nil}},
+			&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+				ID: core.StringPtr("r2"), Direction: core.StringPtr("inbound"), IPVersion: core.StringPtr("ipv4"), Protocol: core.StringPtr("tcp"),
+				PortMin: core.Int64Ptr(1), PortMax: core.Int64Ptr(65535),
+				Remote: &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("10.0.0.0/8")},
+			},
+		},
+		{
+			map[string]interface{}{"direction": "inbound", "ip_version": "ipv4", "remote": "", "icmp": []interface{}{map[string]interface{}{"type": 8, "code": 0}}},
+			&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+				ID: core.StringPtr("r3"), Direction: core.StringPtr("inbound"), IPVersion: core.StringPtr("ipv4"), Protocol: core.StringPtr("icmp"),
+				Type: core.Int64Ptr(8), Code: core.Int64Ptr(0),
+				Remote: &vpcv1.SecurityGroupRuleRemote{CIDRBlock: core.StringPtr("0.0.0.0/0")},
+			},
+		},
+	}
+	for _, c := range cases {
+		_, live := flattenSecurityGroupInlineRule(c.rule)
+		if expected, got := securityGroupInlineRuleKey(c.declared), securityGroupInlineRuleKey(live); expected != got {
+			t.Errorf("expected the rule %v to match %v, got key %s instead of %s", live, c.declared, got, expected)
+		}
+	}
+
+	udp := map[string]interface{}{"direction": "inbound", "udp": []interface{}{map[string]interface{}{"port_min": 53, "port_max": 53}}}
+	if securityGroupInlineRuleKey(udp) == securityGroupInlineRuleKey(cases[1].declared) {
+		t.Errorf("expected the udp rule not to match the tcp rule")
+	}
+	if _, _, err := expandSecurityGroupInlineRule(map[string]interface{}{"direction": "inbound", "tcp": []interface{}{nil}, "udp": []interface{}{nil}}); err == nil {
+		t.Errorf("expected an error for a rule with two protocols")
+	}
+}
+
+func TestSecurityGroupInlineRuleICMP(t *testing.T) {
+	icmp := func(icmpType, icmpCode int) map[string]interface{} {
+		return map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{"type": icmpType, "code": icmpCode}}}
+	}
+
+	// An unset type and code allow any ICMP traffic and are not sent, zero is sent as a valid type and code
+	prototype, patch, err := expandSecurityGroupInlineRule(icmp(securityGroupInlineRuleICMPAny, securityGroupInlineRuleICMPAny))
+	if err != nil || prototype.Type != nil || prototype.Code != nil || patch.Type != nil || patch.Code != nil {
+		t.Fatalf("expected no icmp type and code, got %v and %v (%v)", prototype.Type, prototype.Code, err)
+	}
+	prototype, _, err = expandSecurityGroupInlineRule(icmp(0, securityGroupInlineRuleICMPAny))
+	if err != nil || prototype.Type == nil || *prototype.Type != 0 || prototype.Code != nil {
+		t.Fatalf("expected the icmp type 0 without a code, got %v and %v (%v)", prototype.Type, prototype.Code, err)
+	}
+	if _, _, err := expandSecurityGroupInlineRule(icmp(securityGroupInlineRuleICMPAny, 0)); err == nil {
+		t.Fatal("expected an error for an icmp code without a type")
+	}
+
+	_, live := flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+		ID: core.StringPtr("r1"), Direction: core.StringPtr("inbound"), IPVersion: core.StringPtr("ipv4"), Protocol: core.StringPtr("icmp"),
+	})
+	if expected, got := securityGroupInlineRuleKey(icmp(securityGroupInlineRuleICMPAny, securityGroupInlineRuleICMPAny)), securityGroupInlineRuleKey(live); expected != got {
+		t.Fatalf("expected the rule without a type to match, got key %s instead of %s", got, expected)
+	}
+	if securityGroupInlineRuleKey(icmp(0, 0)) == securityGroupInlineRuleKey(live) {
+		t.Fatal("expected the rule of type 0 not to match a rule of any type")
+	}
+
+	// The unset default is not validated against the ICMP type range
+	r := resourceIBMISSecurityGroup()
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"vpc":  "r006-1",
+		"rule": []interface{}{map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{}}}},
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected validation errors: %v", diags)
+	}
+}
+
 func testAccCheckIBMISSecurityGroupDestroy(s *terraform.State) error {
 	userDetails, _ := testAccProvider.Meta().(ClientSession).BluemixUserDetails()
 
@@ -157,3 +314,67 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name string, port int) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc = "${ibm_is_vpc.testacc_vpc.id}"
+
+	rule {
+		direction = "outbound"
+	}
+
+	rule {
+		direction = "inbound"
+		remote = "10.0.0.0/8"
+		tcp {
+			port_min = %d
+			port_max = %d
+		}
+	}
+}`, vpcname, name, port, port)
+
+}
+
+func testSweepISSecurityGroups(region string) error {
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	start := ""
+	allrecs := []vpcv1.SecurityGroup{}
+	for {
+		options := &vpcv1.ListSecurityGroupsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		groups, response, err := sess.ListSecurityGroups(options)
+		if err != nil {
+			return fmt.Errorf("Error Fetching Security Groups %s\n%s", err, response)
+		}
+		start = GetNext(groups.Next)
+		allrecs = append(allrecs, groups.SecurityGroups...)
+		if start == "" {
+			break
+		}
+	}
+	var errs []error
+	for _, group := range allrecs {
+		if !isSweepable(*group.Name) {
+			continue
+		}
+		if err := sweepResource(resourceIBMISSecurityGroup(), "ibm_is_security_group", *group.ID, *group.Name, nil, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return sweepErrors("ibm_is_security_group", errs)
+}
@@ -0,0 +1,527 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareName                = "name"
+	isShareProfile             = "profile"
+	isShareZone                = "zone"
+	isShareSize                = "size"
+	isShareIops                = "iops"
+	isShareEncryptionKey       = "encryption_key"
+	isShareAccessControlMode   = "access_control_mode"
+	isShareResourceGroup       = "resource_group"
+	isShareTags                = "tags"
+	isShareSourceShare         = "source_share"
+	isShareReplicationCronSpec = "replication_cron_spec"
+	isShareReplicaShare        = "replica_share"
+	isShareReplicationRole     = "replication_role"
+	isShareReplicationStatus   = "replication_status"
+	isShareLastSyncAt          = "last_sync_at"
+	isShareEncryption          = "encryption"
+	isShareMountTargets        = "mount_targets"
+	isShareLifecycleState      = "lifecycle_state"
+
+	isShareLifecycleStatePending  = "pending"
+	isShareLifecycleStateUpdating = "updating"
+	isShareLifecycleStateStable   = "stable"
+	isShareLifecycleStateDeleting = "deleting"
+	isShareLifecycleStateFailed   = "failed"
+	isShareDeleteDone             = "done"
+)
+
+func resourceIBMISShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISShareCreate,
+		ReadContext:   resourceIBMISShareRead,
+		UpdateContext: resourceIBMISShareUpdate,
+		DeleteContext: resourceIBMISShareDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceTagsCustomizeDiff(diff)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return shareSizeCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isShareProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the profile of the share.",
+			},
+			isShareZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone the share resides in.",
+			},
+			isShareSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isShareSize, isShareSourceShare},
+				ValidateFunc: InvokeValidator("ibm_is_share", isShareSize),
+				Description:  "The size of the share in gigabytes, which can only be increased. Exactly one of size and source_share must be set, a replica share has the size of its source share.",
+			},
+			isShareIops: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum input/output operations per second for the share. Only applies to the profiles with a range of IOPS.",
+			},
+			isShareEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The CRN of the root key to encrypt the share with. If unspecified, the share is encrypted with IBM-managed keys.",
+			},
+			isShareAccessControlMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_share", isShareAccessControlMode),
+				Description:  "The access control mode of the share: vpc allows the mount targets of a VPC to mount the share, security_group restricts the access with the security groups of the mount targets.",
+			},
+			isShareName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_share", isShareName),
+				Description:  "The unique user-defined name for this share.",
+			},
+			isShareResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			isShareTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_is_share", "tag")},
+				Set:         resourceIBMVPCHash,
+				Description: "The user tags of the share",
+			},
+			isShareSourceShare: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isShareSize, isShareSourceShare},
+				RequiredWith: []string{isShareReplicationCronSpec},
+				Description:  "The unique identifier or CRN of the share to replicate, which makes this share a replica share. The source share is kept after a failover or a split so that the share is not replaced.",
+			},
+			isShareReplicationCronSpec: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{isShareSourceShare},
+				ValidateFunc: InvokeValidator("ibm_is_share", isShareReplicationCronSpec),
+				Description:  "The cron specification of the replication of a replica share, in UTC. Required when source_share is set.",
+			},
+			isShareReplicaShare: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the replica share of this share.",
+			},
+			isShareReplicationRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication role of the share: none, replica or source.",
+			},
+			isShareReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the share: active, failover_pending, initializing, none or split_pending.",
+			},
+			isShareLastSyncAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the replica share was last synchronized with its source share.",
+			},
+			isShareEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption of the share: provider_managed or user_managed.",
+			},
+			isShareMountTargets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The mount targets of the share.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the mount target.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the mount target.",
+						},
+					},
+				},
+			},
+			isShareLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the share.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the share was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this share.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this share.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISShareValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareSize,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "10",
+			MaxValue:                   "32000",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareAccessControlMode,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", vpcext.ShareAccessControlModeVPC, vpcext.ShareAccessControlModeSecurityGroup),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareReplicationCronSpec,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^((((\d+,)+\d+|([\d\*]+(\/|-)\d+)|\d+|\*) ?){5,7})$`,
+			MinValueLength:             9,
+			MaxValueLength:             63,
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_share", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// shareSizeCustomizeDiff rejects a smaller size during plan, shares can only be expanded
+func shareSizeCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange(isShareSize) {
+		return nil
+	}
+	o, n := diff.GetChange(isShareSize)
+	if n.(int) != 0 && n.(int) < o.(int) {
+		return fmt.Errorf("The size of a share cannot be reduced: %s can only be increased from %d GB, got %d GB", isShareSize, o.(int), n.(int))
+	}
+	return nil
+}
+
+func resourceIBMISShareCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := d.Get(isShareProfile).(string)
+	zone := d.Get(isShareZone).(string)
+	prototype := &vpcext.SharePrototype{
+		Profile: &vpcext.Reference{Name: &profile},
+		Zone:    &vpcext.Reference{Name: &zone},
+	}
+	if size, ok := d.GetOk(isShareSize); ok {
+		sizeInt := int64(size.(int))
+		prototype.Size = &sizeInt
+	}
+	if iops, ok := d.GetOk(isShareIops); ok {
+		iopsInt := int64(iops.(int))
+		prototype.Iops = &iopsInt
+	}
+	if encryptionKey, ok := d.GetOk(isShareEncryptionKey); ok {
+		encryptionKeyStr := encryptionKey.(string)
+		prototype.EncryptionKey = &vpcext.Reference{CRN: &encryptionKeyStr}
+	}
+	if accessControlMode, ok := d.GetOk(isShareAccessControlMode); ok {
+		accessControlModeStr := accessControlMode.(string)
+		prototype.AccessControlMode = &accessControlModeStr
+	}
+	if name, ok := d.GetOk(isShareName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if resourceGroup, ok := d.GetOk(isShareResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		prototype.ResourceGroup = &vpcext.Reference{ID: &resourceGroupStr}
+	}
+	if sourceShare, ok := d.GetOk(isShareSourceShare); ok {
+		prototype.SourceShare = shareReference(sourceShare.(string))
+		cronSpec := d.Get(isShareReplicationCronSpec).(string)
+		prototype.ReplicationCronSpec = &cronSpec
+	}
+
+	share, response, err := client.CreateShare(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateShare failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(*share.ID)
+	log.Printf("[INFO] Share : %s", d.Id())
+
+	_, err = isWaitForShareStable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	if _, ok := d.GetOk(isShareTags); ok {
+		oldList, newList := d.GetChange(isShareTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, *share.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on create of resource share (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMISShareRead(context, d, meta)
+}
+
+// shareReference refers to a share by CRN or by unique identifier
+func shareReference(idOrCRN string) *vpcext.Reference {
+	if len(idOrCRN) > 4 && idOrCRN[:4] == "crn:" {
+		return &vpcext.Reference{CRN: &idOrCRN}
+	}
+	return &vpcext.Reference{ID: &idOrCRN}
+}
+
+func resourceIBMISShareRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	share, response, err := client.GetShare(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetShare failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	for k, v := range dataSourceIBMISShareToMap(*share) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+	// A failover or a split removes the source share and the replication cron spec, keep them so that the share is
+	// not replaced
+	if share.SourceShare != nil {
+		if _, ok := d.GetOk(isShareSourceShare); !ok {
+			d.Set(isShareSourceShare, share.SourceShare.ID)
+		}
+	}
+	if share.ReplicationCronSpec != nil {
+		d.Set(isShareReplicationCronSpec, share.ReplicationCronSpec)
+	}
+
+	tags, err := GetTagsUsingCRN(meta, *share.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of resource share (%s) tags: %s", d.Id(), err)
+	}
+	d.Set(isShareTags, tags)
+
+	return nil
+}
+
+func resourceIBMISShareUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	if d.HasChange(isShareName) {
+		patch[isShareName] = d.Get(isShareName).(string)
+	}
+	if d.HasChange(isShareProfile) {
+		patch[isShareProfile] = map[string]interface{}{
+			"name": d.Get(isShareProfile).(string),
+		}
+	}
+	if d.HasChange(isShareSize) {
+		patch[isShareSize] = d.Get(isShareSize).(int)
+	}
+	if d.HasChange(isShareIops) {
+		patch[isShareIops] = d.Get(isShareIops).(int)
+	}
+	if d.HasChange(isShareAccessControlMode) {
+		patch[isShareAccessControlMode] = d.Get(isShareAccessControlMode).(string)
+	}
+	if d.HasChange(isShareReplicationCronSpec) {
+		patch[isShareReplicationCronSpec] = d.Get(isShareReplicationCronSpec).(string)
+	}
+	if len(patch) > 0 {
+		_, response, err := client.UpdateShare(context, d.Id(), patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateShare failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		_, err = isWaitForShareStable(context, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	if d.HasChange(isShareTags) {
+		oldList, newList := d.GetChange(isShareTags)
+		err = UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
+		if err != nil {
+			log.Printf(
+				"Error on update of resource share (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMISShareRead(context, d, meta)
+}
+
+func resourceIBMISShareDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.DeleteShare(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteShare failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForShareDeleted(context, client, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForShareStable(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isShareLifecycleStatePending, isShareLifecycleStateUpdating},
+		Target:  []string{isShareLifecycleStateStable, isShareLifecycleStateFailed},
+		Refresh: func() (interface{}, string, error) {
+			share, response, err := client.GetShare(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Share: %w", newAPIError(err, response))
+			}
+			if *share.LifecycleState == isShareLifecycleStateFailed {
+				return share, *share.LifecycleState, fmt.Errorf("Share (%s) went into failed state during the operation", id)
+			}
+			return share, *share.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForShareDeleted(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isShareLifecycleStateDeleting, isShareLifecycleStateStable},
+		Target:  []string{isShareDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			share, response, err := client.GetShare(ctx, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return share, isShareDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Share: %w", newAPIError(err, response))
+			}
+			if *share.LifecycleState == isShareLifecycleStateFailed {
+				return share, *share.LifecycleState, fmt.Errorf("Share (%s) went into failed state during the deletion", id)
+			}
+			return share, isShareLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareMountTargetShare                   = "share"
+	isShareMountTargetID                      = "mount_target"
+	isShareMountTargetName                    = "name"
+	isShareMountTargetVPC                     = "vpc"
+	isShareMountTargetVirtualNetworkInterface = "virtual_network_interface"
+	isShareMountTargetVNISubnet               = "subnet"
+	isShareMountTargetVNIName                 = "name"
+	isShareMountTargetVNISecurityGroups       = "security_groups"
+	isShareMountTargetTransitEncryption       = "transit_encryption"
+	isShareMountTargetMountPath               = "mount_path"
+	isShareMountTargetAccessControlMode       = "access_control_mode"
+	isShareMountTargetSubnet                  = "subnet"
+	isShareMountTargetPrimaryIP               = "primary_ip"
+	isShareMountTargetLifecycleState          = "lifecycle_state"
+)
+
+// resourceIBMISShareMountTarget mounts a share from a VPC. A share with the vpc access control mode is mounted through
+// a mount target bound to the VPC, a share with the security_group mode through a mount target with a virtual network
+// interface in a subnet of the VPC.
+func resourceIBMISShareMountTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISShareMountTargetCreate,
+		ReadContext:   resourceIBMISShareMountTargetRead,
+		UpdateContext: resourceIBMISShareMountTargetUpdate,
+		DeleteContext: resourceIBMISShareMountTargetDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isShareMountTargetShare: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the share.",
+			},
+			isShareMountTargetName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_share_mount_target", isShareMountTargetName),
+				Description:  "The unique user-defined name for this mount target.",
+			},
+			isShareMountTargetVPC: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isShareMountTargetVPC, isShareMountTargetVirtualNetworkInterface},
+				Description:  "The unique identifier of the VPC to mount the share from, for a share with the vpc access control mode.",
+			},
+			isShareMountTargetVirtualNetworkInterface: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{isShareMountTargetVPC, isShareMountTargetVirtualNetworkInterface},
+				Description:  "The virtual network interface to create for the mount target, for a share with the security_group access control mode.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isShareMountTargetVNISubnet: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The unique identifier of the subnet of the virtual network interface.",
+						},
+						isShareMountTargetVNIName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The name of the virtual network interface.",
+						},
+						isShareMountTargetVNISecurityGroups: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The unique identifiers of the security groups of the virtual network interface. If unspecified, the default security group of the VPC is used.",
+						},
+					},
+				},
+			},
+			isShareMountTargetTransitEncryption: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_is_share_mount_target", isShareMountTargetTransitEncryption),
+				Description:  "The transit encryption mode of the mount target: none or user_managed.",
+			},
+			isShareMountTargetID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the mount target.",
+			},
+			isShareMountTargetMountPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mount path of the share, to mount from the instances of the VPC.",
+			},
+			isShareMountTargetAccessControlMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access control mode of the mount target.",
+			},
+			isShareMountTargetSubnet: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the subnet of the virtual network interface of the mount target.",
+			},
+			isShareMountTargetPrimaryIP: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary IP address of the virtual network interface of the mount target.",
+			},
+			isShareMountTargetLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the mount target.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the mount target was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this mount target.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISShareMountTargetValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareMountTargetTransitEncryption,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "none, user_managed",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareMountTargetName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_share_mount_target", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMISShareMountTargetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get(isShareMountTargetShare).(string)
+	prototype := &vpcext.ShareMountTargetPrototype{}
+	if name, ok := d.GetOk(isShareMountTargetName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if vpc, ok := d.GetOk(isShareMountTargetVPC); ok {
+		vpcStr := vpc.(string)
+		prototype.VPC = &vpcext.Reference{ID: &vpcStr}
+	}
+	if vnis := d.Get(isShareMountTargetVirtualNetworkInterface).([]interface{}); len(vnis) > 0 {
+		vniMap := vnis[0].(map[string]interface{})
+		subnet := vniMap[isShareMountTargetVNISubnet].(string)
+		vni := &vpcext.ShareMountTargetVirtualNetworkInterface{
+			Subnet:         &vpcext.Reference{ID: &subnet},
+			SecurityGroups: expandVPCExtReferences(vniMap[isShareMountTargetVNISecurityGroups].(*schema.Set)),
+		}
+		if name := vniMap[isShareMountTargetVNIName].(string); name != "" {
+			vni.Name = &name
+		}
+		prototype.VirtualNetworkInterface = vni
+	}
+	if transitEncryption, ok := d.GetOk(isShareMountTargetTransitEncryption); ok {
+		transitEncryptionStr := transitEncryption.(string)
+		prototype.TransitEncryption = &transitEncryptionStr
+	}
+
+	mountTarget, response, err := client.CreateShareMountTarget(context, shareID, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateShareMountTarget failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", shareID, *mountTarget.ID))
+	log.Printf("[INFO] Share mount target : %s", d.Id())
+
+	_, err = isWaitForShareMountTargetStable(context, client, shareID, *mountTarget.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISShareMountTargetRead(context, d, meta)
+}
+
+func resourceIBMISShareMountTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of shareID/mountTargetID", d.Id()))
+	}
+	shareID, mountTargetID := parts[0], parts[1]
+
+	mountTarget, response, err := client.GetShareMountTarget(context, shareID, mountTargetID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetShareMountTarget failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	d.Set(isShareMountTargetShare, shareID)
+	for k, v := range dataSourceIBMISShareMountTargetToMap(*mountTarget) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMISShareMountTargetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isShareMountTargetName) {
+		patch := map[string]interface{}{
+			isShareMountTargetName: d.Get(isShareMountTargetName).(string),
+		}
+		shareID := d.Get(isShareMountTargetShare).(string)
+		mountTargetID := d.Get(isShareMountTargetID).(string)
+		_, response, err := client.UpdateShareMountTarget(context, shareID, mountTargetID, patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateShareMountTarget failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	return resourceIBMISShareMountTargetRead(context, d, meta)
+}
+
+func resourceIBMISShareMountTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get(isShareMountTargetShare).(string)
+	mountTargetID := d.Get(isShareMountTargetID).(string)
+	response, err := client.DeleteShareMountTarget(context, shareID, mountTargetID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteShareMountTarget failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForShareMountTargetDeleted(context, client, shareID, mountTargetID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// dataSourceIBMISShareMountTargetToMap returns the attributes of a mount target common to the
+// ibm_is_share_mount_target resource and the ibm_is_share_mount_targets data source
+func dataSourceIBMISShareMountTargetToMap(mountTarget vpcext.ShareMountTarget) map[string]interface{} {
+	mountTargetMap := map[string]interface{}{}
+
+	mountTargetMap[isShareMountTargetID] = mountTarget.ID
+	mountTargetMap[isShareMountTargetName] = mountTarget.Name
+	if mountTarget.VPC != nil {
+		mountTargetMap[isShareMountTargetVPC] = mountTarget.VPC.ID
+	}
+	mountTargetMap[isShareMountTargetTransitEncryption] = mountTarget.TransitEncryption
+	mountTargetMap[isShareMountTargetMountPath] = mountTarget.MountPath
+	mountTargetMap[isShareMountTargetAccessControlMode] = mountTarget.AccessControlMode
+	if mountTarget.Subnet != nil {
+		mountTargetMap[isShareMountTargetSubnet] = mountTarget.Subnet.ID
+	}
+	if mountTarget.PrimaryIP != nil {
+		mountTargetMap[isShareMountTargetPrimaryIP] = mountTarget.PrimaryIP.Address
+	}
+	mountTargetMap[isShareMountTargetLifecycleState] = mountTarget.LifecycleState
+	mountTargetMap["created_at"] = mountTarget.CreatedAt
+	mountTargetMap["href"] = mountTarget.Href
+	mountTargetMap["resource_type"] = mountTarget.ResourceType
+
+	return mountTargetMap
+}
+
+func isWaitForShareMountTargetStable(ctx context.Context, client *vpcext.VpcExtV1, shareID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share mount target (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isShareLifecycleStatePending, isShareLifecycleStateUpdating},
+		Target:  []string{isShareLifecycleStateStable, isShareLifecycleStateFailed},
+		Refresh: func() (interface{}, string, error) {
+			mountTarget, response, err := client.GetShareMountTarget(ctx, shareID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Share Mount Target: %w", newAPIError(err, response))
+			}
+			if *mountTarget.LifecycleState == isShareLifecycleStateFailed {
+				return mountTarget, *mountTarget.LifecycleState, fmt.Errorf("Share mount target (%s) went into failed state during the operation", id)
+			}
+			return mountTarget, *mountTarget.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForShareMountTargetDeleted(ctx context.Context, client *vpcext.VpcExtV1, shareID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share mount target (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isShareLifecycleStateDeleting, isShareLifecycleStateStable},
+		Target:  []string{isShareDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			mountTarget, response, err := client.GetShareMountTarget(ctx, shareID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return mountTarget, isShareDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting Share Mount Target: %w", newAPIError(err, response))
+			}
+			if *mountTarget.LifecycleState == isShareLifecycleStateFailed {
+				return mountTarget, *mountTarget.LifecycleState, fmt.Errorf("Share mount target (%s) went into failed state during the deletion", id)
+			}
+			return mountTarget, isShareLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
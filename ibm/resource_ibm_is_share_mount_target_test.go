@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISShareMountTargetBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	shareName := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-mount-target-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-mount-target-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISShareMountTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareMountTargetConfig(vpcname, shareName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISShareMountTargetExists("ibm_is_share_mount_target.testacc_mount_target"),
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.testacc_mount_target", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.testacc_mount_target", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrPair("ibm_is_share_mount_target.testacc_mount_target", "vpc", "ibm_is_vpc.testacc_vpc", "id"),
+					resource.TestCheckResourceAttrSet("ibm_is_share_mount_target.testacc_mount_target", "mount_path"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareMountTargetConfig(vpcname, shareName, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share_mount_target.testacc_mount_target", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_share_mount_target.testacc_mount_target",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISShareMountTargetDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_share_mount_target" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetShareMountTarget(context.Background(), parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("Share mount target still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISShareMountTargetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetShareMountTarget(context.Background(), parts[0], parts[1])
+		return err
+	}
+}
+
+func testAccCheckIBMISShareMountTargetConfig(vpcname, shareName, name string) string {
+	return testAccCheckIBMISShareConfig(shareName, 200) + fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	resource "ibm_is_share_mount_target" "testacc_mount_target" {
+		share = ibm_is_share.testacc_share.id
+		vpc   = ibm_is_vpc.testacc_vpc.id
+		name  = "%s"
+	  }`, vpcname, name)
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isShareReplicaOperationsShareReplica   = "share_replica"
+	isShareReplicaOperationsFallbackPolicy = "fallback_policy"
+	isShareReplicaOperationsTimeout        = "timeout"
+	isShareReplicaOperationsSplitShare     = "split_share"
+
+	isShareReplicationRoleNone   = "none"
+	isShareReplicationRoleSource = "source"
+)
+
+// resourceIBMISShareReplicaOperations fails a replica share over to its source share, or splits it from its source
+// share, on creation. Destroying it only removes it from the state, as neither operation can be undone.
+func resourceIBMISShareReplicaOperations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISShareReplicaOperationsCreate,
+		ReadContext:   resourceIBMISShareReplicaOperationsRead,
+		DeleteContext: resourceIBMISShareReplicaOperationsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isShareReplicaOperationsShareReplica: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the replica share.",
+			},
+			isShareReplicaOperationsFallbackPolicy: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isShareReplicaOperationsFallbackPolicy, isShareReplicaOperationsSplitShare},
+				ValidateFunc: InvokeValidator("ibm_is_share_replica_operations", isShareReplicaOperationsFallbackPolicy),
+				Description:  "Fails the replica share over, with the action to take should the source share be unreachable: fail the failover, or split the replica share from its source share.",
+			},
+			isShareReplicaOperationsTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{isShareReplicaOperationsFallbackPolicy},
+				ValidateFunc: InvokeValidator("ibm_is_share_replica_operations", isShareReplicaOperationsTimeout),
+				Description:  "The number of seconds to wait for the source share to respond before applying the fallback policy.",
+			},
+			isShareReplicaOperationsSplitShare: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isShareReplicaOperationsFallbackPolicy, isShareReplicaOperationsSplitShare},
+				Description:  "Splits the replica share from its source share, making both of them standalone shares.",
+			},
+			isShareReplicationRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication role of the share after the operation.",
+			},
+		},
+	}
+}
+
+func resourceIBMISShareReplicaOperationsValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareReplicaOperationsFallbackPolicy,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", vpcext.ShareFailoverFallbackPolicyFail, vpcext.ShareFailoverFallbackPolicySplit),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isShareReplicaOperationsTimeout,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "300",
+			MaxValue:                   "3600",
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_share_replica_operations", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMISShareReplicaOperationsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get(isShareReplicaOperationsShareReplica).(string)
+	role := isShareReplicationRoleSource
+	if d.Get(isShareReplicaOperationsSplitShare).(bool) {
+		role = isShareReplicationRoleNone
+		response, err := client.DeleteShareSource(context, shareID)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteShareSource failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		fallbackPolicy := d.Get(isShareReplicaOperationsFallbackPolicy).(string)
+		prototype := &vpcext.ShareFailoverPrototype{
+			FallbackPolicy: &fallbackPolicy,
+		}
+		if timeout, ok := d.GetOk(isShareReplicaOperationsTimeout); ok {
+			timeoutInt := int64(timeout.(int))
+			prototype.Timeout = &timeoutInt
+		}
+		response, err := client.FailoverShare(context, shareID, prototype)
+		if err != nil {
+			log.Printf("[DEBUG] FailoverShare failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+	d.SetId(shareID)
+	log.Printf("[INFO] Share replica operation : %s", d.Id())
+
+	_, err = isWaitForShareReplicationRole(context, client, shareID, role, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISShareReplicaOperationsRead(context, d, meta)
+}
+
+func resourceIBMISShareReplicaOperationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	share, response, err := client.GetShare(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetShare failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.Set(isShareReplicationRole, share.ReplicationRole)
+
+	return nil
+}
+
+func resourceIBMISShareReplicaOperationsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// isWaitForShareReplicationRole waits for a failover, which makes the replica share the source share, or a split,
+// which makes it a standalone share, to complete. A failover falling back to a split ends in the none role as well.
+func isWaitForShareReplicationRole(ctx context.Context, client *vpcext.VpcExtV1, id, role string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for share (%s) to have the %s replication role.", id, role)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"replica", isShareLifecycleStateUpdating},
+		Target:  []string{role, isShareReplicationRoleNone},
+		Refresh: func() (interface{}, string, error) {
+			share, response, err := client.GetShare(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting Share: %w", newAPIError(err, response))
+			}
+			if *share.LifecycleState == isShareLifecycleStateFailed {
+				return share, *share.LifecycleState, fmt.Errorf("Share (%s) went into failed state during the operation", id)
+			}
+			if *share.LifecycleState != isShareLifecycleStateStable {
+				return share, isShareLifecycleStateUpdating, nil
+			}
+			return share, *share.ReplicationRole, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISShareBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-share-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareConfig(name, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISShareExists("ibm_is_share.testacc_share"),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share", "size", "200"),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share", "replication_role", "none"),
+					resource.TestCheckResourceAttrSet("ibm_is_share.testacc_share", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareConfig(nameUpdate, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share", "size", "300"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_share.testacc_share",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMISShareReplica(t *testing.T) {
+	name := fmt.Sprintf("tf-share-%d", acctest.RandIntRange(10, 100))
+	replicaName := fmt.Sprintf("tf-share-replica-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISShareReplicaConfig(name, replicaName, "0 */5 * * *"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISShareExists("ibm_is_share.testacc_share_replica"),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share_replica", "replication_role", "replica"),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share_replica", "replication_cron_spec", "0 */5 * * *"),
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share_replica", "size", "200"),
+					resource.TestCheckResourceAttrPair("ibm_is_share.testacc_share", "replica_share", "ibm_is_share.testacc_share_replica", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISShareReplicaConfig(name, replicaName, "0 */8 * * *"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.testacc_share_replica", "replication_cron_spec", "0 */8 * * *"),
+				),
+			},
+		},
+	})
+}
+
+func TestIBMISShareReplicaValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		raw   map[string]interface{}
+		valid bool
+	}{
+		{"share", map[string]interface{}{"size": 200}, true},
+		{"replica share", map[string]interface{}{"source_share": "r006-source", "replication_cron_spec": "0 */5 * * *"}, true},
+		{"share without size", map[string]interface{}{}, false},
+		{"share with replication cron spec", map[string]interface{}{"size": 200, "replication_cron_spec": "0 */5 * * *"}, false},
+		{"replica share without replication cron spec", map[string]interface{}{"source_share": "r006-source"}, false},
+		{"replica share with size", map[string]interface{}{"source_share": "r006-source", "replication_cron_spec": "0 */5 * * *", "size": 200}, false},
+	}
+	for _, c := range cases {
+		c.raw["profile"] = "tier-3iops"
+		c.raw["zone"] = "us-south-1"
+		diags := resourceIBMISShare().Validate(terraform.NewResourceConfigRaw(c.raw))
+		if diags.HasError() == c.valid {
+			t.Errorf("%s: expected valid %t, got %v", c.name, c.valid, diags)
+		}
+	}
+}
+
+func testAccCheckIBMISShareDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_share" {
+			continue
+		}
+		_, _, err := client.GetShare(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Share still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetShare(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIBMISShareConfig(name string, size int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_share" "testacc_share" {
+		name    = "%s"
+		profile = "tier-3iops"
+		zone    = "%s"
+		size    = %d
+	  }`, name, ISZoneName, size)
+}
+
+func testAccCheckIBMISShareReplicaConfig(name, replicaName, cronSpec string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_share" "testacc_share" {
+		name    = "%s"
+		profile = "tier-3iops"
+		zone    = "%s"
+		size    = 200
+	  }
+
+	resource "ibm_is_share" "testacc_share_replica" {
+		name                  = "%s"
+		profile               = "tier-3iops"
+		zone                  = "%s"
+		source_share          = ibm_is_share.testacc_share.id
+		replication_cron_spec = "%s"
+	  }`, name, ISZoneName, replicaName, ISZoneName, cronSpec)
+}
@@ -231,7 +231,7 @@ func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return nil, isSnapshotFailed, fmt.Errorf("Error getting Snapshot : %s\n%s", err, response)
+			return nil, isSnapshotFailed, fmt.Errorf("Error getting Snapshot : %w", newAPIError(err, response))
 		}
 
 		if *snapshot.LifecycleState == isSnapshotAvailable {
@@ -267,7 +267,7 @@ func snapshotGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Snapshot : %s\n%s", err, response)
+		return fmt.Errorf("Error getting Snapshot : %w", newAPIError(err, response))
 	}
 
 	d.SetId(*snapshot.ID)
@@ -328,12 +328,12 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 		}
 		snapshotPatch, err := snapshotPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for SnapshotPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for SnapshotPatch: %w", err)
 		}
 		updateSnapshotOptions.SnapshotPatch = snapshotPatch
 		_, response, err := sess.UpdateSnapshot(updateSnapshotOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Snapshot : %s\n%s", err, response)
+			return fmt.Errorf("Error updating Snapshot : %w", newAPIError(err, response))
 		}
 		_, err = isWaitForSnapshotUpdate(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -364,7 +364,7 @@ func isSnapshotUpdateRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 		}
 		snapshot, response, err := sess.GetSnapshot(getSnapshotOptions)
 		if err != nil {
-			return nil, isSnapshotFailed, fmt.Errorf("Error getting Snapshot : %s\n%s", err, response)
+			return nil, isSnapshotFailed, fmt.Errorf("Error getting Snapshot : %w", newAPIError(err, response))
 		}
 
 		if *snapshot.LifecycleState == isSnapshotAvailable || *snapshot.LifecycleState == isSnapshotFailed {
@@ -402,7 +402,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting Snapshot (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting Snapshot (%s): %w", id, newAPIError(err, response))
 	}
 
 	deleteSnapshotOptions := &vpcv1.DeleteSnapshotOptions{
@@ -410,7 +410,7 @@ func snapshotDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSnapshot(deleteSnapshotOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting Snapshot : %s\n%s", err, response)
+		return fmt.Errorf("Error deleting Snapshot : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForSnapshotDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -446,7 +446,7 @@ func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRef
 			if response != nil && response.StatusCode == 404 {
 				return snapshot, isSnapshotDeleted, nil
 			}
-			return nil, isSnapshotFailed, fmt.Errorf("The Snapshot %s failed to delete: %s\n%s", id, err, response)
+			return nil, isSnapshotFailed, fmt.Errorf("The Snapshot %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return snapshot, *snapshot.LifecycleState, nil
 	}
@@ -471,7 +471,7 @@ func snapshotExists(d *schema.ResourceData, meta interface{}, id string) (bool,
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Snapshot: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Snapshot: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshotCopySourceSnapshotCRN = "source_snapshot_crn"
+)
+
+// resourceIBMISSnapshotCopy copies a snapshot of another region into the region of the provider, so that a volume can
+// be restored from it should the region of the source snapshot fail
+func resourceIBMISSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSnapshotCopyCreate,
+		ReadContext:   resourceIBMISSnapshotCopyRead,
+		UpdateContext: resourceIBMISSnapshotCopyUpdate,
+		DeleteContext: resourceIBMISSnapshotCopyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotCopySourceSnapshotCRN: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the snapshot to copy, in another region.",
+			},
+			isSnapshotName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_snapshot", isSnapshotName),
+				Description:  "Snapshot name",
+			},
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Resource group info",
+			},
+			isSnapshotEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The CRN of the root key of a key management service of the region to encrypt the copy with. If unspecified, the copy is encrypted with IBM-managed keys.",
+			},
+			isSnapshotSourceVolume: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The volume the source snapshot was taken of",
+			},
+			isSnapshotSourceImage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If present, the image id from which the data on this volume was most directly provisioned.",
+			},
+			isSnapshotOperatingSystem: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The globally unique name for the operating system included in this image",
+			},
+			isSnapshotBootable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if a boot volume attachment can be created with a volume created from this snapshot",
+			},
+			isSnapshotLCState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Snapshot lifecycle state",
+			},
+			isSnapshotCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the resource",
+			},
+			isSnapshotEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Encryption type of the snapshot",
+			},
+			isSnapshotHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL for the snapshot",
+			},
+			isSnapshotMinCapacity: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum capacity of the snapshot",
+			},
+			isSnapshotResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the snapshot",
+			},
+			isSnapshotSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the snapshot",
+			},
+		},
+	}
+}
+
+func resourceIBMISSnapshotCopyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceSnapshotCRN := d.Get(isSnapshotCopySourceSnapshotCRN).(string)
+	prototype := &vpcext.SnapshotCopyPrototype{
+		SourceSnapshot: &vpcext.Reference{CRN: &sourceSnapshotCRN},
+	}
+	if name, ok := d.GetOk(isSnapshotName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if resourceGroup, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		prototype.ResourceGroup = &vpcext.Reference{ID: &resourceGroupStr}
+	}
+	if encryptionKey, ok := d.GetOk(isSnapshotEncryptionKey); ok {
+		encryptionKeyStr := encryptionKey.(string)
+		prototype.EncryptionKey = &vpcext.Reference{CRN: &encryptionKeyStr}
+	}
+
+	snapshot, response, err := client.CreateSnapshotCopy(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSnapshotCopy failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(*snapshot.ID)
+	log.Printf("[INFO] Snapshot copy : %s", d.Id())
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForSnapshotAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISSnapshotCopyRead(context, d, meta)
+}
+
+func resourceIBMISSnapshotCopyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := snapshotGet(d, meta, d.Id())
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	snapshot, response, err := client.GetSnapshot(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] GetSnapshot failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	if snapshot.SourceSnapshot != nil {
+		d.Set(isSnapshotCopySourceSnapshotCRN, snapshot.SourceSnapshot.CRN)
+	}
+
+	return nil
+}
+
+func resourceIBMISSnapshotCopyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isSnapshotName) {
+		err := snapshotUpdate(d, meta, d.Id(), d.Get(isSnapshotName).(string), true)
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+	return resourceIBMISSnapshotCopyRead(context, d, meta)
+}
+
+func resourceIBMISSnapshotCopyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := snapshotDelete(d, meta, d.Id())
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSnapshotCopyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-snapshot-copy-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-snapshot-copy-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotCopyConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISSnapshotCopyExists("ibm_is_snapshot_copy.testacc_snapshot_copy"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "source_snapshot_crn", isSnapshotCopySourceCRN),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_copy.testacc_snapshot_copy", "source_volume"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSnapshotCopyConfig(nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_snapshot_copy.testacc_snapshot_copy", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_snapshot_copy.testacc_snapshot_copy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotCopyDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_snapshot_copy" {
+			continue
+		}
+		_, _, err := client.GetSnapshot(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Snapshot copy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSnapshotCopyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		snapshot, _, err := client.GetSnapshot(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if snapshot.SourceSnapshot == nil || *snapshot.SourceSnapshot.CRN != isSnapshotCopySourceCRN {
+			return fmt.Errorf("Snapshot %s is not a copy of %s", rs.Primary.ID, isSnapshotCopySourceCRN)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISSnapshotCopyConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_snapshot_copy" "testacc_snapshot_copy" {
+		name                = "%s"
+		source_snapshot_crn = "%s"
+	  }`, name, isSnapshotCopySourceCRN)
+}
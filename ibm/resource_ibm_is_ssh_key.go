@@ -186,7 +186,7 @@ func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create SSH Key %w", newAPIError(err, response))
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
@@ -222,7 +222,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 
 	key, response, err := sess.CreateKey(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create SSH Key %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create SSH Key %w", newAPIError(err, response))
 	}
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
@@ -274,7 +274,7 @@ func classicKeyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting SSH Key (%s): %w", id, newAPIError(err, response))
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -315,7 +315,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting SSH Key (%s): %w", id, newAPIError(err, response))
 	}
 	d.Set(isKeyName, *key.Name)
 	d.Set(isKeyPublicKey, *key.PublicKey)
@@ -382,7 +382,7 @@ func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
+			return fmt.Errorf("Error getting SSH Key : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
@@ -400,12 +400,12 @@ func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		keyPatch, err := keyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for KeyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for KeyPatch: %w", err)
 		}
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc SSH Key: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc SSH Key: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -422,7 +422,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		key, response, err := sess.GetKey(options)
 		if err != nil {
-			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
+			return fmt.Errorf("Error getting SSH Key : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isKeyTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
@@ -440,12 +440,12 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		keyPatch, err := keyPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for KeyPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for KeyPatch: %w", err)
 		}
 		options.KeyPatch = keyPatch
 		_, response, err := sess.UpdateKey(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc SSH Key: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc SSH Key: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -485,7 +485,7 @@ func classicKeyDelete(d *schema.ResourceData, meta interface{}, id string) error
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting SSH Key (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcclassicv1.DeleteKeyOptions{
@@ -493,7 +493,7 @@ func classicKeyDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting SSH Key : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting SSH Key : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -513,7 +513,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting SSH Key (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting SSH Key (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcv1.DeleteKeyOptions{
@@ -521,7 +521,7 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteKey(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting SSH Key : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting SSH Key : %w", newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -556,7 +556,7 @@ func classicKeyExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting SSH Key: %w", newAPIError(err, response))
 	}
 
 	return true, nil
@@ -575,7 +575,7 @@ func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting SSH Key: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -341,7 +341,7 @@ func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zo
 	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("Error while creating Subnet %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Subnet %w", newAPIError(err, response))
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
@@ -413,7 +413,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	subnet, response, err := sess.CreateSubnet(createSubnetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Subnet err %s\n%s", err, response)
-		return fmt.Errorf("Error while creating Subnet %s\n%s", err, response)
+		return fmt.Errorf("Error while creating Subnet %w", newAPIError(err, response))
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
@@ -455,7 +455,7 @@ func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) r
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Subnet : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error getting Subnet : %w", newAPIError(err, response))
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -488,7 +488,7 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 		}
 		subnet, response, err := subnetC.GetSubnet(getSubnetOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error getting Subnet : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error getting Subnet : %w", newAPIError(err, response))
 		}
 
 		if *subnet.Status == "available" || *subnet.Status == "failed" {
@@ -532,7 +532,7 @@ func classicSubnetGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Subnet (%s): %w", id, newAPIError(err, response))
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIpv4CidrBlock, *subnet.Ipv4CIDRBlock)
@@ -583,7 +583,7 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Subnet (%s): %w", id, newAPIError(err, response))
 	}
 	d.Set(isSubnetName, *subnet.Name)
 	d.Set(isSubnetIPVersion, *subnet.IPVersion)
@@ -691,7 +691,7 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %w", newAPIError(err, response))
 			}
 			_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -706,7 +706,7 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return fmt.Errorf("Error Attaching public gateway to the subnet : %w", newAPIError(err, response))
 			}
 			_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -717,13 +717,13 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 	if hasChanged {
 		subnetPatch, err := subnetPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for SubnetPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for SubnetPatch: %w", err)
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Subnet : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Subnet : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -759,7 +759,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			response, err := sess.UnsetSubnetPublicGateway(unsetSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
+				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %w", newAPIError(err, response))
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -774,7 +774,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			_, response, err := sess.SetSubnetPublicGateway(setSubnetPublicGatewayOptions)
 			if err != nil {
-				return fmt.Errorf("Error Attaching public gateway to the subnet : %s\n%s", err, response)
+				return fmt.Errorf("Error Attaching public gateway to the subnet : %w", newAPIError(err, response))
 			}
 			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
@@ -804,13 +804,13 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		subnetPatch, err := subnetPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for SubnetPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for SubnetPatch: %w", err)
 		}
 		updateSubnetOptions.SubnetPatch = subnetPatch
 		updateSubnetOptions.ID = &id
 		_, response, err := sess.UpdateSubnet(updateSubnetOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating Subnet : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating Subnet : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -851,7 +851,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Subnet (%s): %w", id, newAPIError(err, response))
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcclassicv1.UnsetSubnetPublicGatewayOptions{
@@ -871,7 +871,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	}
 	response, err = sess.DeleteSubnet(deleteSubnetOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Subnet : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -894,7 +894,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Subnet (%s): %w", id, newAPIError(err, response))
 	}
 	if subnet.PublicGateway != nil {
 		unsetSubnetPublicGatewayOptions := &vpcv1.UnsetSubnetPublicGatewayOptions{
@@ -914,7 +914,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteSubnet(deleteSubnetOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Subnet : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -950,7 +950,7 @@ func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id str
 			if response != nil && response.StatusCode == 404 {
 				return subnet, isSubnetDeleted, nil
 			}
-			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return subnet, isSubnetDeleting, err
 	}
@@ -985,7 +985,7 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 			if response != nil && strings.Contains(err.Error(), "please detach all network interfaces from subnet before deleting it") {
 				return subnet, isSubnetDeleting, nil
 			}
-			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %s\n%s", id, err, response)
+			return subnet, "", fmt.Errorf("The Subnet %s failed to delete: %w", id, newAPIError(err, response))
 		}
 		return subnet, isSubnetDeleting, err
 	}
@@ -1019,7 +1019,7 @@ func classicSubnetExists(d *schema.ResourceData, meta interface{}, id string) (b
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Subnet: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -1037,7 +1037,7 @@ func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, er
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Subnet: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
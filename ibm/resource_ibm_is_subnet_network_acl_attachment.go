@@ -209,7 +209,7 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-		return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+		return fmt.Errorf("Error while attaching a network ACL to a subnet %w", newAPIError(err, response))
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Network ACL : %s", *resultACL.ID)
@@ -235,7 +235,7 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response)
+		return fmt.Errorf("Error getting subnet's (%s) attached network ACL: %w", id, newAPIError(err, response))
 	}
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
@@ -348,7 +348,7 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return fmt.Errorf("Error while attaching a network ACL to a subnet %w", newAPIError(err, response))
 		}
 		log.Printf("[INFO] Updated subnet %s with Network ACL : %s", subnet, *resultACL.ID)
 
@@ -375,7 +375,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Subnet (%s): %w", id, newAPIError(err, response))
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -389,7 +389,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 	}
 
 	// Fetch default network ACL
@@ -407,7 +407,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return fmt.Errorf("Error while attaching a network ACL to a subnet %w", newAPIError(err, response))
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Network ACL : %s", id, *resultACL.ID)
 	} else {
@@ -432,7 +432,7 @@ func resourceIBMISSubnetNetworkACLAttachmentExists(d *schema.ResourceData, meta
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting subnet's attached network ACL: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting subnet's attached network ACL: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
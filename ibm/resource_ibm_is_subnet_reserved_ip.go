@@ -165,7 +165,7 @@ func resourceIBMISReservedIPRead(d *schema.ResourceData, meta interface{}) error
 
 	allIDs, err := idParts(d.Id())
 	if err != nil {
-		return fmt.Errorf("The ID can not be split into subnet ID and reserved IP ID. %s", err)
+		return fmt.Errorf("The ID can not be split into subnet ID and reserved IP ID. %w", err)
 	}
 	subnetID := allIDs[0]
 
@@ -227,14 +227,14 @@ func resourceIBMISReservedIPUpdate(d *schema.ResourceData, meta interface{}) err
 
 		reservedIPPatch, err := patch.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error updating the reserved IP %s", err)
+			return fmt.Errorf("Error updating the reserved IP %w", err)
 		}
 
 		options.ReservedIPPatch = reservedIPPatch
 
 		_, response, err := sess.UpdateSubnetReservedIP(options)
 		if err != nil {
-			return fmt.Errorf("Error updating the reserved IP %s\n%s", err, response)
+			return fmt.Errorf("Error updating the reserved IP %w", newAPIError(err, response))
 		}
 	}
 	return resourceIBMISReservedIPRead(d, meta)
@@ -297,7 +297,7 @@ func get(d *schema.ResourceData, meta interface{}) (*vpcv1.ReservedIP, error) {
 			d.SetId("")
 			return nil, nil
 		}
-		return nil, fmt.Errorf("Error Getting Reserved IP : %s\n%s", err, response)
+		return nil, fmt.Errorf("Error Getting Reserved IP : %w", newAPIError(err, response))
 	}
 	return rip, nil
 }
@@ -15,6 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_is_subnet", &resource.Sweeper{
+		Name:         "ibm_is_subnet",
+		Dependencies: []string{"ibm_is_instance"},
+		F:            testSweepISSubnets,
+	})
+}
+
 func TestAccIBMISSubnet_basic(t *testing.T) {
 	var subnet string
 	vpcname := fmt.Sprintf("tfsubnet-vpc-%d", acctest.RandIntRange(10, 100))
@@ -175,3 +183,41 @@ func testAccCheckIBMISSubnetConfigUpdate(vpcname, name, zone, cidr, gwname strin
 		tags = ["tag1"]
 	}`, vpcname, gwname, zone, name, zone, cidr)
 }
+
+func testSweepISSubnets(region string) error {
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	start := ""
+	allrecs := []vpcv1.Subnet{}
+	for {
+		options := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		subnets, response, err := sess.ListSubnets(options)
+		if err != nil {
+			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+		}
+		start = GetNext(subnets.Next)
+		allrecs = append(allrecs, subnets.Subnets...)
+		if start == "" {
+			break
+		}
+	}
+	var errs []error
+	for _, subnet := range allrecs {
+		if !isSweepable(*subnet.Name) {
+			continue
+		}
+		if err := sweepResource(resourceIBMISSubnet(), "ibm_is_subnet", *subnet.ID, *subnet.Name, nil, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return sweepErrors("ibm_is_subnet", errs)
+}
@@ -250,7 +250,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	result, response, err := sess.CreateEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 
 	d.SetId(*result.ID)
@@ -283,7 +283,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		_, response, err := sess.UpdateEndpointGateway(opt)
 		if err != nil {
 			log.Printf("Update Endpoint Gateway failed: %v", response)
-			return err
+			return newAPIError(err, response)
 		}
 
 	}
@@ -291,7 +291,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
-			return fmt.Errorf("Error getting VPE: %s\n%s", err, response)
+			return fmt.Errorf("Error getting VPE: %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
@@ -313,7 +313,7 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 	result, response, err := sess.GetEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Get Endpoint Gateway failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(isVirtualEndpointGatewayName, result.Name)
 	d.Set(isVirtualEndpointGatewayHealthState, result.HealthState)
@@ -362,7 +362,7 @@ func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta inte
 			return false, nil
 		}
 		log.Printf("Error : %s", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	return true, nil
 }
@@ -117,7 +117,7 @@ func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta in
 	_, response, err := sess.AddEndpointGatewayIP(opt)
 	if err != nil {
 		log.Printf("Add Endpoint Gateway failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, ipID))
 	return resourceIBMisVirtualEndpointGatewayIPRead(d, meta)
@@ -138,7 +138,7 @@ func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta inte
 	result, response, err := sess.GetEndpointGatewayIP(opt)
 	if err != nil {
 		log.Printf("Get Endpoint Gateway IP failed: %v", response)
-		return err
+		return newAPIError(err, response)
 	}
 	d.Set(isVirtualEndpointGatewayIPID, result.ID)
 	d.Set(isVirtualEndpointGatewayIPName, result.Name)
@@ -195,7 +195,7 @@ func resourceIBMisVirtualEndpointGatewayIPExists(d *schema.ResourceData, meta in
 			return false, nil
 		}
 		log.Printf("Error : %s", response)
-		return false, err
+		return false, newAPIError(err, response)
 	}
 	return true, nil
 }
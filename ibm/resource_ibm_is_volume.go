@@ -50,6 +50,7 @@ func resourceIBMISVolume() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -57,6 +58,9 @@ func resourceIBMISVolume() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return resourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceVolumeCapacityCustomizeDiff(diff, isVolumeCapacity)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +75,6 @@ func resourceIBMISVolume() *schema.Resource {
 			isVolumeProfileName: {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Volume profile name",
 			},
 
@@ -94,7 +97,6 @@ func resourceIBMISVolume() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     100,
-				ForceNew:    true,
 				Description: "Vloume capacity value",
 			},
 			isVolumeResourceGroup: {
@@ -108,7 +110,6 @@ func resourceIBMISVolume() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
 				Description: "IOPS value for the Volume",
 			},
 			isVolumeCrn: {
@@ -281,7 +282,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create volume err %w", newAPIError(err, response))
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
@@ -325,7 +326,7 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Volume (%s): %w", id, newAPIError(err, response))
 	}
 	d.SetId(*vol.ID)
 	d.Set(isVolumeName, *vol.Name)
@@ -413,7 +414,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vol, response, err := sess.GetVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Volume : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isVolumeTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
@@ -422,6 +423,24 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 				"Error on update of resource vpc volume (%s) tags: %s", id, err)
 		}
 	}
+	if d.HasChange(isVolumeCapacity) || d.HasChange(isVolumeIops) || d.HasChange(isVolumeProfileName) {
+		volumePatch := map[string]interface{}{}
+		if d.HasChange(isVolumeCapacity) {
+			volumePatch["capacity"] = int64(d.Get(isVolumeCapacity).(int))
+		}
+		if d.HasChange(isVolumeIops) {
+			volumePatch["iops"] = int64(d.Get(isVolumeIops).(int))
+		}
+		if d.HasChange(isVolumeProfileName) {
+			volumePatch["profile"] = map[string]interface{}{
+				"name": d.Get(isVolumeProfileName).(string),
+			}
+		}
+		err = volUpdateCapacity(sess, id, volumePatch, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
 	if hasChanged {
 		options := &vpcv1.UpdateVolumeOptions{
 			ID: &id,
@@ -431,17 +450,48 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		volumePatch, err := volumePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VolumePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VolumePatch: %w", err)
 		}
 		options.VolumePatch = volumePatch
 		_, response, err := sess.UpdateVolume(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc volume: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc volume: %w", newAPIError(err, response))
 		}
 	}
 	return nil
 }
 
+// volUpdateCapacity expands a volume or changes its IOPS or its profile, attached volumes are updated online.
+// The VolumePatch of the SDK only has the name, so the patch is passed as a map.
+func volUpdateCapacity(sess *vpcv1.VpcV1, id string, volumePatch map[string]interface{}, timeout time.Duration) error {
+	_, err := isWaitForVolumeAvailable(sess, id, timeout)
+	if err != nil {
+		return err
+	}
+	options := &vpcv1.UpdateVolumeOptions{
+		ID:          &id,
+		VolumePatch: volumePatch,
+	}
+	_, response, err := sess.UpdateVolume(options)
+	if err != nil {
+		return fmt.Errorf("Error updating the capacity, IOPS or profile of vpc volume (%s): %w", id, newAPIError(err, response))
+	}
+	_, err = isWaitForVolumeAvailable(sess, id, timeout)
+	return err
+}
+
+// resourceVolumeCapacityCustomizeDiff rejects a smaller capacity during plan, volumes can only be expanded
+func resourceVolumeCapacityCustomizeDiff(diff *schema.ResourceDiff, key string) error {
+	if diff.Id() == "" || !diff.HasChange(key) {
+		return nil
+	}
+	o, n := diff.GetChange(key)
+	if n.(int) != 0 && n.(int) < o.(int) {
+		return fmt.Errorf("The capacity of a volume cannot be reduced: %s can only be increased from %d GB, got %d GB", key, o.(int), n.(int))
+	}
+	return nil
+}
+
 func resourceIBMISVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 
@@ -466,7 +516,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Volume (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Volume (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcv1.DeleteVolumeOptions{
@@ -474,9 +524,9 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVolume(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Volume : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Volume : %w", newAPIError(err, response))
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(context.Background(), sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -510,38 +560,38 @@ func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string)
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
 			}
-			return vol, "", fmt.Errorf("Error Getting Volume: %s\n%s", err, response)
+			return vol, "", fmt.Errorf("Error Getting Volume: %w", newAPIError(err, response))
 		}
 		return vol, isVolumeDeleting, err
 	}
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(ctx context.Context, vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isVolumeDeleting},
 		Target:     []string{"done", ""},
-		Refresh:    isVolumeDeleteRefreshFunc(vol, id),
+		Refresh:    isVolumeDeleteRefreshFunc(ctx, vol, id),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+func isVolumeDeleteRefreshFunc(ctx context.Context, vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		volgetoptions := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
-		vol, response, err := vol.GetVolume(volgetoptions)
+		vol, response, err := vol.GetVolumeWithContext(ctx, volgetoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
 				return vol, isVolumeDeleted, nil
 			}
-			return vol, "", fmt.Errorf("Error Getting Volume: %s\n%s", err, response)
+			return vol, "", fmt.Errorf("Error Getting Volume: %w", newAPIError(err, response))
 		}
 		return vol, isVolumeDeleting, err
 	}
@@ -568,7 +618,7 @@ func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Volume: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -595,7 +645,7 @@ func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFu
 		}
 		vol, response, err := client.GetVolume(volgetoptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting volume: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting volume: %w", newAPIError(err, response))
 		}
 
 		if *vol.Status == "available" {
@@ -611,7 +661,7 @@ func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
 	delete_all_snapshots.SourceVolumeID = &id
 	response, err := sess.DeleteSnapshots(delete_all_snapshots)
 	if err != nil {
-		return fmt.Errorf("Error deleting snapshots from volume %s\n%s", err, response)
+		return fmt.Errorf("Error deleting snapshots from volume %w", newAPIError(err, response))
 	}
 	return nil
 }
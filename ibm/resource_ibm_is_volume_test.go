@@ -6,6 +6,7 @@ package ibm
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -45,6 +46,51 @@ func TestAccIBMISVolume_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVolume_expand(t *testing.T) {
+	var volumeID string
+	name := fmt.Sprintf("tf-vol-exp-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVolumeCapacityConfig(name, "10iops-tier", 100),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						volumeID = s.RootModule().Resources["ibm_is_volume.storage"].Primary.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "capacity", "100"),
+				),
+			},
+			{
+				// The volume is expanded and its profile changed in place
+				Config: testAccCheckIBMISVolumeCapacityConfig(name, "5iops-tier", 200),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["ibm_is_volume.storage"].Primary.ID; id != volumeID {
+							return fmt.Errorf("expected volume %s to be updated in place, got %s", volumeID, id)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "capacity", "200"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "profile", "5iops-tier"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMISVolumeCapacityConfig(name, "5iops-tier", 150),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("capacity of a volume cannot be reduced"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVolumeDestroy(s *terraform.State) error {
 
 	sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
@@ -101,3 +147,14 @@ func testAccCheckIBMISVolumeConfig(name string) string {
 }`, name)
 
 }
+
+func testAccCheckIBMISVolumeCapacityConfig(name, profile string, capacity int) string {
+	return fmt.Sprintf(
+		`resource "ibm_is_volume" "storage"{
+    name = "%s"
+    profile = "%s"
+    zone = "us-south-3"
+    capacity = %d
+}`, name, profile, capacity)
+
+}
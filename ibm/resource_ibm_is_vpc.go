@@ -474,7 +474,7 @@ func classicVpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg st
 
 	vpc, response, err := sess.CreateVPC(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC err %s\n%s", err, response)
+		return fmt.Errorf("Error while creating VPC err %w", newAPIError(err, response))
 	}
 	d.SetId(*vpc.ID)
 	log.Printf("[INFO] VPC : %s", *vpc.ID)
@@ -516,7 +516,7 @@ func isClassicVPCRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -602,7 +602,7 @@ func isVPCRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 		}
 		vpc, response, err := vpc.GetVPC(getvpcOptions)
 		if err != nil {
-			return nil, isVPCFailed, fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return nil, isVPCFailed, fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 		}
 
 		if *vpc.Status == isVPCAvailable || *vpc.Status == isVPCFailed {
@@ -647,7 +647,7 @@ func classicVpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -707,7 +707,7 @@ func classicVpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+			return fmt.Errorf("Error Fetching subnets %w", newAPIError(err, response))
 		}
 		start = GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -867,7 +867,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 	}
 
 	d.Set(isVPCName, *vpc.Name)
@@ -933,7 +933,7 @@ func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 		s, response, err := sess.ListSubnets(options)
 		if err != nil {
-			return fmt.Errorf("Error Fetching subnets %s\n%s", err, response)
+			return fmt.Errorf("Error Fetching subnets %w", newAPIError(err, response))
 		}
 		start = GetNext(s.Next)
 		allrecs = append(allrecs, s.Subnets...)
@@ -1116,7 +1116,7 @@ func classicVpcUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
@@ -1134,12 +1134,12 @@ func classicVpcUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		}
 		vpcPatch, err := vpcPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPCPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VPCPatch: %w", err)
 		}
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating VPC : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -1156,7 +1156,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vpc, response, err := sess.GetVPC(getvpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+			return fmt.Errorf("Error getting VPC : %w", newAPIError(err, response))
 		}
 		oldList, newList := d.GetChange(isVPCTags)
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpc.CRN)
@@ -1191,12 +1191,12 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		}
 		vpcPatch, err := vpcPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPCPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VPCPatch: %w", err)
 		}
 		updateVpcOptions.VPCPatch = vpcPatch
 		_, response, err := sess.UpdateVPC(updateVpcOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC : %s\n%s", err, response)
+			return fmt.Errorf("Error Updating VPC : %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -1239,7 +1239,7 @@ func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error
 			return nil
 		}
 
-		return fmt.Errorf("Error Getting VPC (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting VPC (%s): %w", id, newAPIError(err, response))
 
 	}
 
@@ -1248,7 +1248,7 @@ func classicVpcDelete(d *schema.ResourceData, meta interface{}, id string) error
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting VPC : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1273,7 +1273,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting VPC (%s): %w", id, newAPIError(err, response))
 	}
 
 	deletevpcOptions := &vpcv1.DeleteVPCOptions{
@@ -1281,7 +1281,7 @@ func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPC(deletevpcOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting VPC : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForVPCDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -1317,7 +1317,7 @@ func isClassicVPCDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) re
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %w", id, newAPIError(err, response))
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -1350,7 +1350,7 @@ func isVPCDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFu
 			if response != nil && response.StatusCode == 404 {
 				return vpc, isVPCDeleted, nil
 			}
-			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %s\n%s", id, err, response)
+			return nil, isVPCFailed, fmt.Errorf("The VPC %s failed to delete: %w", id, newAPIError(err, response))
 		}
 
 		return vpc, isVPCDeleting, nil
@@ -1385,7 +1385,7 @@ func classicVpcExists(d *schema.ResourceData, meta interface{}, id string) (bool
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting VPC: %w", newAPIError(err, response))
 	}
 
 	return true, nil
@@ -1404,7 +1404,7 @@ func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting VPC: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -1425,12 +1425,12 @@ func nwaclNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	}
 	networkACLPatch, err := networkACLPatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %s", err)
+		return fmt.Errorf("Error calling asPatch for NetworkACLPatch: %w", err)
 	}
 	updateNetworkACLOptions.NetworkACLPatch = networkACLPatch
 	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Network ACL(%s) name : %s\n%s", id, err, response)
+		return fmt.Errorf("Error Updating Network ACL(%s) name : %w", id, newAPIError(err, response))
 	}
 	return nil
 }
@@ -1444,12 +1444,12 @@ func sgNameUpdate(sess *vpcv1.VpcV1, id, name string) error {
 	}
 	securityGroupPatch, err := securityGroupPatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("Error calling asPatch for SecurityGroupPatch: %s", err)
+		return fmt.Errorf("Error calling asPatch for SecurityGroupPatch: %w", err)
 	}
 	updateSecurityGroupOptions.SecurityGroupPatch = securityGroupPatch
 	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Security Group name : %s\n%s", err, response)
+		return fmt.Errorf("Error Updating Security Group name : %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -1467,7 +1467,7 @@ func rtNameUpdate(sess *vpcv1.VpcV1, vpcID, id, name string) error {
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Routing table name %s\n%s", err, response)
+		return fmt.Errorf("Error Updating Routing table name %w", newAPIError(err, response))
 	}
 	return nil
 }
@@ -159,7 +159,7 @@ func classicVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, nam
 	}
 	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Address Prefix %s\n%s", err, response)
+		return fmt.Errorf("Error while creating VPC Address Prefix %w", newAPIError(err, response))
 	}
 
 	addrPrefixID := *addrPrefix.ID
@@ -184,7 +184,7 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	}
 	addrPrefix, response, err := sess.CreateVPCAddressPrefix(options)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Address Prefix %s\n%s", err, response)
+		return fmt.Errorf("Error while creating VPC Address Prefix %w", newAPIError(err, response))
 	}
 
 	addrPrefixID := *addrPrefix.ID
@@ -234,7 +234,7 @@ func classicVpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID,
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %w", addrPrefixID, newAPIError(err, response))
 	}
 	d.Set(isVPCAddressPrefixVPCID, vpcID)
 	d.Set(isVPCAddressPrefixPrefixName, *addrPrefix.Name)
@@ -248,7 +248,7 @@ func classicVpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID,
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPC : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 
@@ -270,7 +270,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %w", addrPrefixID, newAPIError(err, response))
 	}
 	d.Set(isVPCAddressPrefixVPCID, vpcID)
 	d.Set(isVPCAddressPrefixDefault, *addrPrefix.IsDefault)
@@ -285,7 +285,7 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPC : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 
@@ -352,12 +352,12 @@ func classicVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpc
 		}
 		addressPrefixPatch, err := addressPrefixPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for AddressPrefixPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for AddressPrefixPatch: %w", err)
 		}
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
 		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Address Prefix: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating VPC Address Prefix: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -383,12 +383,12 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 		}
 		addressPrefixPatch, err := addressPrefixPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for AddressPrefixPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for AddressPrefixPatch: %w", err)
 		}
 		updatevpcAddressPrefixoptions.AddressPrefixPatch = addressPrefixPatch
 		_, response, err := sess.UpdateVPCAddressPrefix(updatevpcAddressPrefixoptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Address Prefix: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating VPC Address Prefix: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -442,7 +442,7 @@ func classicVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpc
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %w", addrPrefixID, newAPIError(err, response))
 	}
 	deletevpcAddressPrefixOptions := &vpcclassicv1.DeleteVPCAddressPrefixOptions{
 		VPCID: &vpcID,
@@ -453,7 +453,7 @@ func classicVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpc
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %w", addrPrefixID, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -474,7 +474,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return fmt.Errorf("Error Getting VPC Address Prefix (%s): %w", addrPrefixID, newAPIError(err, response))
 	}
 
 	deletevpcAddressPrefixOptions := &vpcv1.DeleteVPCAddressPrefixOptions{
@@ -486,7 +486,7 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %s\n%s", addrPrefixID, err, response)
+		return fmt.Errorf("Error Deleting VPC Address Prefix (%s): %w", addrPrefixID, newAPIError(err, response))
 	}
 	d.SetId("")
 	return nil
@@ -530,7 +530,7 @@ func classicVpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpc
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting VPC Address Prefix: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -549,7 +549,7 @@ func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, add
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting VPC Address Prefix: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -163,7 +163,7 @@ func classicVpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName,
 	}
 	route, response, err := sess.CreateVPCRoute(createRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Route %s\n%s", err, response)
+		return fmt.Errorf("Error while creating VPC Route %w", newAPIError(err, response))
 	}
 	routeID := *route.ID
 
@@ -194,7 +194,7 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 	}
 	route, response, err := sess.CreateVPCRoute(createRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error while creating VPC Route err %s\n%s", err, response)
+		return fmt.Errorf("Error while creating VPC Route err %w", newAPIError(err, response))
 	}
 	routeID := *route.ID
 
@@ -219,7 +219,7 @@ func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.Reso
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				return route, "", fmt.Errorf("Error Getting VPC Route: %s\n%s", err, response)
+				return route, "", fmt.Errorf("Error Getting VPC Route: %w", newAPIError(err, response))
 			}
 
 			if *route.LifecycleState == "stable" || *route.LifecycleState == "failed" {
@@ -247,7 +247,7 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 			}
 			route, response, err := sess.GetVPCRoute(getVpcRouteOptions)
 			if err != nil {
-				return route, "", fmt.Errorf("Error Getting VPC Route: %s\n%s", err, response)
+				return route, "", fmt.Errorf("Error Getting VPC Route: %w", newAPIError(err, response))
 			}
 
 			if *route.LifecycleState == "stable" || *route.LifecycleState == "failed" {
@@ -304,7 +304,7 @@ func classicVpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return fmt.Errorf("Error Getting VPC Route (%s): %w", routeID, newAPIError(err, response))
 	}
 	d.Set(isVPCRouteVPCID, vpcID)
 	d.Set(isVPCRouteName, route.Name)
@@ -320,7 +320,7 @@ func classicVpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPC : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 	return nil
@@ -341,7 +341,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return fmt.Errorf("Error Getting VPC Route (%s): %w", routeID, newAPIError(err, response))
 	}
 	d.Set(isVPCRouteVPCID, vpcID)
 	d.Set(isVPCRouteName, route.Name)
@@ -357,7 +357,7 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	}
 	vpc, response, err := sess.GetVPC(getVPCOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPC : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPC : %w", newAPIError(err, response))
 	}
 	d.Set(RelatedCRN, *vpc.CRN)
 
@@ -415,12 +415,12 @@ func classicVpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, rout
 		}
 		routePatch, err := routePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for RoutePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for RoutePatch: %w", err)
 		}
 		updateVpcRouteOptions.RoutePatch = routePatch
 		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Route: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating VPC Route: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -441,12 +441,12 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 		}
 		routePatch, err := routePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for RoutePatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for RoutePatch: %w", err)
 		}
 		updateVpcRouteOptions.RoutePatch = routePatch
 		_, response, err := sess.UpdateVPCRoute(updateVpcRouteOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating VPC Route: %s\n%s", err, response)
+			return fmt.Errorf("Error Updating VPC Route: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -494,7 +494,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return fmt.Errorf("Error Getting VPC Route (%s): %w", routeID, newAPIError(err, response))
 	}
 	deleteRouteOptions := &vpcclassicv1.DeleteVPCRouteOptions{
 		VPCID: &vpcID,
@@ -502,7 +502,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 	}
 	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC Route: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting VPC Route: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -527,7 +527,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Route (%s): %s\n%s", routeID, err, response)
+		return fmt.Errorf("Error Getting VPC Route (%s): %w", routeID, newAPIError(err, response))
 	}
 	deleteRouteOptions := &vpcv1.DeleteVPCRouteOptions{
 		VPCID: &vpcID,
@@ -535,7 +535,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	}
 	response, err = sess.DeleteVPCRoute(deleteRouteOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting VPC Route: %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting VPC Route: %w", newAPIError(err, response))
 	}
 	_, err = isWaitForVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -561,7 +561,7 @@ func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, rou
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
 				}
-				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", routeID, err, response)
+				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %w", routeID, newAPIError(err, response))
 			}
 
 			return route, isRouteStatusDeleting, nil
@@ -590,7 +590,7 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 				if response != nil && response.StatusCode == 404 {
 					return route, isRouteStatusDeleted, nil
 				}
-				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %s\n%s", routeID, err, response)
+				return route, isRouteStatusDeleting, fmt.Errorf("The VPC route %s failed to delete: %w", routeID, newAPIError(err, response))
 			}
 			return route, isRouteStatusDeleting, nil
 		},
@@ -639,7 +639,7 @@ func classicVpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, rout
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting VPC Route: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -658,7 +658,7 @@ func vpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting VPC Route: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
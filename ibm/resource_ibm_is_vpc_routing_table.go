@@ -189,7 +189,7 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 	routeTable, response, err := sess.CreateVPCRoutingTable(createVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
@@ -211,7 +211,7 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Routing table: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPC Routing table: %w", newAPIError(err, response))
 	}
 
 	d.Set(rtID, routeTable.ID)
@@ -277,7 +277,7 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update VPC Routing table err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 	return resourceIBMISVPCRoutingTableRead(d, meta)
 }
@@ -318,7 +318,7 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting VPC Routing table : %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting VPC Routing table : %w", newAPIError(err, response))
 	}
 	return true, nil
 }
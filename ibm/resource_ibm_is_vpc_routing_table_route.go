@@ -188,7 +188,7 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 	route, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
-		return err
+		return newAPIError(err, response)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID))
@@ -210,7 +210,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Routing table route: %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPC Routing table route: %w", newAPIError(err, response))
 	}
 
 	d.Set(rID, *route.ID)
@@ -260,7 +260,7 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
-			return err
+			return newAPIError(err, response)
 		}
 	}
 
@@ -302,7 +302,7 @@ func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return false, nil
 		}
-		return false, fmt.Errorf("Error Getting VPC Routing table route : %s\n%s", err, response)
+		return false, fmt.Errorf("Error Getting VPC Routing table route : %w", newAPIError(err, response))
 	}
 	return true, nil
 }
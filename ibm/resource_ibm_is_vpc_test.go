@@ -15,6 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_is_vpc", &resource.Sweeper{
+		Name:         "ibm_is_vpc",
+		Dependencies: []string{"ibm_is_subnet", "ibm_is_security_group"},
+		F:            testSweepISVPCs,
+	})
+}
+
 func TestAccIBMISVPC_basic(t *testing.T) {
 	var vpc string
 	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
@@ -224,3 +232,41 @@ func testAccCheckIBMISVPCSgConfig(vpcname string, sgname string) string {
 `, vpcname, sgname)
 
 }
+
+func testSweepISVPCs(region string) error {
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	start := ""
+	allrecs := []vpcv1.VPC{}
+	for {
+		options := &vpcv1.ListVpcsOptions{}
+		if start != "" {
+			options.Start = &start
+		}
+		vpcs, response, err := sess.ListVpcs(options)
+		if err != nil {
+			return fmt.Errorf("Error Fetching vpcs %s\n%s", err, response)
+		}
+		start = GetNext(vpcs.Next)
+		allrecs = append(allrecs, vpcs.Vpcs...)
+		if start == "" {
+			break
+		}
+	}
+	var errs []error
+	for _, vpc := range allrecs {
+		if !isSweepable(*vpc.Name) {
+			continue
+		}
+		if err := sweepResource(resourceIBMISVPC(), "ibm_is_vpc", *vpc.ID, *vpc.Name, nil, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return sweepErrors("ibm_is_vpc", errs)
+}
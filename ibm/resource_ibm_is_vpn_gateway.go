@@ -282,7 +282,7 @@ func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID
 
 	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %w", newAPIError(err, response))
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 	_, err = isWaitForClassicVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
@@ -330,7 +330,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 
 	vpnGatewayIntf, response, err := sess.CreateVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %w", newAPIError(err, response))
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -376,7 +376,7 @@ func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id st
 		}
 		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Vpn Gateway: %w", newAPIError(err, response))
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 
@@ -410,7 +410,7 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 		}
 		vpnGatewayIntf, response, err := vpnGateway.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return nil, "", fmt.Errorf("Error Getting Vpn Gateway: %w", newAPIError(err, response))
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -457,7 +457,7 @@ func classicVpngwGet(d *schema.ResourceData, meta interface{}, id string) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway (%s): %w", id, newAPIError(err, response))
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 	d.Set(isVPNGatewayName, *vpnGateway.Name)
@@ -508,7 +508,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway (%s): %w", id, newAPIError(err, response))
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -613,7 +613,7 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Volume : %w", newAPIError(err, response))
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 
@@ -633,12 +633,12 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		}
 		vpnGatewayPatch, err := vpnGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %w", err)
 		}
 		options.VPNGatewayPatch = vpnGatewayPatch
 		_, response, err := sess.UpdateVPNGateway(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Vpn Gateway: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc Vpn Gateway: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -655,7 +655,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		vpnGatewayIntf, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
 		if err != nil {
-			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
+			return fmt.Errorf("Error getting Volume : %w", newAPIError(err, response))
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
@@ -675,12 +675,12 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		vpnGatewayPatch, err := vpnGatewayPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VPNGatewayPatch: %w", err)
 		}
 		options.VPNGatewayPatch = vpnGatewayPatch
 		_, response, err := sess.UpdateVPNGateway(options)
 		if err != nil {
-			return fmt.Errorf("Error updating vpc Vpn Gateway: %s\n%s", err, response)
+			return fmt.Errorf("Error updating vpc Vpn Gateway: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -720,7 +720,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcclassicv1.DeleteVPNGatewayOptions{
@@ -728,7 +728,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 	}
 	response, err = sess.DeleteVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Vpn Gateway : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForClassicVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -752,7 +752,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway (%s): %s\n%s", id, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway (%s): %w", id, newAPIError(err, response))
 	}
 
 	options := &vpcv1.DeleteVPNGatewayOptions{
@@ -760,7 +760,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	}
 	response, err = sess.DeleteVPNGateway(options)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Vpn Gateway : %w", newAPIError(err, response))
 	}
 	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -795,7 +795,7 @@ func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1,
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
 			}
-			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %w", newAPIError(err, response))
 		}
 		return vpngw, isVPNGatewayDeleting, err
 	}
@@ -826,7 +826,7 @@ func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayDeleted, nil
 			}
-			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %s\n%s", err, response)
+			return "", "", fmt.Errorf("Error Getting Vpn Gateway: %w", newAPIError(err, response))
 		}
 		return vpngw, isVPNGatewayDeleting, err
 	}
@@ -860,7 +860,7 @@ func classicVpngwExists(d *schema.ResourceData, meta interface{}, id string) (bo
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Vpn Gatewa: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -878,7 +878,7 @@ func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Vpn Gatewa: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
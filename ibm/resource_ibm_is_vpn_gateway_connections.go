@@ -342,7 +342,7 @@ func classicVpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatew
 
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %w", newAPIError(err, response))
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcclassicv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
@@ -402,7 +402,7 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
+		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %w", newAPIError(err, response))
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
@@ -453,7 +453,7 @@ func classicVpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID s
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway Connection (%s): %w", gConnID, newAPIError(err, response))
 	}
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcclassicv1.VPNGatewayConnection)
 	d.Set(isVPNGatewayConnectionName, *vpnGatewayConnection.Name)
@@ -477,7 +477,7 @@ func classicVpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID s
 	}
 	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPN Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPN Gateway : %w", newAPIError(err, response))
 	}
 	vpngateway := vpngatewayIntf.(*vpcclassicv1.VPNGateway)
 
@@ -500,7 +500,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway Connection (%s): %w", gConnID, newAPIError(err, response))
 	}
 	d.Set(isVPNGatewayConnection, gConnID)
 	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
@@ -562,7 +562,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	vpngatewayIntf, response, err := sess.GetVPNGateway(getVPNGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting VPN Gateway : %s\n%s", err, response)
+		return fmt.Errorf("Error Getting VPN Gateway : %w", newAPIError(err, response))
 	}
 	vpngateway := vpngatewayIntf.(*vpcv1.VPNGateway)
 	d.Set(RelatedCRN, *vpngateway.CRN)
@@ -666,12 +666,12 @@ func classicVpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnI
 	if hasChanged {
 		vpnGatewayConnectionPatch, err := vpnGatewayConnectionPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VPNGatewayConnectionPatch: %w", err)
 		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Vpn Gateway Connection: %s\n%s", err, response)
+			return fmt.Errorf("Error updating Vpn Gateway Connection: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -749,12 +749,12 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	if hasChanged {
 		vpnGatewayConnectionPatch, err := vpnGatewayConnectionPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
+			return fmt.Errorf("Error calling asPatch for VPNGatewayConnectionPatch: %w", err)
 		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating Vpn Gateway Connection: %s\n%s", err, response)
+			return fmt.Errorf("Error updating Vpn Gateway Connection: %w", newAPIError(err, response))
 		}
 	}
 	return nil
@@ -804,7 +804,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection(%s): %s\n%s", gConnID, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway Connection(%s): %w", gConnID, newAPIError(err, response))
 	}
 	deleteVpnGatewayConnectionOptions := &vpcclassicv1.DeleteVPNGatewayConnectionOptions{
 		VPNGatewayID: &gID,
@@ -812,7 +812,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 	}
 	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %w", newAPIError(err, response))
 	}
 
 	_, err = isWaitForClassicVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
@@ -841,7 +841,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Vpn Gateway Connection(%s): %s\n%s", gConnID, err, response)
+		return fmt.Errorf("Error Getting Vpn Gateway Connection(%s): %w", gConnID, newAPIError(err, response))
 	}
 
 	deleteVpnGatewayConnectionOptions := &vpcv1.DeleteVPNGatewayConnectionOptions{
@@ -850,7 +850,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	response, err = sess.DeleteVPNGatewayConnection(deleteVpnGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
+		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %w", newAPIError(err, response))
 	}
 
 	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
@@ -889,7 +889,7 @@ func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpccla
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
-			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
+			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %w", gConnID, newAPIError(err, response))
 		}
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
@@ -921,7 +921,7 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 			if response != nil && response.StatusCode == 404 {
 				return "", isVPNGatewayConnectionDeleted, nil
 			}
-			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %s\n%s", gConnID, err, response)
+			return "", "", fmt.Errorf("The Vpn Gateway Connection %s failed to delete: %w", gConnID, newAPIError(err, response))
 		}
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
@@ -966,7 +966,7 @@ func classicVpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnI
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -986,7 +986,7 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		if response != nil && response.StatusCode == 404 {
 			return false, nil
 		}
-		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
+		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %w", newAPIError(err, response))
 	}
 	return true, nil
 }
@@ -0,0 +1,614 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPNServerCertificateCRN          = "certificate_crn"
+	isVPNServerClientAuthentication    = "client_authentication"
+	isVPNServerAuthMethod              = "method"
+	isVPNServerAuthClientCaCRN         = "client_ca_crn"
+	isVPNServerAuthCrl                 = "crl"
+	isVPNServerAuthIdentityProvider    = "identity_provider"
+	isVPNServerClientIPPool            = "client_ip_pool"
+	isVPNServerClientDNSServerIps      = "client_dns_server_ips"
+	isVPNServerClientIdleTimeout       = "client_idle_timeout"
+	isVPNServerClientAutoDelete        = "client_auto_delete"
+	isVPNServerClientAutoDeleteTimeout = "client_auto_delete_timeout"
+	isVPNServerEnableSplitTunneling    = "enable_split_tunneling"
+	isVPNServerName                    = "name"
+	isVPNServerPort                    = "port"
+	isVPNServerProtocol                = "protocol"
+	isVPNServerResourceGroup           = "resource_group"
+	isVPNServerSecurityGroups          = "security_groups"
+	isVPNServerSubnets                 = "subnets"
+	isVPNServerHostname                = "hostname"
+	isVPNServerHealthState             = "health_state"
+	isVPNServerLifecycleState          = "lifecycle_state"
+	isVPNServerPrivateIps              = "private_ips"
+	isVPNServerVPC                     = "vpc"
+
+	isVPNServerLifecycleStatePending  = "pending"
+	isVPNServerLifecycleStateUpdating = "updating"
+	isVPNServerLifecycleStateStable   = "stable"
+	isVPNServerLifecycleStateDeleting = "deleting"
+	isVPNServerLifecycleStateFailed   = "failed"
+	isVPNServerDeleteDone             = "done"
+)
+
+func resourceIBMISVPNServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPNServerCreate,
+		ReadContext:   resourceIBMISVPNServerRead,
+		UpdateContext: resourceIBMISVPNServerUpdate,
+		DeleteContext: resourceIBMISVPNServerDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return vpnServerClientAuthenticationCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			isVPNServerCertificateCRN: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the certificate manager or secrets manager certificate the VPN server presents to its clients.",
+			},
+			isVPNServerClientAuthentication: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Description: "The methods the clients authenticate with. A client must pass all of them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPNServerAuthMethod: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_is_vpn_server", isVPNServerAuthMethod),
+							Description:  "The authentication method: certificate or username.",
+						},
+						isVPNServerAuthClientCaCRN: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the certificate of the CA that issued the client certificates. Required by the certificate method.",
+						},
+						isVPNServerAuthCrl: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PEM-encoded certificate revocation list of the client certificates. Only applies to the certificate method.",
+						},
+						isVPNServerAuthIdentityProvider: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The identity provider the usernames and passcodes are checked against, such as iam. Required by the username method.",
+						},
+					},
+				},
+			},
+			isVPNServerClientIPPool: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CIDR the IP addresses of the clients are allocated from. It must not overlap any address prefix of the VPC or any destination of the routes of the VPN server.",
+			},
+			isVPNServerClientDNSServerIps: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The DNS server addresses pushed to the clients.",
+			},
+			isVPNServerClientIdleTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_vpn_server", isVPNServerClientIdleTimeout),
+				Description:  "The number of seconds of inactivity after which a client is disconnected. 0 disables the timeout.",
+			},
+			isVPNServerEnableSplitTunneling: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether only the traffic to the destinations of the routes of the VPN server goes through the VPN.",
+			},
+			isVPNServerName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_vpn_server", isVPNServerName),
+				Description:  "The unique user-defined name for this VPN server.",
+			},
+			isVPNServerPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      443,
+				ValidateFunc: InvokeValidator("ibm_is_vpn_server", isVPNServerPort),
+				Description:  "The port the VPN server listens on.",
+			},
+			isVPNServerProtocol: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "udp",
+				ValidateFunc: InvokeValidator("ibm_is_vpn_server", isVPNServerProtocol),
+				Description:  "The transport protocol of the VPN server: udp or tcp.",
+			},
+			isVPNServerResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The unique identifier of the resource group to use. If unspecified, the account's default resource group is used.",
+			},
+			isVPNServerSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security groups of the VPN server. If unspecified, the default security group of the VPC is used.",
+			},
+			isVPNServerSubnets: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The subnets the VPN server is deployed in. Two subnets in different zones make the VPN server highly available.",
+			},
+			isVPNServerClientAutoDelete: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the disconnected clients are deleted after client_auto_delete_timeout.",
+			},
+			isVPNServerClientAutoDeleteTimeout: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of hours after which the disconnected clients are deleted.",
+			},
+			isVPNServerHostname: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hostname the clients connect to.",
+			},
+			isVPNServerHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health state of the VPN server.",
+			},
+			isVPNServerLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the VPN server.",
+			},
+			isVPNServerPrivateIps: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reserved IPs the VPN server uses in its subnets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the reserved IP.",
+						},
+					},
+				},
+			},
+			isVPNServerVPC: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the VPC of the VPN server.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the VPN server was created.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN for this VPN server.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this VPN server.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISVPNServerValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerAuthMethod,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", vpcext.VPNServerAuthenticationMethodCertificate, vpcext.VPNServerAuthenticationMethodUsername),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerProtocol,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "udp, tcp",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerPort,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerClientIdleTimeout,
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "28800",
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_vpn_server", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// vpnServerClientAuthenticationCustomizeDiff rejects the client authentication methods missing the arguments the API
+// requires, and the methods given twice
+func vpnServerClientAuthenticationCustomizeDiff(diff *schema.ResourceDiff) error {
+	methods := map[string]bool{}
+	for i, auth := range diff.Get(isVPNServerClientAuthentication).([]interface{}) {
+		authMap, ok := auth.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		method := authMap[isVPNServerAuthMethod].(string)
+		if method == "" {
+			// unknown until apply
+			continue
+		}
+		if methods[method] {
+			return fmt.Errorf("%s.%d: the %s method is given more than once", isVPNServerClientAuthentication, i, method)
+		}
+		methods[method] = true
+		switch method {
+		case vpcext.VPNServerAuthenticationMethodCertificate:
+			if !diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isVPNServerClientAuthentication, i, isVPNServerAuthClientCaCRN)) {
+				continue
+			}
+			if authMap[isVPNServerAuthClientCaCRN].(string) == "" {
+				return fmt.Errorf("%s.%d: %s is required by the certificate method", isVPNServerClientAuthentication, i, isVPNServerAuthClientCaCRN)
+			}
+			if authMap[isVPNServerAuthIdentityProvider].(string) != "" {
+				return fmt.Errorf("%s.%d: %s only applies to the username method", isVPNServerClientAuthentication, i, isVPNServerAuthIdentityProvider)
+			}
+		case vpcext.VPNServerAuthenticationMethodUsername:
+			if !diff.NewValueKnown(fmt.Sprintf("%s.%d.%s", isVPNServerClientAuthentication, i, isVPNServerAuthIdentityProvider)) {
+				continue
+			}
+			if authMap[isVPNServerAuthIdentityProvider].(string) == "" {
+				return fmt.Errorf("%s.%d: %s is required by the username method", isVPNServerClientAuthentication, i, isVPNServerAuthIdentityProvider)
+			}
+			if authMap[isVPNServerAuthClientCaCRN].(string) != "" || authMap[isVPNServerAuthCrl].(string) != "" {
+				return fmt.Errorf("%s.%d: %s and %s only apply to the certificate method", isVPNServerClientAuthentication, i, isVPNServerAuthClientCaCRN, isVPNServerAuthCrl)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceIBMISVPNServerCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	certificateCRN := d.Get(isVPNServerCertificateCRN).(string)
+	clientIPPool := d.Get(isVPNServerClientIPPool).(string)
+	enableSplitTunneling := d.Get(isVPNServerEnableSplitTunneling).(bool)
+	port := int64(d.Get(isVPNServerPort).(int))
+	protocol := d.Get(isVPNServerProtocol).(string)
+	prototype := &vpcext.VPNServerPrototype{
+		Certificate:          &vpcext.CertificateInstanceReference{CRN: &certificateCRN},
+		ClientAuthentication: expandVPNServerClientAuthentication(d.Get(isVPNServerClientAuthentication).([]interface{})),
+		ClientDNSServerIps:   expandVPNServerIPs(d.Get(isVPNServerClientDNSServerIps).(*schema.Set)),
+		ClientIPPool:         &clientIPPool,
+		EnableSplitTunneling: &enableSplitTunneling,
+		Port:                 &port,
+		Protocol:             &protocol,
+		Subnets:              expandVPCExtReferences(d.Get(isVPNServerSubnets).(*schema.Set)),
+	}
+	if clientIdleTimeout, ok := d.GetOkExists(isVPNServerClientIdleTimeout); ok {
+		clientIdleTimeoutInt := int64(clientIdleTimeout.(int))
+		prototype.ClientIdleTimeout = &clientIdleTimeoutInt
+	}
+	if name, ok := d.GetOk(isVPNServerName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+	if resourceGroup, ok := d.GetOk(isVPNServerResourceGroup); ok {
+		resourceGroupStr := resourceGroup.(string)
+		prototype.ResourceGroup = &vpcext.Reference{ID: &resourceGroupStr}
+	}
+	if securityGroups, ok := d.GetOk(isVPNServerSecurityGroups); ok {
+		prototype.SecurityGroups = expandVPCExtReferences(securityGroups.(*schema.Set))
+	}
+
+	vpnServer, response, err := client.CreateVPNServer(context, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPNServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(*vpnServer.ID)
+	log.Printf("[INFO] VPN server : %s", d.Id())
+
+	_, err = isWaitForVPNServerStable(context, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISVPNServerRead(context, d, meta)
+}
+
+func resourceIBMISVPNServerRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServer, response, err := client.GetVPNServer(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	for k, v := range dataSourceIBMISVPNServerToMap(*vpnServer) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMISVPNServerUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := map[string]interface{}{}
+	if d.HasChange(isVPNServerCertificateCRN) {
+		patch["certificate"] = map[string]interface{}{"crn": d.Get(isVPNServerCertificateCRN).(string)}
+	}
+	if d.HasChange(isVPNServerClientAuthentication) {
+		patch[isVPNServerClientAuthentication] = expandVPNServerClientAuthentication(d.Get(isVPNServerClientAuthentication).([]interface{}))
+	}
+	if d.HasChange(isVPNServerClientDNSServerIps) {
+		// an empty list, rather than a null one, removes all the DNS servers
+		patch[isVPNServerClientDNSServerIps] = append([]vpcext.IP{}, expandVPNServerIPs(d.Get(isVPNServerClientDNSServerIps).(*schema.Set))...)
+	}
+	if d.HasChange(isVPNServerClientIdleTimeout) {
+		patch[isVPNServerClientIdleTimeout] = d.Get(isVPNServerClientIdleTimeout).(int)
+	}
+	for _, key := range []string{isVPNServerClientIPPool, isVPNServerEnableSplitTunneling, isVPNServerName, isVPNServerPort, isVPNServerProtocol} {
+		if d.HasChange(key) {
+			patch[key] = d.Get(key)
+		}
+	}
+	if d.HasChange(isVPNServerSubnets) {
+		patch[isVPNServerSubnets] = expandVPCExtReferences(d.Get(isVPNServerSubnets).(*schema.Set))
+	}
+
+	if len(patch) > 0 {
+		_, response, err := client.UpdateVPNServer(context, d.Id(), patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVPNServer failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+		_, err = isWaitForVPNServerStable(context, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	if d.HasChange(isVPNServerSecurityGroups) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		oldSgs, newSgs := d.GetChange(isVPNServerSecurityGroups)
+		err = securityGroupTargetBindingsUpdate(context, sess, d.Id(), oldSgs.(*schema.Set), newSgs.(*schema.Set))
+		if err != nil {
+			return diagFromErr(context, err)
+		}
+	}
+
+	return resourceIBMISVPNServerRead(context, d, meta)
+}
+
+func resourceIBMISVPNServerDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := client.DeleteVPNServer(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVPNServer failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForVPNServerDeleted(context, client, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandVPNServerClientAuthentication(list []interface{}) []vpcext.VPNServerAuthentication {
+	auths := make([]vpcext.VPNServerAuthentication, 0, len(list))
+	for _, auth := range list {
+		authMap := auth.(map[string]interface{})
+		method := authMap[isVPNServerAuthMethod].(string)
+		vpnServerAuth := vpcext.VPNServerAuthentication{Method: &method}
+		if clientCaCRN := authMap[isVPNServerAuthClientCaCRN].(string); clientCaCRN != "" {
+			vpnServerAuth.ClientCa = &vpcext.CertificateInstanceReference{CRN: &clientCaCRN}
+		}
+		if crl := authMap[isVPNServerAuthCrl].(string); crl != "" {
+			vpnServerAuth.Crl = &crl
+		}
+		if identityProvider := authMap[isVPNServerAuthIdentityProvider].(string); identityProvider != "" {
+			vpnServerAuth.IdentityProvider = &vpcext.VPNServerAuthenticationByUsernameID{ProviderType: &identityProvider}
+		}
+		auths = append(auths, vpnServerAuth)
+	}
+	return auths
+}
+
+func flattenVPNServerClientAuthentication(auths []vpcext.VPNServerAuthentication) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(auths))
+	for _, auth := range auths {
+		authMap := map[string]interface{}{
+			isVPNServerAuthMethod: auth.Method,
+		}
+		if auth.ClientCa != nil {
+			authMap[isVPNServerAuthClientCaCRN] = auth.ClientCa.CRN
+		}
+		if auth.Crl != nil {
+			authMap[isVPNServerAuthCrl] = auth.Crl
+		}
+		if auth.IdentityProvider != nil {
+			authMap[isVPNServerAuthIdentityProvider] = auth.IdentityProvider.ProviderType
+		}
+		list = append(list, authMap)
+	}
+	return list
+}
+
+func expandVPNServerIPs(set *schema.Set) []vpcext.IP {
+	var ips []vpcext.IP
+	for _, address := range expandStringList(set.List()) {
+		addressStr := address
+		ips = append(ips, vpcext.IP{Address: &addressStr})
+	}
+	return ips
+}
+
+// expandVPCExtReferences returns references by ID to the resources of a set of IDs
+func expandVPCExtReferences(set *schema.Set) []vpcext.Reference {
+	var references []vpcext.Reference
+	for _, id := range expandStringList(set.List()) {
+		idStr := id
+		references = append(references, vpcext.Reference{ID: &idStr})
+	}
+	return references
+}
+
+func isWaitForVPNServerStable(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerLifecycleStatePending, isVPNServerLifecycleStateUpdating},
+		Target:  []string{isVPNServerLifecycleStateStable, isVPNServerLifecycleStateFailed},
+		Refresh: func() (interface{}, string, error) {
+			vpnServer, response, err := client.GetVPNServer(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting VPN Server: %w", newAPIError(err, response))
+			}
+			if *vpnServer.LifecycleState == isVPNServerLifecycleStateFailed {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("VPN server (%s) went into failed state during the operation", id)
+			}
+			return vpnServer, *vpnServer.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForVPNServerDeleted(ctx context.Context, client *vpcext.VpcExtV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerLifecycleStateDeleting, isVPNServerLifecycleStateStable},
+		Target:  []string{isVPNServerDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			vpnServer, response, err := client.GetVPNServer(ctx, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return vpnServer, isVPNServerDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting VPN Server: %w", newAPIError(err, response))
+			}
+			if *vpnServer.LifecycleState == isVPNServerLifecycleStateFailed {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("VPN server (%s) went into failed state during the deletion", id)
+			}
+			return vpnServer, isVPNServerLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
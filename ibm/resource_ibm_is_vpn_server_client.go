@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPNServerClientVPNServer = "vpn_server"
+	isVPNServerClientID        = "vpn_client"
+	isVPNServerClientDelete    = "delete"
+	isVPNServerClientStatus    = "status"
+)
+
+// resourceIBMISVPNServerClient disconnects a client from a VPN server on creation. Destroying it only removes it from
+// the state, as a disconnected client can't be reconnected but by the client itself.
+func resourceIBMISVPNServerClient() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPNServerClientDisconnect,
+		ReadContext:   resourceIBMISVPNServerClientRead,
+		DeleteContext: resourceIBMISVPNServerClientDelete,
+
+		Schema: map[string]*schema.Schema{
+			isVPNServerClientVPNServer: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the VPN server.",
+			},
+			isVPNServerClientID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the client to disconnect.",
+			},
+			isVPNServerClientDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the record of the client is deleted as well as the client is disconnected.",
+			},
+			isVPNServerClientStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the client.",
+			},
+		},
+	}
+}
+
+func resourceIBMISVPNServerClientDisconnect(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get(isVPNServerClientVPNServer).(string)
+	clientID := d.Get(isVPNServerClientID).(string)
+	if d.Get(isVPNServerClientDelete).(bool) {
+		response, err := client.DeleteVPNServerClient(context, vpnServerID, clientID)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteVPNServerClient failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	} else {
+		response, err := client.DisconnectVPNServerClient(context, vpnServerID, clientID)
+		if err != nil {
+			log.Printf("[DEBUG] DisconnectVPNServerClient failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpnServerID, clientID))
+
+	return resourceIBMISVPNServerClientRead(context, d, meta)
+}
+
+func resourceIBMISVPNServerClientRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get(isVPNServerClientVPNServer).(string)
+	clientID := d.Get(isVPNServerClientID).(string)
+	vpnServerClient, response, err := client.GetVPNServerClient(context, vpnServerID, clientID)
+	if err != nil {
+		// a deleted or expired client keeps the disconnection done
+		if response != nil && response.StatusCode == 404 {
+			d.Set(isVPNServerClientStatus, "")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerClient failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.Set(isVPNServerClientStatus, vpnServerClient.Status)
+
+	return nil
+}
+
+func resourceIBMISVPNServerClientDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/vpcext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPNServerRouteVPNServer      = "vpn_server"
+	isVPNServerRouteDestination    = "destination"
+	isVPNServerRouteAction         = "action"
+	isVPNServerRouteName           = "name"
+	isVPNServerRouteID             = "vpn_server_route"
+	isVPNServerRouteHealthState    = "health_state"
+	isVPNServerRouteLifecycleState = "lifecycle_state"
+)
+
+func resourceIBMISVPNServerRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPNServerRouteCreate,
+		ReadContext:   resourceIBMISVPNServerRouteRead,
+		UpdateContext: resourceIBMISVPNServerRouteUpdate,
+		DeleteContext: resourceIBMISVPNServerRouteDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isVPNServerRouteVPNServer: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the VPN server.",
+			},
+			isVPNServerRouteDestination: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The destination CIDR of the route.",
+			},
+			isVPNServerRouteAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      vpcext.VPNServerRouteActionDeliver,
+				ValidateFunc: InvokeValidator("ibm_is_vpn_server_route", isVPNServerRouteAction),
+				Description:  "The action of the route: deliver forwards the traffic, translate forwards it with the source IP of the client translated to a private IP of the VPN server, drop drops it.",
+			},
+			isVPNServerRouteName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_vpn_server_route", isVPNServerRouteName),
+				Description:  "The user-defined name of the route, unique within the VPN server.",
+			},
+			isVPNServerRouteID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the route.",
+			},
+			isVPNServerRouteHealthState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health state of the route.",
+			},
+			isVPNServerRouteLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the route.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the route was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this route.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type.",
+			},
+		},
+	}
+}
+
+func resourceIBMISVPNServerRouteValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerRouteAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s, %s", vpcext.VPNServerRouteActionDeliver, vpcext.VPNServerRouteActionDrop, vpcext.VPNServerRouteActionTranslate),
+		})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isVPNServerRouteName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63,
+		})
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_is_vpn_server_route", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMISVPNServerRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get(isVPNServerRouteVPNServer).(string)
+	destination := d.Get(isVPNServerRouteDestination).(string)
+	action := d.Get(isVPNServerRouteAction).(string)
+	prototype := &vpcext.VPNServerRoutePrototype{
+		Action:      &action,
+		Destination: &destination,
+	}
+	if name, ok := d.GetOk(isVPNServerRouteName); ok {
+		nameStr := name.(string)
+		prototype.Name = &nameStr
+	}
+
+	route, response, err := client.CreateVPNServerRoute(context, vpnServerID, prototype)
+	if err != nil {
+		log.Printf("[DEBUG] CreateVPNServerRoute failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpnServerID, *route.ID))
+	log.Printf("[INFO] VPN server route : %s", d.Id())
+
+	_, err = isWaitForVPNServerRouteStable(context, client, vpnServerID, *route.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	return resourceIBMISVPNServerRouteRead(context, d, meta)
+}
+
+func resourceIBMISVPNServerRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of vpnServerID/routeID", d.Id()))
+	}
+	vpnServerID, routeID := parts[0], parts[1]
+
+	route, response, err := client.GetVPNServerRoute(context, vpnServerID, routeID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVPNServerRoute failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+
+	d.Set(isVPNServerRouteVPNServer, vpnServerID)
+	d.Set(isVPNServerRouteID, route.ID)
+	d.Set(isVPNServerRouteDestination, route.Destination)
+	d.Set(isVPNServerRouteAction, route.Action)
+	d.Set(isVPNServerRouteName, route.Name)
+	d.Set(isVPNServerRouteHealthState, route.HealthState)
+	d.Set(isVPNServerRouteLifecycleState, route.LifecycleState)
+	d.Set("created_at", route.CreatedAt)
+	d.Set("href", route.Href)
+	d.Set("resource_type", route.ResourceType)
+
+	return nil
+}
+
+func resourceIBMISVPNServerRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(isVPNServerRouteName) {
+		patch := map[string]interface{}{
+			isVPNServerRouteName: d.Get(isVPNServerRouteName).(string),
+		}
+		vpnServerID := d.Get(isVPNServerRouteVPNServer).(string)
+		routeID := d.Get(isVPNServerRouteID).(string)
+		_, response, err := client.UpdateVPNServerRoute(context, vpnServerID, routeID, patch)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateVPNServerRoute failed %s\n%s", err, response)
+			return diagFromErr(context, newAPIError(err, response))
+		}
+	}
+
+	return resourceIBMISVPNServerRouteRead(context, d, meta)
+}
+
+func resourceIBMISVPNServerRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vpcExtClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpnServerID := d.Get(isVPNServerRouteVPNServer).(string)
+	routeID := d.Get(isVPNServerRouteID).(string)
+	response, err := client.DeleteVPNServerRoute(context, vpnServerID, routeID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteVPNServerRoute failed %s\n%s", err, response)
+		return diagFromErr(context, newAPIError(err, response))
+	}
+	_, err = isWaitForVPNServerRouteDeleted(context, client, vpnServerID, routeID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(context, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForVPNServerRouteStable(ctx context.Context, client *vpcext.VpcExtV1, vpnServerID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server route (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerLifecycleStatePending, isVPNServerLifecycleStateUpdating},
+		Target:  []string{isVPNServerLifecycleStateStable, isVPNServerLifecycleStateFailed},
+		Refresh: func() (interface{}, string, error) {
+			route, response, err := client.GetVPNServerRoute(ctx, vpnServerID, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error Getting VPN Server Route: %w", newAPIError(err, response))
+			}
+			if *route.LifecycleState == isVPNServerLifecycleStateFailed {
+				return route, *route.LifecycleState, fmt.Errorf("VPN server route (%s) went into failed state during the operation", id)
+			}
+			return route, *route.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForVPNServerRouteDeleted(ctx context.Context, client *vpcext.VpcExtV1, vpnServerID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server route (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{isVPNServerLifecycleStateDeleting, isVPNServerLifecycleStateStable},
+		Target:  []string{isVPNServerDeleteDone, ""},
+		Refresh: func() (interface{}, string, error) {
+			route, response, err := client.GetVPNServerRoute(ctx, vpnServerID, id)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return route, isVPNServerDeleteDone, nil
+				}
+				return nil, "", fmt.Errorf("Error Getting VPN Server Route: %w", newAPIError(err, response))
+			}
+			if *route.LifecycleState == isVPNServerLifecycleStateFailed {
+				return route, *route.LifecycleState, fmt.Errorf("VPN server route (%s) went into failed state during the deletion", id)
+			}
+			return route, isVPNServerLifecycleStateDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
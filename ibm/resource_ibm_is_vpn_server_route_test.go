@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPNServerRouteBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnServerName := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vpn-route-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-vpn-route-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNServerRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNServerRouteConfig(vpcname, subnetname, vpnServerName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISVPNServerRouteExists("ibm_is_vpn_server_route.testacc_vpn_route"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.testacc_vpn_route", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.testacc_vpn_route", "destination", "172.16.0.0/16"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.testacc_vpn_route", "action", "translate"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.testacc_vpn_route", "lifecycle_state", "stable"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNServerRouteConfig(vpcname, subnetname, vpnServerName, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpn_server_route.testacc_vpn_route", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpn_server_route.testacc_vpn_route",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNServerRouteDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server_route" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetVPNServerRoute(context.Background(), parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("VPN server route still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPNServerRouteExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetVPNServerRoute(context.Background(), parts[0], parts[1])
+		return err
+	}
+}
+
+func testAccCheckIBMISVPNServerRouteConfig(vpcname, subnetname, vpnServerName, name string) string {
+	return testAccCheckIBMISVPNServerConfig(vpcname, subnetname, vpnServerName, 600) + fmt.Sprintf(`
+	resource "ibm_is_vpn_server_route" "testacc_vpn_route" {
+		vpn_server  = ibm_is_vpn_server.testacc_vpn_server.id
+		destination = "172.16.0.0/16"
+		action      = "translate"
+		name        = "%s"
+	  }`, name)
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPNServerBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-vpn-server-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-vpn-server-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNServerConfig(vpcname, subnetname, name, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMISVPNServerExists("ibm_is_vpn_server.testacc_vpn_server"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "name", name),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "client_idle_timeout", "600"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "client_authentication.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "client_authentication.0.method", "certificate"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpn_server.testacc_vpn_server", "hostname"),
+					resource.TestCheckResourceAttrSet("ibm_is_vpn_server.testacc_vpn_server", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNServerConfig(vpcname, subnetname, nameUpdate, 1200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_is_vpn_server.testacc_vpn_server", "client_idle_timeout", "1200"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpn_server.testacc_vpn_server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIBMISVPNServerClientAuthenticationCustomizeDiff(t *testing.T) {
+	testCases := []struct {
+		name string
+		auth []interface{}
+		err  string
+	}{
+		{
+			name: "certificate",
+			auth: []interface{}{
+				map[string]interface{}{"method": "certificate", "client_ca_crn": "crn:ca"},
+			},
+		},
+		{
+			name: "certificate and username",
+			auth: []interface{}{
+				map[string]interface{}{"method": "certificate", "client_ca_crn": "crn:ca"},
+				map[string]interface{}{"method": "username", "identity_provider": "iam"},
+			},
+		},
+		{
+			name: "certificate without client CA",
+			auth: []interface{}{
+				map[string]interface{}{"method": "certificate"},
+			},
+			err: "client_ca_crn is required by the certificate method",
+		},
+		{
+			name: "username without identity provider",
+			auth: []interface{}{
+				map[string]interface{}{"method": "username"},
+			},
+			err: "identity_provider is required by the username method",
+		},
+		{
+			name: "username with client CA",
+			auth: []interface{}{
+				map[string]interface{}{"method": "username", "identity_provider": "iam", "client_ca_crn": "crn:ca"},
+			},
+			err: "only apply to the certificate method",
+		},
+		{
+			name: "same method twice",
+			auth: []interface{}{
+				map[string]interface{}{"method": "username", "identity_provider": "iam"},
+				map[string]interface{}{"method": "username", "identity_provider": "iam"},
+			},
+			err: "the username method is given more than once",
+		},
+	}
+
+	for _, tc := range testCases {
+		raw := map[string]interface{}{
+			"certificate_crn":       "crn:certificate",
+			"client_authentication": tc.auth,
+			"client_ip_pool":        "10.5.0.0/21",
+			"subnets":               []interface{}{"subnet-id"},
+		}
+		_, err := resourceIBMISVPNServer().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error %s", tc.name, err)
+		}
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s: expected error %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func testAccCheckIBMISVPNServerDestroy(s *terraform.State) error {
+	client, err := vpcExtClient(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpn_server" {
+			continue
+		}
+		_, _, err := client.GetVPNServer(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("VPN server still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPNServerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		client, err := vpcExtClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetVPNServer(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIBMISVPNServerConfig(vpcname, subnetname, name string, clientIdleTimeout int) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+
+	  resource "ibm_is_vpn_server" "testacc_vpn_server" {
+		name                = "%s"
+		certificate_crn     = "%s"
+		client_ip_pool      = "10.5.0.0/21"
+		client_idle_timeout = %d
+		subnets             = [ibm_is_subnet.testacc_subnet.id]
+		client_authentication {
+		  method        = "certificate"
+		  client_ca_crn = "%s"
+		}
+	  }`, vpcname, subnetname, ISZoneName, ISCIDR, name, certCRN, clientIdleTimeout, isVPNServerClientCaCRN)
+}
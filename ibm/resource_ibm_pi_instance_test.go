@@ -6,6 +6,8 @@ package ibm
 import (
 	"errors"
 	"fmt"
+	"log"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -15,6 +17,13 @@ import (
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 )
 
+func init() {
+	resource.AddTestSweepers("ibm_pi_instance", &resource.Sweeper{
+		Name: "ibm_pi_instance",
+		F:    testSweepPIInstances,
+	})
+}
+
 func TestAccIBMPIInstancebasic(t *testing.T) {
 
 	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
@@ -127,3 +136,35 @@ func testAccCheckIBMPIInstanceConfig(name string) string {
 	  }
 	`, pi_cloud_instance_id, name)
 }
+
+func testSweepPIInstances(region string) error {
+	powerinstanceid := os.Getenv("PI_CLOUDINSTANCE_ID")
+	if powerinstanceid == "" {
+		log.Printf("[INFO] Skipping ibm_pi_instance sweeper, PI_CLOUDINSTANCE_ID is not set")
+		return nil
+	}
+	meta, err := sharedClientForRegion(region)
+	if err != nil {
+		return err
+	}
+	sess, err := meta.IBMPISession()
+	if err != nil {
+		return err
+	}
+	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
+	instances, err := client.GetAll(powerinstanceid, getTimeOut)
+	if err != nil {
+		return fmt.Errorf("Error Fetching pvm instances: %s", err)
+	}
+	var errs []error
+	for _, instance := range instances.PvmInstances {
+		if instance.ServerName == nil || !isSweepable(*instance.ServerName) {
+			continue
+		}
+		id := fmt.Sprintf("%s/%s", powerinstanceid, *instance.PvmInstanceID)
+		if err := sweepResource(resourceIBMPIInstance(), "ibm_pi_instance", id, *instance.ServerName, nil, meta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return sweepErrors("ibm_pi_instance", errs)
+}
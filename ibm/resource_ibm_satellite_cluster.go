@@ -105,6 +105,7 @@ func resourceIBMSatelliteCluster() *schema.Resource {
 				Optional:    true,
 				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
 			},
+			"update_strategy": workerUpdateStrategySchema(),
 			"master_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -570,10 +571,34 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
 		}
 		waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
-		if workerFields != nil {
+		if strategy := expandWorkerUpdateStrategy(d); strategy != nil {
+			albClient, err := meta.(ClientSession).VpcContainerAPI()
+			if err != nil {
+				return err
+			}
+			rollout := &satelliteWorkerRollout{
+				meta:          meta,
+				satClient:     satClient,
+				csClient:      albClient,
+				wrkAPI:        wrkAPI,
+				cluster:       clusterID,
+				target:        targetEnv,
+				masterVersion: *cluster.MasterKubeVersion,
+				patchVersion:  patchVersion,
+			}
+			pools, err := rollout.pools()
+			if err != nil {
+				d.Set("patch_version", nil)
+				return err
+			}
+			if err := strategy.rollWorkers(rollout, pools, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				d.Set("patch_version", nil)
+				return fmt.Errorf("Error updating the workers of the cluster (%s): %s", clusterID, err)
+			}
+		} else if workerFields != nil {
 			for _, w := range workerFields {
 				//kubeversion update done if there is a change in Major.Minor version
-				if strings.Split(*w.KubeVersion.Actual, "_")[0] != strings.Split(*cluster.MasterKubeVersion, "_")[0] || (strings.Split(*w.KubeVersion.Actual, ".")[2] != patchVersion && strings.Split(*w.KubeVersion.Target, ".")[2] == patchVersion) {
+				if satelliteWorkerOutdated(*w.KubeVersion.Actual, *w.KubeVersion.Target, *cluster.MasterKubeVersion, patchVersion) {
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}